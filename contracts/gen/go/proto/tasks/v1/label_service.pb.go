@@ -0,0 +1,525 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: proto/tasks/v1/label_service.proto
+
+package tasksv1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Label struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Color         string                 `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Label) Reset() {
+	*x = Label{}
+	mi := &file_proto_tasks_v1_label_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Label) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Label) ProtoMessage() {}
+
+func (x *Label) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tasks_v1_label_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Label.ProtoReflect.Descriptor instead.
+func (*Label) Descriptor() ([]byte, []int) {
+	return file_proto_tasks_v1_label_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Label) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Label) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Label) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *Label) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Label) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListLabelsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The maximum number of labels to return. Zero selects the server default,
+	// values above the server maximum are coerced to it.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// A page token received from a previous ListLabels call.
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLabelsRequest) Reset() {
+	*x = ListLabelsRequest{}
+	mi := &file_proto_tasks_v1_label_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLabelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLabelsRequest) ProtoMessage() {}
+
+func (x *ListLabelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tasks_v1_label_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLabelsRequest.ProtoReflect.Descriptor instead.
+func (*ListLabelsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tasks_v1_label_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListLabelsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListLabelsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListLabelsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Labels        []*Label               `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLabelsResponse) Reset() {
+	*x = ListLabelsResponse{}
+	mi := &file_proto_tasks_v1_label_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLabelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLabelsResponse) ProtoMessage() {}
+
+func (x *ListLabelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tasks_v1_label_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLabelsResponse.ProtoReflect.Descriptor instead.
+func (*ListLabelsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tasks_v1_label_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListLabelsResponse) GetLabels() []*Label {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *ListLabelsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetLabelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLabelRequest) Reset() {
+	*x = GetLabelRequest{}
+	mi := &file_proto_tasks_v1_label_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLabelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLabelRequest) ProtoMessage() {}
+
+func (x *GetLabelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tasks_v1_label_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLabelRequest.ProtoReflect.Descriptor instead.
+func (*GetLabelRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tasks_v1_label_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetLabelRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateLabelRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The final segment of the label name, e.g. "urgent". Lower-case letters,
+	// digits and hyphens, starting with a letter. Filters refer to labels by it.
+	LabelId       string `protobuf:"bytes,1,opt,name=label_id,json=labelId,proto3" json:"label_id,omitempty"`
+	Label         *Label `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLabelRequest) Reset() {
+	*x = CreateLabelRequest{}
+	mi := &file_proto_tasks_v1_label_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLabelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLabelRequest) ProtoMessage() {}
+
+func (x *CreateLabelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tasks_v1_label_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLabelRequest.ProtoReflect.Descriptor instead.
+func (*CreateLabelRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tasks_v1_label_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateLabelRequest) GetLabelId() string {
+	if x != nil {
+		return x.LabelId
+	}
+	return ""
+}
+
+func (x *CreateLabelRequest) GetLabel() *Label {
+	if x != nil {
+		return x.Label
+	}
+	return nil
+}
+
+type UpdateLabelRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The label to update. Its name identifies the label.
+	Label *Label `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	// The fields to update: any of display_name and color, or "*" to replace
+	// both of them.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLabelRequest) Reset() {
+	*x = UpdateLabelRequest{}
+	mi := &file_proto_tasks_v1_label_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLabelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLabelRequest) ProtoMessage() {}
+
+func (x *UpdateLabelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tasks_v1_label_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLabelRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLabelRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tasks_v1_label_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateLabelRequest) GetLabel() *Label {
+	if x != nil {
+		return x.Label
+	}
+	return nil
+}
+
+func (x *UpdateLabelRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteLabelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLabelRequest) Reset() {
+	*x = DeleteLabelRequest{}
+	mi := &file_proto_tasks_v1_label_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLabelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLabelRequest) ProtoMessage() {}
+
+func (x *DeleteLabelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tasks_v1_label_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLabelRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLabelRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tasks_v1_label_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteLabelRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+var File_proto_tasks_v1_label_service_proto protoreflect.FileDescriptor
+
+const file_proto_tasks_v1_label_service_proto_rawDesc = "" +
+	"\n" +
+	"\"proto/tasks/v1/label_service.proto\x12\btasks.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x17validate/validate.proto\"\x9c\x02\n" +
+	"\x05Label\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12+\n" +
+	"\fdisplay_name\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x01R\vdisplayName\x12\x1d\n" +
+	"\x05color\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x18@R\x05color\x12>\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\tcreatedAt\x12>\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\tupdatedAt:.\xeaA+\n" +
+	"\x19tasks.readytogo.com/Label\x12\x0elabels/{label}\"`\n" +
+	"\x11ListLabelsRequest\x12'\n" +
+	"\tpage_size\x18\x01 \x01(\x05B\n" +
+	"\xe0A\x01\xfaB\x04\x1a\x02(\x00R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tpageToken\"e\n" +
+	"\x12ListLabelsResponse\x12'\n" +
+	"\x06labels\x18\x01 \x03(\v2\x0f.tasks.v1.LabelR\x06labels\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"H\n" +
+	"\x0fGetLabelRequest\x125\n" +
+	"\x04name\x18\x01 \x01(\tB!\xe0A\x02\xfaA\x1b\n" +
+	"\x19tasks.readytogo.com/LabelR\x04name\"\x90\x01\n" +
+	"\x12CreateLabelRequest\x12F\n" +
+	"\blabel_id\x18\x01 \x01(\tB+\xe0A\x02\xfaB%r#2!^[a-z]([a-z0-9-]{0,61}[a-z0-9])?$R\alabelId\x122\n" +
+	"\x05label\x18\x02 \x01(\v2\x0f.tasks.v1.LabelB\v\xe0A\x02\xfaB\x05\x8a\x01\x02\x10\x01R\x05label\"\x92\x01\n" +
+	"\x12UpdateLabelRequest\x122\n" +
+	"\x05label\x18\x01 \x01(\v2\x0f.tasks.v1.LabelB\v\xe0A\x02\xfaB\x05\x8a\x01\x02\x10\x01R\x05label\x12H\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\v\xe0A\x02\xfaB\x05\x8a\x01\x02\x10\x01R\n" +
+	"updateMask\"K\n" +
+	"\x12DeleteLabelRequest\x125\n" +
+	"\x04name\x18\x01 \x01(\tB!\xe0A\x02\xfaA\x1b\n" +
+	"\x19tasks.readytogo.com/LabelR\x04name2\xe3\x03\n" +
+	"\fLabelService\x12[\n" +
+	"\n" +
+	"ListLabels\x12\x1b.tasks.v1.ListLabelsRequest\x1a\x1c.tasks.v1.ListLabelsResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/labels\x12S\n" +
+	"\bGetLabel\x12\x19.tasks.v1.GetLabelRequest\x1a\x0f.tasks.v1.Label\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/{name=labels/*}\x12W\n" +
+	"\vCreateLabel\x12\x1c.tasks.v1.CreateLabelRequest\x1a\x0f.tasks.v1.Label\"\x19\x82\xd3\xe4\x93\x02\x13:\x05label\"\n" +
+	"/v1/labels\x12f\n" +
+	"\vUpdateLabel\x12\x1c.tasks.v1.UpdateLabelRequest\x1a\x0f.tasks.v1.Label\"(\x82\xd3\xe4\x93\x02\":\x05label2\x19/v1/{label.name=labels/*}\x12`\n" +
+	"\vDeleteLabel\x12\x1c.tasks.v1.DeleteLabelRequest\x1a\x16.google.protobuf.Empty\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/v1/{name=labels/*}BGZEgithub.com/10Narratives/ready-to-do/contracts/gen/go/tasks/v1;tasksv1b\x06proto3"
+
+var (
+	file_proto_tasks_v1_label_service_proto_rawDescOnce sync.Once
+	file_proto_tasks_v1_label_service_proto_rawDescData []byte
+)
+
+func file_proto_tasks_v1_label_service_proto_rawDescGZIP() []byte {
+	file_proto_tasks_v1_label_service_proto_rawDescOnce.Do(func() {
+		file_proto_tasks_v1_label_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_tasks_v1_label_service_proto_rawDesc), len(file_proto_tasks_v1_label_service_proto_rawDesc)))
+	})
+	return file_proto_tasks_v1_label_service_proto_rawDescData
+}
+
+var file_proto_tasks_v1_label_service_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_proto_tasks_v1_label_service_proto_goTypes = []any{
+	(*Label)(nil),                 // 0: tasks.v1.Label
+	(*ListLabelsRequest)(nil),     // 1: tasks.v1.ListLabelsRequest
+	(*ListLabelsResponse)(nil),    // 2: tasks.v1.ListLabelsResponse
+	(*GetLabelRequest)(nil),       // 3: tasks.v1.GetLabelRequest
+	(*CreateLabelRequest)(nil),    // 4: tasks.v1.CreateLabelRequest
+	(*UpdateLabelRequest)(nil),    // 5: tasks.v1.UpdateLabelRequest
+	(*DeleteLabelRequest)(nil),    // 6: tasks.v1.DeleteLabelRequest
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil), // 8: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),         // 9: google.protobuf.Empty
+}
+var file_proto_tasks_v1_label_service_proto_depIdxs = []int32{
+	7,  // 0: tasks.v1.Label.created_at:type_name -> google.protobuf.Timestamp
+	7,  // 1: tasks.v1.Label.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: tasks.v1.ListLabelsResponse.labels:type_name -> tasks.v1.Label
+	0,  // 3: tasks.v1.CreateLabelRequest.label:type_name -> tasks.v1.Label
+	0,  // 4: tasks.v1.UpdateLabelRequest.label:type_name -> tasks.v1.Label
+	8,  // 5: tasks.v1.UpdateLabelRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 6: tasks.v1.LabelService.ListLabels:input_type -> tasks.v1.ListLabelsRequest
+	3,  // 7: tasks.v1.LabelService.GetLabel:input_type -> tasks.v1.GetLabelRequest
+	4,  // 8: tasks.v1.LabelService.CreateLabel:input_type -> tasks.v1.CreateLabelRequest
+	5,  // 9: tasks.v1.LabelService.UpdateLabel:input_type -> tasks.v1.UpdateLabelRequest
+	6,  // 10: tasks.v1.LabelService.DeleteLabel:input_type -> tasks.v1.DeleteLabelRequest
+	2,  // 11: tasks.v1.LabelService.ListLabels:output_type -> tasks.v1.ListLabelsResponse
+	0,  // 12: tasks.v1.LabelService.GetLabel:output_type -> tasks.v1.Label
+	0,  // 13: tasks.v1.LabelService.CreateLabel:output_type -> tasks.v1.Label
+	0,  // 14: tasks.v1.LabelService.UpdateLabel:output_type -> tasks.v1.Label
+	9,  // 15: tasks.v1.LabelService.DeleteLabel:output_type -> google.protobuf.Empty
+	11, // [11:16] is the sub-list for method output_type
+	6,  // [6:11] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_proto_tasks_v1_label_service_proto_init() }
+func file_proto_tasks_v1_label_service_proto_init() {
+	if File_proto_tasks_v1_label_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_tasks_v1_label_service_proto_rawDesc), len(file_proto_tasks_v1_label_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_tasks_v1_label_service_proto_goTypes,
+		DependencyIndexes: file_proto_tasks_v1_label_service_proto_depIdxs,
+		MessageInfos:      file_proto_tasks_v1_label_service_proto_msgTypes,
+	}.Build()
+	File_proto_tasks_v1_label_service_proto = out.File
+	file_proto_tasks_v1_label_service_proto_goTypes = nil
+	file_proto_tasks_v1_label_service_proto_depIdxs = nil
+}
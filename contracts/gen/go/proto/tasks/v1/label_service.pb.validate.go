@@ -0,0 +1,1012 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: proto/tasks/v1/label_service.proto
+
+package tasksv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Label with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Label) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Label with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in LabelMultiError, or nil if none found.
+func (m *Label) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Label) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if utf8.RuneCountInString(m.GetDisplayName()) > 128 {
+		err := LabelValidationError{
+			field:  "DisplayName",
+			reason: "value length must be at most 128 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetColor()) > 64 {
+		err := LabelValidationError{
+			field:  "Color",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LabelValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LabelValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LabelValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LabelValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LabelValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LabelValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return LabelMultiError(errors)
+	}
+
+	return nil
+}
+
+// LabelMultiError is an error wrapping multiple validation errors returned by
+// Label.ValidateAll() if the designated constraints aren't met.
+type LabelMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LabelMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LabelMultiError) AllErrors() []error { return m }
+
+// LabelValidationError is the validation error returned by Label.Validate if
+// the designated constraints aren't met.
+type LabelValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LabelValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LabelValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LabelValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LabelValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LabelValidationError) ErrorName() string { return "LabelValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LabelValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLabel.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LabelValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LabelValidationError{}
+
+// Validate checks the field values on ListLabelsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListLabelsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListLabelsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListLabelsRequestMultiError, or nil if none found.
+func (m *ListLabelsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListLabelsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPageSize() < 0 {
+		err := ListLabelsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListLabelsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListLabelsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListLabelsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListLabelsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListLabelsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListLabelsRequestMultiError) AllErrors() []error { return m }
+
+// ListLabelsRequestValidationError is the validation error returned by
+// ListLabelsRequest.Validate if the designated constraints aren't met.
+type ListLabelsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLabelsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLabelsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLabelsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLabelsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLabelsRequestValidationError) ErrorName() string {
+	return "ListLabelsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListLabelsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLabelsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLabelsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLabelsRequestValidationError{}
+
+// Validate checks the field values on ListLabelsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListLabelsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListLabelsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListLabelsResponseMultiError, or nil if none found.
+func (m *ListLabelsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListLabelsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLabels() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListLabelsResponseValidationError{
+						field:  fmt.Sprintf("Labels[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListLabelsResponseValidationError{
+						field:  fmt.Sprintf("Labels[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListLabelsResponseValidationError{
+					field:  fmt.Sprintf("Labels[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListLabelsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListLabelsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListLabelsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListLabelsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListLabelsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListLabelsResponseMultiError) AllErrors() []error { return m }
+
+// ListLabelsResponseValidationError is the validation error returned by
+// ListLabelsResponse.Validate if the designated constraints aren't met.
+type ListLabelsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLabelsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLabelsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLabelsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLabelsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLabelsResponseValidationError) ErrorName() string {
+	return "ListLabelsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListLabelsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLabelsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLabelsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLabelsResponseValidationError{}
+
+// Validate checks the field values on GetLabelRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetLabelRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetLabelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetLabelRequestMultiError, or nil if none found.
+func (m *GetLabelRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetLabelRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return GetLabelRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetLabelRequestMultiError is an error wrapping multiple validation errors
+// returned by GetLabelRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetLabelRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetLabelRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetLabelRequestMultiError) AllErrors() []error { return m }
+
+// GetLabelRequestValidationError is the validation error returned by
+// GetLabelRequest.Validate if the designated constraints aren't met.
+type GetLabelRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetLabelRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetLabelRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetLabelRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetLabelRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetLabelRequestValidationError) ErrorName() string { return "GetLabelRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetLabelRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetLabelRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetLabelRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetLabelRequestValidationError{}
+
+// Validate checks the field values on CreateLabelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateLabelRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateLabelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateLabelRequestMultiError, or nil if none found.
+func (m *CreateLabelRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateLabelRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_CreateLabelRequest_LabelId_Pattern.MatchString(m.GetLabelId()) {
+		err := CreateLabelRequestValidationError{
+			field:  "LabelId",
+			reason: "value does not match regex pattern \"^[a-z]([a-z0-9-]{0,61}[a-z0-9])?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLabel() == nil {
+		err := CreateLabelRequestValidationError{
+			field:  "Label",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetLabel()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateLabelRequestValidationError{
+					field:  "Label",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateLabelRequestValidationError{
+					field:  "Label",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLabel()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateLabelRequestValidationError{
+				field:  "Label",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateLabelRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateLabelRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateLabelRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateLabelRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateLabelRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateLabelRequestMultiError) AllErrors() []error { return m }
+
+// CreateLabelRequestValidationError is the validation error returned by
+// CreateLabelRequest.Validate if the designated constraints aren't met.
+type CreateLabelRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateLabelRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateLabelRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateLabelRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateLabelRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateLabelRequestValidationError) ErrorName() string {
+	return "CreateLabelRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateLabelRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateLabelRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateLabelRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateLabelRequestValidationError{}
+
+var _CreateLabelRequest_LabelId_Pattern = regexp.MustCompile("^[a-z]([a-z0-9-]{0,61}[a-z0-9])?$")
+
+// Validate checks the field values on UpdateLabelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateLabelRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateLabelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateLabelRequestMultiError, or nil if none found.
+func (m *UpdateLabelRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateLabelRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetLabel() == nil {
+		err := UpdateLabelRequestValidationError{
+			field:  "Label",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetLabel()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateLabelRequestValidationError{
+					field:  "Label",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateLabelRequestValidationError{
+					field:  "Label",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLabel()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateLabelRequestValidationError{
+				field:  "Label",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetUpdateMask() == nil {
+		err := UpdateLabelRequestValidationError{
+			field:  "UpdateMask",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateLabelRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateLabelRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateLabelRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateLabelRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateLabelRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateLabelRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateLabelRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateLabelRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateLabelRequestMultiError) AllErrors() []error { return m }
+
+// UpdateLabelRequestValidationError is the validation error returned by
+// UpdateLabelRequest.Validate if the designated constraints aren't met.
+type UpdateLabelRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateLabelRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateLabelRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateLabelRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateLabelRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateLabelRequestValidationError) ErrorName() string {
+	return "UpdateLabelRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateLabelRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateLabelRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateLabelRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateLabelRequestValidationError{}
+
+// Validate checks the field values on DeleteLabelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteLabelRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteLabelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteLabelRequestMultiError, or nil if none found.
+func (m *DeleteLabelRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteLabelRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return DeleteLabelRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteLabelRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteLabelRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteLabelRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteLabelRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteLabelRequestMultiError) AllErrors() []error { return m }
+
+// DeleteLabelRequestValidationError is the validation error returned by
+// DeleteLabelRequest.Validate if the designated constraints aren't met.
+type DeleteLabelRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteLabelRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteLabelRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteLabelRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteLabelRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteLabelRequestValidationError) ErrorName() string {
+	return "DeleteLabelRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteLabelRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteLabelRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteLabelRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteLabelRequestValidationError{}
@@ -11,7 +11,6 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
@@ -78,15 +77,98 @@ func (Project_State) EnumDescriptor() ([]byte, []int) {
 	return file_proto_tasks_v1_project_service_proto_rawDescGZIP(), []int{0, 0}
 }
 
+type WatchProjectsResponse_ChangeType int32
+
+const (
+	WatchProjectsResponse_CHANGE_TYPE_UNSPECIFIED WatchProjectsResponse_ChangeType = 0
+	// The project was created.
+	WatchProjectsResponse_CREATED WatchProjectsResponse_ChangeType = 1
+	// The project was updated, archived, unarchived, undeleted or moved.
+	WatchProjectsResponse_UPDATED WatchProjectsResponse_ChangeType = 2
+	// The project was soft-deleted or purged.
+	WatchProjectsResponse_DELETED WatchProjectsResponse_ChangeType = 3
+	// Nothing changed. Sent periodically to keep idle streams open.
+	WatchProjectsResponse_HEARTBEAT WatchProjectsResponse_ChangeType = 4
+)
+
+// Enum value maps for WatchProjectsResponse_ChangeType.
+var (
+	WatchProjectsResponse_ChangeType_name = map[int32]string{
+		0: "CHANGE_TYPE_UNSPECIFIED",
+		1: "CREATED",
+		2: "UPDATED",
+		3: "DELETED",
+		4: "HEARTBEAT",
+	}
+	WatchProjectsResponse_ChangeType_value = map[string]int32{
+		"CHANGE_TYPE_UNSPECIFIED": 0,
+		"CREATED":                 1,
+		"UPDATED":                 2,
+		"DELETED":                 3,
+		"HEARTBEAT":               4,
+	}
+)
+
+func (x WatchProjectsResponse_ChangeType) Enum() *WatchProjectsResponse_ChangeType {
+	p := new(WatchProjectsResponse_ChangeType)
+	*p = x
+	return p
+}
+
+func (x WatchProjectsResponse_ChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WatchProjectsResponse_ChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_tasks_v1_project_service_proto_enumTypes[1].Descriptor()
+}
+
+func (WatchProjectsResponse_ChangeType) Type() protoreflect.EnumType {
+	return &file_proto_tasks_v1_project_service_proto_enumTypes[1]
+}
+
+func (x WatchProjectsResponse_ChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WatchProjectsResponse_ChangeType.Descriptor instead.
+func (WatchProjectsResponse_ChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_tasks_v1_project_service_proto_rawDescGZIP(), []int{18, 0}
+}
+
 type Project struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	ColorTag      string                 `protobuf:"bytes,4,opt,name=color_tag,json=colorTag,proto3" json:"color_tag,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	State         Project_State          `protobuf:"varint,7,opt,name=state,proto3,enum=tasks.v1.Project_State" json:"state,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DisplayName string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// A hex color (#RGB, #RRGGBB or #RRGGBBAA) or one of the palette names red,
+	// orange, yellow, green, teal, blue, purple, pink, brown and gray, in any
+	// case. Stored and returned as lower-case #rrggbb; empty means no color.
+	ColorTag  string                 `protobuf:"bytes,4,opt,name=color_tag,json=colorTag,proto3" json:"color_tag,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Ignored by CreateProject, which always creates ACTIVE projects. Changed
+	// with UpdateProject, DeleteProject, UndeleteProject, ArchiveProject and
+	// UnarchiveProject.
+	State Project_State `protobuf:"varint,7,opt,name=state,proto3,enum=tasks.v1.Project_State" json:"state,omitempty"`
+	// The time the project was soft-deleted. Unset unless state is DELETED.
+	DeleteTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=delete_time,json=deleteTime,proto3" json:"delete_time,omitempty"`
+	// The time after which a soft-deleted project is permanently removed.
+	PurgeTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=purge_time,json=purgeTime,proto3" json:"purge_time,omitempty"`
+	// The rank of the project in the manual order. Positions are compared
+	// byte-wise; new projects are placed last. Changed with MoveProject.
+	Position string `protobuf:"bytes,10,opt,name=position,proto3" json:"position,omitempty"`
+	// The names of the labels assigned to the project, e.g. "labels/urgent".
+	Labels []string `protobuf:"bytes,11,rep,name=labels,proto3" json:"labels,omitempty"`
+	// An RFC 7232 entity tag of the current version of the project, e.g.
+	// "\"3\"". Changes whenever the project changes. Send it back in
+	// UpdateProject or DeleteProject to make the call fail with ABORTED if the
+	// project was changed in the meantime.
+	Etag string `protobuf:"bytes,12,opt,name=etag,proto3" json:"etag,omitempty"`
+	// The user the project belongs to, e.g. "users/{user}". Projects are only
+	// visible to their owner. Projects without an owner are shared with every
+	// user.
+	Owner         string `protobuf:"bytes,13,opt,name=owner,proto3" json:"owner,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -170,14 +252,68 @@ func (x *Project) GetState() Project_State {
 	return Project_STATE_UNSPECIFIED
 }
 
+func (x *Project) GetDeleteTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeleteTime
+	}
+	return nil
+}
+
+func (x *Project) GetPurgeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PurgeTime
+	}
+	return nil
+}
+
+func (x *Project) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *Project) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Project) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *Project) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
 type ListProjectsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	Filter        string                 `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
-	OrderBy       string                 `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	ShowArchived  bool                   `protobuf:"varint,5,opt,name=show_archived,json=showArchived,proto3" json:"show_archived,omitempty"`
-	ShowDeleted   bool                   `protobuf:"varint,6,opt,name=show_deleted,json=showDeleted,proto3" json:"show_deleted,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The maximum number of projects to return. Zero selects the server
+	// default, values above the server maximum are coerced to it.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// A page token received from a previous ListProjects call. All other
+	// request parameters must match the call that provided the token.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// An AIP-160 filter over name, display_name, description, color_tag,
+	// created_at, updated_at, state and labels, e.g.
+	// `state = ACTIVE AND display_name : "infra*"`. Labels are matched by
+	// label ID: `labels:"urgent"` selects projects labeled labels/urgent.
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// One of created_at, updated_at, display_name, state or position,
+	// optionally followed by " asc" or " desc". Defaults to "created_at".
+	OrderBy string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// Include ARCHIVED projects in the result.
+	ShowArchived bool `protobuf:"varint,5,opt,name=show_archived,json=showArchived,proto3" json:"show_archived,omitempty"`
+	// Include DELETED projects in the result.
+	ShowDeleted   bool `protobuf:"varint,6,opt,name=show_deleted,json=showDeleted,proto3" json:"show_deleted,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -351,9 +487,15 @@ func (x *GetProjectRequest) GetName() string {
 }
 
 type CreateProjectRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	Project       *Project               `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Project   *Project               `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// A UUID identifying this request. A retry with the same request_id and
+	// the same payload returns the project created by the first call instead
+	// of creating another one; reusing it with a different payload fails with
+	// FAILED_PRECONDITION. Request IDs are remembered for a server-defined
+	// time.
+	RequestId     string `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -402,9 +544,20 @@ func (x *CreateProjectRequest) GetProject() *Project {
 	return nil
 }
 
+func (x *CreateProjectRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
 type UpdateProjectRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The project to update. Its name identifies the project. If its etag is
+	// set, the update fails with ABORTED unless it matches the current etag.
+	Project *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// The fields to update: any of display_name, description, color_tag, state
+	// and labels, or "*" to replace all of them.
 	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -455,8 +608,11 @@ func (x *UpdateProjectRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 }
 
 type DeleteProjectRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The etag of the project. If set, the deletion fails with ABORTED unless
+	// it matches the current etag.
+	Etag          string `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -498,65 +654,770 @@ func (x *DeleteProjectRequest) GetName() string {
 	return ""
 }
 
+func (x *DeleteProjectRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type UndeleteProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndeleteProjectRequest) Reset() {
+	*x = UndeleteProjectRequest{}
+	mi := &file_proto_tasks_v1_project_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndeleteProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteProjectRequest) ProtoMessage() {}
+
+func (x *UndeleteProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tasks_v1_project_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteProjectRequest.ProtoReflect.Descriptor instead.
+func (*UndeleteProjectRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tasks_v1_project_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UndeleteProjectRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ArchiveProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveProjectRequest) Reset() {
+	*x = ArchiveProjectRequest{}
+	mi := &file_proto_tasks_v1_project_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveProjectRequest) ProtoMessage() {}
+
+func (x *ArchiveProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tasks_v1_project_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveProjectRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveProjectRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tasks_v1_project_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ArchiveProjectRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UnarchiveProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnarchiveProjectRequest) Reset() {
+	*x = UnarchiveProjectRequest{}
+	mi := &file_proto_tasks_v1_project_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnarchiveProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchiveProjectRequest) ProtoMessage() {}
+
+func (x *UnarchiveProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tasks_v1_project_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchiveProjectRequest.ProtoReflect.Descriptor instead.
+func (*UnarchiveProjectRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tasks_v1_project_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UnarchiveProjectRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type MoveProjectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The project the moved project is placed next to.
+	//
+	// Types that are valid to be assigned to Destination:
+	//
+	//	*MoveProjectRequest_After
+	//	*MoveProjectRequest_Before
+	Destination   isMoveProjectRequest_Destination `protobuf_oneof:"destination"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveProjectRequest) Reset() {
+	*x = MoveProjectRequest{}
+	mi := &file_proto_tasks_v1_project_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveProjectRequest) ProtoMessage() {}
+
+func (x *MoveProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tasks_v1_project_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveProjectRequest.ProtoReflect.Descriptor instead.
+func (*MoveProjectRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tasks_v1_project_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MoveProjectRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MoveProjectRequest) GetDestination() isMoveProjectRequest_Destination {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *MoveProjectRequest) GetAfter() string {
+	if x != nil {
+		if x, ok := x.Destination.(*MoveProjectRequest_After); ok {
+			return x.After
+		}
+	}
+	return ""
+}
+
+func (x *MoveProjectRequest) GetBefore() string {
+	if x != nil {
+		if x, ok := x.Destination.(*MoveProjectRequest_Before); ok {
+			return x.Before
+		}
+	}
+	return ""
+}
+
+type isMoveProjectRequest_Destination interface {
+	isMoveProjectRequest_Destination()
+}
+
+type MoveProjectRequest_After struct {
+	// Place the project right after this project.
+	After string `protobuf:"bytes,2,opt,name=after,proto3,oneof"`
+}
+
+type MoveProjectRequest_Before struct {
+	// Place the project right before this project.
+	Before string `protobuf:"bytes,3,opt,name=before,proto3,oneof"`
+}
+
+func (*MoveProjectRequest_After) isMoveProjectRequest_Destination() {}
+
+func (*MoveProjectRequest_Before) isMoveProjectRequest_Destination() {}
+
+type BatchCreateProjectsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The projects to create, at most the server-defined maximum batch size.
+	// Request IDs are not supported within batches.
+	Requests      []*CreateProjectRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreateProjectsRequest) Reset() {
+	*x = BatchCreateProjectsRequest{}
+	mi := &file_proto_tasks_v1_project_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateProjectsRequest) ProtoMessage() {}
+
+func (x *BatchCreateProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tasks_v1_project_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateProjectsRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tasks_v1_project_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BatchCreateProjectsRequest) GetRequests() []*CreateProjectRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type BatchCreateProjectsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The created projects, in the order of the requests.
+	Projects      []*Project `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreateProjectsResponse) Reset() {
+	*x = BatchCreateProjectsResponse{}
+	mi := &file_proto_tasks_v1_project_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateProjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateProjectsResponse) ProtoMessage() {}
+
+func (x *BatchCreateProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tasks_v1_project_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateProjectsResponse.ProtoReflect.Descriptor instead.
+func (*BatchCreateProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tasks_v1_project_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BatchCreateProjectsResponse) GetProjects() []*Project {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+type BatchGetProjectsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The names of the projects to get, at most the server-defined maximum
+	// batch size.
+	Names         []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetProjectsRequest) Reset() {
+	*x = BatchGetProjectsRequest{}
+	mi := &file_proto_tasks_v1_project_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetProjectsRequest) ProtoMessage() {}
+
+func (x *BatchGetProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tasks_v1_project_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetProjectsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tasks_v1_project_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BatchGetProjectsRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type BatchGetProjectsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The projects, in the order of the requested names.
+	Projects      []*Project `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetProjectsResponse) Reset() {
+	*x = BatchGetProjectsResponse{}
+	mi := &file_proto_tasks_v1_project_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetProjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetProjectsResponse) ProtoMessage() {}
+
+func (x *BatchGetProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tasks_v1_project_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetProjectsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tasks_v1_project_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BatchGetProjectsResponse) GetProjects() []*Project {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+type BatchDeleteProjectsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The names of the projects to delete, at most the server-defined maximum
+	// batch size.
+	Names         []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteProjectsRequest) Reset() {
+	*x = BatchDeleteProjectsRequest{}
+	mi := &file_proto_tasks_v1_project_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteProjectsRequest) ProtoMessage() {}
+
+func (x *BatchDeleteProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tasks_v1_project_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteProjectsRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tasks_v1_project_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BatchDeleteProjectsRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type BatchDeleteProjectsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The deleted projects, in the order of the requested names.
+	Projects      []*Project `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteProjectsResponse) Reset() {
+	*x = BatchDeleteProjectsResponse{}
+	mi := &file_proto_tasks_v1_project_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteProjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteProjectsResponse) ProtoMessage() {}
+
+func (x *BatchDeleteProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tasks_v1_project_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteProjectsResponse.ProtoReflect.Descriptor instead.
+func (*BatchDeleteProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tasks_v1_project_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BatchDeleteProjectsResponse) GetProjects() []*Project {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+type WatchProjectsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A change token received from a previous WatchProjects call. The stream
+	// starts right after the change the token was sent with. Empty starts with
+	// the changes committed after the call; the first response is a heartbeat
+	// carrying the token of that point. Fails with FAILED_PRECONDITION if the
+	// changes after the token were already discarded; list the projects again
+	// and watch without a token then.
+	ChangeToken   string `protobuf:"bytes,1,opt,name=change_token,json=changeToken,proto3" json:"change_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchProjectsRequest) Reset() {
+	*x = WatchProjectsRequest{}
+	mi := &file_proto_tasks_v1_project_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchProjectsRequest) ProtoMessage() {}
+
+func (x *WatchProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tasks_v1_project_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchProjectsRequest.ProtoReflect.Descriptor instead.
+func (*WatchProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tasks_v1_project_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *WatchProjectsRequest) GetChangeToken() string {
+	if x != nil {
+		return x.ChangeToken
+	}
+	return ""
+}
+
+type WatchProjectsResponse struct {
+	state      protoimpl.MessageState           `protogen:"open.v1"`
+	ChangeType WatchProjectsResponse_ChangeType `protobuf:"varint,1,opt,name=change_type,json=changeType,proto3,enum=tasks.v1.WatchProjectsResponse_ChangeType" json:"change_type,omitempty"`
+	// The name of the changed project. Empty for heartbeats.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The project as it was when the change was committed. Unset for
+	// heartbeats and purged projects.
+	Project *Project `protobuf:"bytes,3,opt,name=project,proto3" json:"project,omitempty"`
+	// The token to resume the stream after this response.
+	ChangeToken string `protobuf:"bytes,4,opt,name=change_token,json=changeToken,proto3" json:"change_token,omitempty"`
+	// The time the change was recorded, or the time of the heartbeat.
+	ChangeTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=change_time,json=changeTime,proto3" json:"change_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchProjectsResponse) Reset() {
+	*x = WatchProjectsResponse{}
+	mi := &file_proto_tasks_v1_project_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchProjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchProjectsResponse) ProtoMessage() {}
+
+func (x *WatchProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tasks_v1_project_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchProjectsResponse.ProtoReflect.Descriptor instead.
+func (*WatchProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tasks_v1_project_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *WatchProjectsResponse) GetChangeType() WatchProjectsResponse_ChangeType {
+	if x != nil {
+		return x.ChangeType
+	}
+	return WatchProjectsResponse_CHANGE_TYPE_UNSPECIFIED
+}
+
+func (x *WatchProjectsResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WatchProjectsResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+func (x *WatchProjectsResponse) GetChangeToken() string {
+	if x != nil {
+		return x.ChangeToken
+	}
+	return ""
+}
+
+func (x *WatchProjectsResponse) GetChangeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangeTime
+	}
+	return nil
+}
+
 var File_proto_tasks_v1_project_service_proto protoreflect.FileDescriptor
 
 const file_proto_tasks_v1_project_service_proto_rawDesc = "" +
 	"\n" +
-	"$proto/tasks/v1/project_service.proto\x12\btasks.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x17validate/validate.proto\"\xa6\x03\n" +
+	"$proto/tasks/v1/project_service.proto\x12\btasks.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19google/api/resource.proto\x1a google/protobuf/field_mask.proto\x1a\x17validate/validate.proto\"\x90\a\n" +
 	"\aProject\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12!\n" +
 	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1b\n" +
-	"\tcolor_tag\x18\x04 \x01(\tR\bcolorTag\x129\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x96\x01\n" +
+	"\tcolor_tag\x18\x04 \x01(\tBy\xfaBvrt2r^(#([0-9a-fA-F]{3}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})|(?i:red|orange|yellow|green|teal|blue|purple|pink|brown|gray))?$R\bcolorTag\x12>\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\tcreatedAt\x12>\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\tupdatedAt\x12-\n" +
+	"\x05state\x18\a \x01(\x0e2\x17.tasks.v1.Project.StateR\x05state\x12@\n" +
+	"\vdelete_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"deleteTime\x12>\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12-\n" +
-	"\x05state\x18\a \x01(\x0e2\x17.tasks.v1.Project.StateR\x05state\"E\n" +
+	"purge_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\tpurgeTime\x12\x1f\n" +
+	"\bposition\x18\n" +
+	" \x01(\tB\x03\xe0A\x03R\bposition\x12n\n" +
+	"\x06labels\x18\v \x03(\tBV\xfaA\x1b\n" +
+	"\x19tasks.readytogo.com/Label\xfaB5\x92\x012\x10@\x18\x01\",r*2(^labels/[a-z]([a-z0-9-]{0,61}[a-z0-9])?$R\x06labels\x12\x17\n" +
+	"\x04etag\x18\f \x01(\tB\x03\xe0A\x01R\x04etag\x126\n" +
+	"\x05owner\x18\r \x01(\tB \xe0A\x03\xfaA\x1a\n" +
+	"\x18tasks.readytogo.com/UserR\x05owner\"E\n" +
 	"\x05State\x12\x15\n" +
 	"\x11STATE_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
 	"\x06ACTIVE\x10\x01\x12\f\n" +
 	"\bARCHIVED\x10\x02\x12\v\n" +
 	"\aDELETED\x10\x03:4\xeaA1\n" +
-	"\x1btasks.readytogo.com/Project\x12\x12projects/{project}\"\xb1\x02\n" +
+	"\x1btasks.readytogo.com/Project\x12\x12projects/{project}\"\xc6\x02\n" +
 	"\x13ListProjectsRequest\x12'\n" +
 	"\tpage_size\x18\x01 \x01(\x05B\n" +
-	"\xe0A\x01\xfaB\x04\x1a\x02(\x01R\bpageSize\x12\"\n" +
+	"\xe0A\x01\xfaB\x04\x1a\x02(\x00R\bpageSize\x12\"\n" +
 	"\n" +
-	"page_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tpageToken\x12\x1b\n" +
-	"\x06filter\x18\x03 \x01(\tB\x03\xe0A\x01R\x06filter\x12P\n" +
-	"\border_by\x18\x04 \x01(\tB5\xe0A\x01\xfaB/r-R\n" +
-	"created_atR\n" +
-	"updated_atR\fdisplay_nameR\x05stateR\aorderBy\x12/\n" +
-	"\rshow_archived\x18\x05 \x01(\bB\n" +
-	"\xe0A\x01\xfaB\x04j\x02\b\x01R\fshowArchived\x12-\n" +
-	"\fshow_deleted\x18\x06 \x01(\bB\n" +
-	"\xe0A\x01\xfaB\x04j\x02\b\x01R\vshowDeleted\"m\n" +
+	"page_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tpageToken\x12#\n" +
+	"\x06filter\x18\x03 \x01(\tB\v\xe0A\x01\xfaB\x05r\x03\x18\x80\x10R\x06filter\x12k\n" +
+	"\border_by\x18\x04 \x01(\tBP\xe0A\x01\xfaBJrH2F^((created_at|updated_at|display_name|state|position)( (asc|desc))?)?$R\aorderBy\x12(\n" +
+	"\rshow_archived\x18\x05 \x01(\bB\x03\xe0A\x01R\fshowArchived\x12&\n" +
+	"\fshow_deleted\x18\x06 \x01(\bB\x03\xe0A\x01R\vshowDeleted\"m\n" +
 	"\x14ListProjectsResponse\x12-\n" +
 	"\bprojects\x18\x01 \x03(\v2\x11.tasks.v1.ProjectR\bprojects\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"L\n" +
 	"\x11GetProjectRequest\x127\n" +
 	"\x04name\x18\x01 \x01(\tB#\xe0A\x02\xfaA\x1d\n" +
-	"\x1btasks.readytogo.com/ProjectR\x04name\"t\n" +
+	"\x1btasks.readytogo.com/ProjectR\x04name\"\xa3\x01\n" +
 	"\x14CreateProjectRequest\x12*\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tB\v\xe0A\x02\xfaB\x05r\x03\xb0\x01\x01R\tprojectId\x120\n" +
-	"\aproject\x18\x02 \x01(\v2\x11.tasks.v1.ProjectB\x03\xe0A\x02R\aproject\"\x8a\x01\n" +
-	"\x14UpdateProjectRequest\x120\n" +
-	"\aproject\x18\x01 \x01(\v2\x11.tasks.v1.ProjectB\x03\xe0A\x02R\aproject\x12@\n" +
-	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
-	"updateMask\"O\n" +
+	"\aproject\x18\x02 \x01(\v2\x11.tasks.v1.ProjectB\x03\xe0A\x02R\aproject\x12-\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tB\x0e\xe0A\x01\xfaB\br\x06\xd0\x01\x01\xb0\x01\x01R\trequestId\"\x9a\x01\n" +
+	"\x14UpdateProjectRequest\x128\n" +
+	"\aproject\x18\x01 \x01(\v2\x11.tasks.v1.ProjectB\v\xe0A\x02\xfaB\x05\x8a\x01\x02\x10\x01R\aproject\x12H\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\v\xe0A\x02\xfaB\x05\x8a\x01\x02\x10\x01R\n" +
+	"updateMask\"o\n" +
 	"\x14DeleteProjectRequest\x127\n" +
 	"\x04name\x18\x01 \x01(\tB#\xe0A\x02\xfaA\x1d\n" +
-	"\x1btasks.readytogo.com/ProjectR\x04name2\x91\x04\n" +
+	"\x1btasks.readytogo.com/ProjectR\x04name\x12\x1e\n" +
+	"\x04etag\x18\x02 \x01(\tB\n" +
+	"\xe0A\x01\xfaB\x04r\x02\x18@R\x04etag\"Q\n" +
+	"\x16UndeleteProjectRequest\x127\n" +
+	"\x04name\x18\x01 \x01(\tB#\xe0A\x02\xfaA\x1d\n" +
+	"\x1btasks.readytogo.com/ProjectR\x04name\"P\n" +
+	"\x15ArchiveProjectRequest\x127\n" +
+	"\x04name\x18\x01 \x01(\tB#\xe0A\x02\xfaA\x1d\n" +
+	"\x1btasks.readytogo.com/ProjectR\x04name\"R\n" +
+	"\x17UnarchiveProjectRequest\x127\n" +
+	"\x04name\x18\x01 \x01(\tB#\xe0A\x02\xfaA\x1d\n" +
+	"\x1btasks.readytogo.com/ProjectR\x04name\"\xd7\x01\n" +
+	"\x12MoveProjectRequest\x127\n" +
+	"\x04name\x18\x01 \x01(\tB#\xe0A\x02\xfaA\x1d\n" +
+	"\x1btasks.readytogo.com/ProjectR\x04name\x128\n" +
+	"\x05after\x18\x02 \x01(\tB \xfaA\x1d\n" +
+	"\x1btasks.readytogo.com/ProjectH\x00R\x05after\x12:\n" +
+	"\x06before\x18\x03 \x01(\tB \xfaA\x1d\n" +
+	"\x1btasks.readytogo.com/ProjectH\x00R\x06beforeB\x12\n" +
+	"\vdestination\x12\x03\xf8B\x01\"e\n" +
+	"\x1aBatchCreateProjectsRequest\x12G\n" +
+	"\brequests\x18\x01 \x03(\v2\x1e.tasks.v1.CreateProjectRequestB\v\xe0A\x02\xfaB\x05\x92\x01\x02\b\x01R\brequests\"L\n" +
+	"\x1bBatchCreateProjectsResponse\x12-\n" +
+	"\bprojects\x18\x01 \x03(\v2\x11.tasks.v1.ProjectR\bprojects\"\\\n" +
+	"\x17BatchGetProjectsRequest\x12A\n" +
+	"\x05names\x18\x01 \x03(\tB+\xe0A\x02\xfaA\x1d\n" +
+	"\x1btasks.readytogo.com/Project\xfaB\x05\x92\x01\x02\b\x01R\x05names\"I\n" +
+	"\x18BatchGetProjectsResponse\x12-\n" +
+	"\bprojects\x18\x01 \x03(\v2\x11.tasks.v1.ProjectR\bprojects\"a\n" +
+	"\x1aBatchDeleteProjectsRequest\x12C\n" +
+	"\x05names\x18\x01 \x03(\tB-\xe0A\x02\xfaA\x1d\n" +
+	"\x1btasks.readytogo.com/Project\xfaB\a\x92\x01\x04\b\x01\x18\x01R\x05names\"L\n" +
+	"\x1bBatchDeleteProjectsResponse\x12-\n" +
+	"\bprojects\x18\x01 \x03(\v2\x11.tasks.v1.ProjectR\bprojects\"F\n" +
+	"\x14WatchProjectsRequest\x12.\n" +
+	"\fchange_token\x18\x01 \x01(\tB\v\xe0A\x01\xfaB\x05r\x03\x18\x80\x02R\vchangeToken\"\x88\x03\n" +
+	"\x15WatchProjectsResponse\x12K\n" +
+	"\vchange_type\x18\x01 \x01(\x0e2*.tasks.v1.WatchProjectsResponse.ChangeTypeR\n" +
+	"changeType\x124\n" +
+	"\x04name\x18\x02 \x01(\tB \xfaA\x1d\n" +
+	"\x1btasks.readytogo.com/ProjectR\x04name\x12+\n" +
+	"\aproject\x18\x03 \x01(\v2\x11.tasks.v1.ProjectR\aproject\x12!\n" +
+	"\fchange_token\x18\x04 \x01(\tR\vchangeToken\x12;\n" +
+	"\vchange_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"changeTime\"_\n" +
+	"\n" +
+	"ChangeType\x12\x1b\n" +
+	"\x17CHANGE_TYPE_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aCREATED\x10\x01\x12\v\n" +
+	"\aUPDATED\x10\x02\x12\v\n" +
+	"\aDELETED\x10\x03\x12\r\n" +
+	"\tHEARTBEAT\x10\x042\xca\v\n" +
 	"\x0eProjectService\x12c\n" +
-	"\fListProjects\x12\x1d.tasks.v1.ListProjectsRequest\x1a\x1e.tasks.v1.ListProjectsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/Projects\x12[\n" +
+	"\fListProjects\x12\x1d.tasks.v1.ListProjectsRequest\x1a\x1e.tasks.v1.ListProjectsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/projects\x12[\n" +
 	"\n" +
-	"GetProject\x12\x1b.tasks.v1.GetProjectRequest\x1a\x11.tasks.v1.Project\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/{name=Projects/*}\x12a\n" +
-	"\rCreateProject\x12\x1e.tasks.v1.CreateProjectRequest\x1a\x11.tasks.v1.Project\"\x1d\x82\xd3\xe4\x93\x02\x17:\aproject\"\f/v1/Projects\x12r\n" +
-	"\rUpdateProject\x12\x1e.tasks.v1.UpdateProjectRequest\x1a\x11.tasks.v1.Project\".\x82\xd3\xe4\x93\x02(:\aproject2\x1d/v1/{project.name=Projects/*}\x12f\n" +
-	"\rDeleteProject\x12\x1e.tasks.v1.DeleteProjectRequest\x1a\x16.google.protobuf.Empty\"\x1d\x82\xd3\xe4\x93\x02\x17*\x15/v1/{name=Projects/*}BGZEgithub.com/10Narratives/ready-to-do/contracts/gen/go/tasks/v1;tasksv1b\x06proto3"
+	"GetProject\x12\x1b.tasks.v1.GetProjectRequest\x1a\x11.tasks.v1.Project\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/{name=projects/*}\x12a\n" +
+	"\rCreateProject\x12\x1e.tasks.v1.CreateProjectRequest\x1a\x11.tasks.v1.Project\"\x1d\x82\xd3\xe4\x93\x02\x17:\aproject\"\f/v1/projects\x12r\n" +
+	"\rUpdateProject\x12\x1e.tasks.v1.UpdateProjectRequest\x1a\x11.tasks.v1.Project\".\x82\xd3\xe4\x93\x02(:\aproject2\x1d/v1/{project.name=projects/*}\x12a\n" +
+	"\rDeleteProject\x12\x1e.tasks.v1.DeleteProjectRequest\x1a\x11.tasks.v1.Project\"\x1d\x82\xd3\xe4\x93\x02\x17*\x15/v1/{name=projects/*}\x12q\n" +
+	"\x0fUndeleteProject\x12 .tasks.v1.UndeleteProjectRequest\x1a\x11.tasks.v1.Project\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/{name=projects/*}:undelete\x12n\n" +
+	"\x0eArchiveProject\x12\x1f.tasks.v1.ArchiveProjectRequest\x1a\x11.tasks.v1.Project\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/{name=projects/*}:archive\x12t\n" +
+	"\x10UnarchiveProject\x12!.tasks.v1.UnarchiveProjectRequest\x1a\x11.tasks.v1.Project\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/{name=projects/*}:unarchive\x12e\n" +
+	"\vMoveProject\x12\x1c.tasks.v1.MoveProjectRequest\x1a\x11.tasks.v1.Project\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/{name=projects/*}:move\x12\x87\x01\n" +
+	"\x13BatchCreateProjects\x12$.tasks.v1.BatchCreateProjectsRequest\x1a%.tasks.v1.BatchCreateProjectsResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/projects:batchCreate\x12x\n" +
+	"\x10BatchGetProjects\x12!.tasks.v1.BatchGetProjectsRequest\x1a\".tasks.v1.BatchGetProjectsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/projects:batchGet\x12\x87\x01\n" +
+	"\x13BatchDeleteProjects\x12$.tasks.v1.BatchDeleteProjectsRequest\x1a%.tasks.v1.BatchDeleteProjectsResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/projects:batchDelete\x12n\n" +
+	"\rWatchProjects\x12\x1e.tasks.v1.WatchProjectsRequest\x1a\x1f.tasks.v1.WatchProjectsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/projects:watch0\x01BGZEgithub.com/10Narratives/ready-to-do/contracts/gen/go/tasks/v1;tasksv1b\x06proto3"
 
 var (
 	file_proto_tasks_v1_project_service_proto_rawDescOnce sync.Once
@@ -570,44 +1431,81 @@ func file_proto_tasks_v1_project_service_proto_rawDescGZIP() []byte {
 	return file_proto_tasks_v1_project_service_proto_rawDescData
 }
 
-var file_proto_tasks_v1_project_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_tasks_v1_project_service_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_proto_tasks_v1_project_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_tasks_v1_project_service_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_proto_tasks_v1_project_service_proto_goTypes = []any{
-	(Project_State)(0),            // 0: tasks.v1.Project.State
-	(*Project)(nil),               // 1: tasks.v1.Project
-	(*ListProjectsRequest)(nil),   // 2: tasks.v1.ListProjectsRequest
-	(*ListProjectsResponse)(nil),  // 3: tasks.v1.ListProjectsResponse
-	(*GetProjectRequest)(nil),     // 4: tasks.v1.GetProjectRequest
-	(*CreateProjectRequest)(nil),  // 5: tasks.v1.CreateProjectRequest
-	(*UpdateProjectRequest)(nil),  // 6: tasks.v1.UpdateProjectRequest
-	(*DeleteProjectRequest)(nil),  // 7: tasks.v1.DeleteProjectRequest
-	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil), // 9: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),         // 10: google.protobuf.Empty
+	(Project_State)(0),                    // 0: tasks.v1.Project.State
+	(WatchProjectsResponse_ChangeType)(0), // 1: tasks.v1.WatchProjectsResponse.ChangeType
+	(*Project)(nil),                       // 2: tasks.v1.Project
+	(*ListProjectsRequest)(nil),           // 3: tasks.v1.ListProjectsRequest
+	(*ListProjectsResponse)(nil),          // 4: tasks.v1.ListProjectsResponse
+	(*GetProjectRequest)(nil),             // 5: tasks.v1.GetProjectRequest
+	(*CreateProjectRequest)(nil),          // 6: tasks.v1.CreateProjectRequest
+	(*UpdateProjectRequest)(nil),          // 7: tasks.v1.UpdateProjectRequest
+	(*DeleteProjectRequest)(nil),          // 8: tasks.v1.DeleteProjectRequest
+	(*UndeleteProjectRequest)(nil),        // 9: tasks.v1.UndeleteProjectRequest
+	(*ArchiveProjectRequest)(nil),         // 10: tasks.v1.ArchiveProjectRequest
+	(*UnarchiveProjectRequest)(nil),       // 11: tasks.v1.UnarchiveProjectRequest
+	(*MoveProjectRequest)(nil),            // 12: tasks.v1.MoveProjectRequest
+	(*BatchCreateProjectsRequest)(nil),    // 13: tasks.v1.BatchCreateProjectsRequest
+	(*BatchCreateProjectsResponse)(nil),   // 14: tasks.v1.BatchCreateProjectsResponse
+	(*BatchGetProjectsRequest)(nil),       // 15: tasks.v1.BatchGetProjectsRequest
+	(*BatchGetProjectsResponse)(nil),      // 16: tasks.v1.BatchGetProjectsResponse
+	(*BatchDeleteProjectsRequest)(nil),    // 17: tasks.v1.BatchDeleteProjectsRequest
+	(*BatchDeleteProjectsResponse)(nil),   // 18: tasks.v1.BatchDeleteProjectsResponse
+	(*WatchProjectsRequest)(nil),          // 19: tasks.v1.WatchProjectsRequest
+	(*WatchProjectsResponse)(nil),         // 20: tasks.v1.WatchProjectsResponse
+	(*timestamppb.Timestamp)(nil),         // 21: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),         // 22: google.protobuf.FieldMask
 }
 var file_proto_tasks_v1_project_service_proto_depIdxs = []int32{
-	8,  // 0: tasks.v1.Project.created_at:type_name -> google.protobuf.Timestamp
-	8,  // 1: tasks.v1.Project.updated_at:type_name -> google.protobuf.Timestamp
+	21, // 0: tasks.v1.Project.created_at:type_name -> google.protobuf.Timestamp
+	21, // 1: tasks.v1.Project.updated_at:type_name -> google.protobuf.Timestamp
 	0,  // 2: tasks.v1.Project.state:type_name -> tasks.v1.Project.State
-	1,  // 3: tasks.v1.ListProjectsResponse.projects:type_name -> tasks.v1.Project
-	1,  // 4: tasks.v1.CreateProjectRequest.project:type_name -> tasks.v1.Project
-	1,  // 5: tasks.v1.UpdateProjectRequest.project:type_name -> tasks.v1.Project
-	9,  // 6: tasks.v1.UpdateProjectRequest.update_mask:type_name -> google.protobuf.FieldMask
-	2,  // 7: tasks.v1.ProjectService.ListProjects:input_type -> tasks.v1.ListProjectsRequest
-	4,  // 8: tasks.v1.ProjectService.GetProject:input_type -> tasks.v1.GetProjectRequest
-	5,  // 9: tasks.v1.ProjectService.CreateProject:input_type -> tasks.v1.CreateProjectRequest
-	6,  // 10: tasks.v1.ProjectService.UpdateProject:input_type -> tasks.v1.UpdateProjectRequest
-	7,  // 11: tasks.v1.ProjectService.DeleteProject:input_type -> tasks.v1.DeleteProjectRequest
-	3,  // 12: tasks.v1.ProjectService.ListProjects:output_type -> tasks.v1.ListProjectsResponse
-	1,  // 13: tasks.v1.ProjectService.GetProject:output_type -> tasks.v1.Project
-	1,  // 14: tasks.v1.ProjectService.CreateProject:output_type -> tasks.v1.Project
-	1,  // 15: tasks.v1.ProjectService.UpdateProject:output_type -> tasks.v1.Project
-	10, // 16: tasks.v1.ProjectService.DeleteProject:output_type -> google.protobuf.Empty
-	12, // [12:17] is the sub-list for method output_type
-	7,  // [7:12] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	21, // 3: tasks.v1.Project.delete_time:type_name -> google.protobuf.Timestamp
+	21, // 4: tasks.v1.Project.purge_time:type_name -> google.protobuf.Timestamp
+	2,  // 5: tasks.v1.ListProjectsResponse.projects:type_name -> tasks.v1.Project
+	2,  // 6: tasks.v1.CreateProjectRequest.project:type_name -> tasks.v1.Project
+	2,  // 7: tasks.v1.UpdateProjectRequest.project:type_name -> tasks.v1.Project
+	22, // 8: tasks.v1.UpdateProjectRequest.update_mask:type_name -> google.protobuf.FieldMask
+	6,  // 9: tasks.v1.BatchCreateProjectsRequest.requests:type_name -> tasks.v1.CreateProjectRequest
+	2,  // 10: tasks.v1.BatchCreateProjectsResponse.projects:type_name -> tasks.v1.Project
+	2,  // 11: tasks.v1.BatchGetProjectsResponse.projects:type_name -> tasks.v1.Project
+	2,  // 12: tasks.v1.BatchDeleteProjectsResponse.projects:type_name -> tasks.v1.Project
+	1,  // 13: tasks.v1.WatchProjectsResponse.change_type:type_name -> tasks.v1.WatchProjectsResponse.ChangeType
+	2,  // 14: tasks.v1.WatchProjectsResponse.project:type_name -> tasks.v1.Project
+	21, // 15: tasks.v1.WatchProjectsResponse.change_time:type_name -> google.protobuf.Timestamp
+	3,  // 16: tasks.v1.ProjectService.ListProjects:input_type -> tasks.v1.ListProjectsRequest
+	5,  // 17: tasks.v1.ProjectService.GetProject:input_type -> tasks.v1.GetProjectRequest
+	6,  // 18: tasks.v1.ProjectService.CreateProject:input_type -> tasks.v1.CreateProjectRequest
+	7,  // 19: tasks.v1.ProjectService.UpdateProject:input_type -> tasks.v1.UpdateProjectRequest
+	8,  // 20: tasks.v1.ProjectService.DeleteProject:input_type -> tasks.v1.DeleteProjectRequest
+	9,  // 21: tasks.v1.ProjectService.UndeleteProject:input_type -> tasks.v1.UndeleteProjectRequest
+	10, // 22: tasks.v1.ProjectService.ArchiveProject:input_type -> tasks.v1.ArchiveProjectRequest
+	11, // 23: tasks.v1.ProjectService.UnarchiveProject:input_type -> tasks.v1.UnarchiveProjectRequest
+	12, // 24: tasks.v1.ProjectService.MoveProject:input_type -> tasks.v1.MoveProjectRequest
+	13, // 25: tasks.v1.ProjectService.BatchCreateProjects:input_type -> tasks.v1.BatchCreateProjectsRequest
+	15, // 26: tasks.v1.ProjectService.BatchGetProjects:input_type -> tasks.v1.BatchGetProjectsRequest
+	17, // 27: tasks.v1.ProjectService.BatchDeleteProjects:input_type -> tasks.v1.BatchDeleteProjectsRequest
+	19, // 28: tasks.v1.ProjectService.WatchProjects:input_type -> tasks.v1.WatchProjectsRequest
+	4,  // 29: tasks.v1.ProjectService.ListProjects:output_type -> tasks.v1.ListProjectsResponse
+	2,  // 30: tasks.v1.ProjectService.GetProject:output_type -> tasks.v1.Project
+	2,  // 31: tasks.v1.ProjectService.CreateProject:output_type -> tasks.v1.Project
+	2,  // 32: tasks.v1.ProjectService.UpdateProject:output_type -> tasks.v1.Project
+	2,  // 33: tasks.v1.ProjectService.DeleteProject:output_type -> tasks.v1.Project
+	2,  // 34: tasks.v1.ProjectService.UndeleteProject:output_type -> tasks.v1.Project
+	2,  // 35: tasks.v1.ProjectService.ArchiveProject:output_type -> tasks.v1.Project
+	2,  // 36: tasks.v1.ProjectService.UnarchiveProject:output_type -> tasks.v1.Project
+	2,  // 37: tasks.v1.ProjectService.MoveProject:output_type -> tasks.v1.Project
+	14, // 38: tasks.v1.ProjectService.BatchCreateProjects:output_type -> tasks.v1.BatchCreateProjectsResponse
+	16, // 39: tasks.v1.ProjectService.BatchGetProjects:output_type -> tasks.v1.BatchGetProjectsResponse
+	18, // 40: tasks.v1.ProjectService.BatchDeleteProjects:output_type -> tasks.v1.BatchDeleteProjectsResponse
+	20, // 41: tasks.v1.ProjectService.WatchProjects:output_type -> tasks.v1.WatchProjectsResponse
+	29, // [29:42] is the sub-list for method output_type
+	16, // [16:29] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_proto_tasks_v1_project_service_proto_init() }
@@ -615,13 +1513,17 @@ func file_proto_tasks_v1_project_service_proto_init() {
 	if File_proto_tasks_v1_project_service_proto != nil {
 		return
 	}
+	file_proto_tasks_v1_project_service_proto_msgTypes[10].OneofWrappers = []any{
+		(*MoveProjectRequest_After)(nil),
+		(*MoveProjectRequest_Before)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_tasks_v1_project_service_proto_rawDesc), len(file_proto_tasks_v1_project_service_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   7,
+			NumEnums:      2,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -65,7 +65,16 @@ func (m *Project) validate(all bool) error {
 
 	// no validation rules for Description
 
-	// no validation rules for ColorTag
+	if !_Project_ColorTag_Pattern.MatchString(m.GetColorTag()) {
+		err := ProjectValidationError{
+			field:  "ColorTag",
+			reason: "value does not match regex pattern \"^(#([0-9a-fA-F]{3}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})|(?i:red|orange|yellow|green|teal|blue|purple|pink|brown|gray))?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if all {
 		switch v := interface{}(m.GetCreatedAt()).(type) {
@@ -127,6 +136,112 @@ func (m *Project) validate(all bool) error {
 
 	// no validation rules for State
 
+	if all {
+		switch v := interface{}(m.GetDeleteTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProjectValidationError{
+					field:  "DeleteTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProjectValidationError{
+					field:  "DeleteTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeleteTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProjectValidationError{
+				field:  "DeleteTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPurgeTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProjectValidationError{
+					field:  "PurgeTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProjectValidationError{
+					field:  "PurgeTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPurgeTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProjectValidationError{
+				field:  "PurgeTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Position
+
+	if len(m.GetLabels()) > 64 {
+		err := ProjectValidationError{
+			field:  "Labels",
+			reason: "value must contain no more than 64 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_Project_Labels_Unique := make(map[string]struct{}, len(m.GetLabels()))
+
+	for idx, item := range m.GetLabels() {
+		_, _ = idx, item
+
+		if _, exists := _Project_Labels_Unique[item]; exists {
+			err := ProjectValidationError{
+				field:  fmt.Sprintf("Labels[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_Project_Labels_Unique[item] = struct{}{}
+		}
+
+		if !_Project_Labels_Pattern.MatchString(item) {
+			err := ProjectValidationError{
+				field:  fmt.Sprintf("Labels[%v]", idx),
+				reason: "value does not match regex pattern \"^labels/[a-z]([a-z0-9-]{0,61}[a-z0-9])?$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Etag
+
+	// no validation rules for Owner
+
 	if len(errors) > 0 {
 		return ProjectMultiError(errors)
 	}
@@ -204,6 +319,10 @@ var _ interface {
 	ErrorName() string
 } = ProjectValidationError{}
 
+var _Project_ColorTag_Pattern = regexp.MustCompile("^(#([0-9a-fA-F]{3}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})|(?i:red|orange|yellow|green|teal|blue|purple|pink|brown|gray))?$")
+
+var _Project_Labels_Pattern = regexp.MustCompile("^labels/[a-z]([a-z0-9-]{0,61}[a-z0-9])?$")
+
 // Validate checks the field values on ListProjectsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -226,10 +345,10 @@ func (m *ListProjectsRequest) validate(all bool) error {
 
 	var errors []error
 
-	if m.GetPageSize() < 1 {
+	if m.GetPageSize() < 0 {
 		err := ListProjectsRequestValidationError{
 			field:  "PageSize",
-			reason: "value must be greater than or equal to 1",
+			reason: "value must be greater than or equal to 0",
 		}
 		if !all {
 			return err
@@ -239,12 +358,10 @@ func (m *ListProjectsRequest) validate(all bool) error {
 
 	// no validation rules for PageToken
 
-	// no validation rules for Filter
-
-	if _, ok := _ListProjectsRequest_OrderBy_InLookup[m.GetOrderBy()]; !ok {
+	if utf8.RuneCountInString(m.GetFilter()) > 2048 {
 		err := ListProjectsRequestValidationError{
-			field:  "OrderBy",
-			reason: "value must be in list [created_at updated_at display_name state]",
+			field:  "Filter",
+			reason: "value length must be at most 2048 runes",
 		}
 		if !all {
 			return err
@@ -252,10 +369,10 @@ func (m *ListProjectsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetShowArchived() != true {
+	if !_ListProjectsRequest_OrderBy_Pattern.MatchString(m.GetOrderBy()) {
 		err := ListProjectsRequestValidationError{
-			field:  "ShowArchived",
-			reason: "value must equal true",
+			field:  "OrderBy",
+			reason: "value does not match regex pattern \"^((created_at|updated_at|display_name|state|position)( (asc|desc))?)?$\"",
 		}
 		if !all {
 			return err
@@ -263,16 +380,9 @@ func (m *ListProjectsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetShowDeleted() != true {
-		err := ListProjectsRequestValidationError{
-			field:  "ShowDeleted",
-			reason: "value must equal true",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for ShowArchived
+
+	// no validation rules for ShowDeleted
 
 	if len(errors) > 0 {
 		return ListProjectsRequestMultiError(errors)
@@ -354,12 +464,7 @@ var _ interface {
 	ErrorName() string
 } = ListProjectsRequestValidationError{}
 
-var _ListProjectsRequest_OrderBy_InLookup = map[string]struct{}{
-	"created_at":   {},
-	"updated_at":   {},
-	"display_name": {},
-	"state":        {},
-}
+var _ListProjectsRequest_OrderBy_Pattern = regexp.MustCompile("^((created_at|updated_at|display_name|state|position)( (asc|desc))?)?$")
 
 // Validate checks the field values on ListProjectsResponse with the rules
 // defined in the proto definition for this message. If any rules are
@@ -666,6 +771,22 @@ func (m *CreateProjectRequest) validate(all bool) error {
 		}
 	}
 
+	if m.GetRequestId() != "" {
+
+		if err := m._validateUuid(m.GetRequestId()); err != nil {
+			err = CreateProjectRequestValidationError{
+				field:  "RequestId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return CreateProjectRequestMultiError(errors)
 	}
@@ -776,6 +897,17 @@ func (m *UpdateProjectRequest) validate(all bool) error {
 
 	var errors []error
 
+	if m.GetProject() == nil {
+		err := UpdateProjectRequestValidationError{
+			field:  "Project",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if all {
 		switch v := interface{}(m.GetProject()).(type) {
 		case interface{ ValidateAll() error }:
@@ -805,6 +937,17 @@ func (m *UpdateProjectRequest) validate(all bool) error {
 		}
 	}
 
+	if m.GetUpdateMask() == nil {
+		err := UpdateProjectRequestValidationError{
+			field:  "UpdateMask",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if all {
 		switch v := interface{}(m.GetUpdateMask()).(type) {
 		case interface{ ValidateAll() error }:
@@ -938,6 +1081,17 @@ func (m *DeleteProjectRequest) validate(all bool) error {
 
 	// no validation rules for Name
 
+	if utf8.RuneCountInString(m.GetEtag()) > 64 {
+		err := DeleteProjectRequestValidationError{
+			field:  "Etag",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return DeleteProjectRequestMultiError(errors)
 	}
@@ -1017,3 +1171,1544 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = DeleteProjectRequestValidationError{}
+
+// Validate checks the field values on UndeleteProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UndeleteProjectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UndeleteProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UndeleteProjectRequestMultiError, or nil if none found.
+func (m *UndeleteProjectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UndeleteProjectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return UndeleteProjectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UndeleteProjectRequestMultiError is an error wrapping multiple validation
+// errors returned by UndeleteProjectRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UndeleteProjectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UndeleteProjectRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UndeleteProjectRequestMultiError) AllErrors() []error { return m }
+
+// UndeleteProjectRequestValidationError is the validation error returned by
+// UndeleteProjectRequest.Validate if the designated constraints aren't met.
+type UndeleteProjectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UndeleteProjectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UndeleteProjectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UndeleteProjectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UndeleteProjectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UndeleteProjectRequestValidationError) ErrorName() string {
+	return "UndeleteProjectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UndeleteProjectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUndeleteProjectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UndeleteProjectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UndeleteProjectRequestValidationError{}
+
+// Validate checks the field values on ArchiveProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ArchiveProjectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ArchiveProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ArchiveProjectRequestMultiError, or nil if none found.
+func (m *ArchiveProjectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ArchiveProjectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return ArchiveProjectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ArchiveProjectRequestMultiError is an error wrapping multiple validation
+// errors returned by ArchiveProjectRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ArchiveProjectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ArchiveProjectRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ArchiveProjectRequestMultiError) AllErrors() []error { return m }
+
+// ArchiveProjectRequestValidationError is the validation error returned by
+// ArchiveProjectRequest.Validate if the designated constraints aren't met.
+type ArchiveProjectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ArchiveProjectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ArchiveProjectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ArchiveProjectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ArchiveProjectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ArchiveProjectRequestValidationError) ErrorName() string {
+	return "ArchiveProjectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ArchiveProjectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sArchiveProjectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ArchiveProjectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ArchiveProjectRequestValidationError{}
+
+// Validate checks the field values on UnarchiveProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnarchiveProjectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnarchiveProjectRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnarchiveProjectRequestMultiError, or nil if none found.
+func (m *UnarchiveProjectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnarchiveProjectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return UnarchiveProjectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnarchiveProjectRequestMultiError is an error wrapping multiple validation
+// errors returned by UnarchiveProjectRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UnarchiveProjectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnarchiveProjectRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnarchiveProjectRequestMultiError) AllErrors() []error { return m }
+
+// UnarchiveProjectRequestValidationError is the validation error returned by
+// UnarchiveProjectRequest.Validate if the designated constraints aren't met.
+type UnarchiveProjectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnarchiveProjectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnarchiveProjectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnarchiveProjectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnarchiveProjectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnarchiveProjectRequestValidationError) ErrorName() string {
+	return "UnarchiveProjectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnarchiveProjectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnarchiveProjectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnarchiveProjectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnarchiveProjectRequestValidationError{}
+
+// Validate checks the field values on MoveProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MoveProjectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MoveProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MoveProjectRequestMultiError, or nil if none found.
+func (m *MoveProjectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MoveProjectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	oneofDestinationPresent := false
+	switch v := m.Destination.(type) {
+	case *MoveProjectRequest_After:
+		if v == nil {
+			err := MoveProjectRequestValidationError{
+				field:  "Destination",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofDestinationPresent = true
+		// no validation rules for After
+	case *MoveProjectRequest_Before:
+		if v == nil {
+			err := MoveProjectRequestValidationError{
+				field:  "Destination",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofDestinationPresent = true
+		// no validation rules for Before
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofDestinationPresent {
+		err := MoveProjectRequestValidationError{
+			field:  "Destination",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MoveProjectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoveProjectRequestMultiError is an error wrapping multiple validation errors
+// returned by MoveProjectRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MoveProjectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoveProjectRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoveProjectRequestMultiError) AllErrors() []error { return m }
+
+// MoveProjectRequestValidationError is the validation error returned by
+// MoveProjectRequest.Validate if the designated constraints aren't met.
+type MoveProjectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoveProjectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoveProjectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoveProjectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoveProjectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoveProjectRequestValidationError) ErrorName() string {
+	return "MoveProjectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MoveProjectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoveProjectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoveProjectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoveProjectRequestValidationError{}
+
+// Validate checks the field values on BatchCreateProjectsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchCreateProjectsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchCreateProjectsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchCreateProjectsRequestMultiError, or nil if none found.
+func (m *BatchCreateProjectsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchCreateProjectsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetRequests()) < 1 {
+		err := BatchCreateProjectsRequestValidationError{
+			field:  "Requests",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetRequests() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchCreateProjectsRequestValidationError{
+						field:  fmt.Sprintf("Requests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchCreateProjectsRequestValidationError{
+						field:  fmt.Sprintf("Requests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchCreateProjectsRequestValidationError{
+					field:  fmt.Sprintf("Requests[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchCreateProjectsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchCreateProjectsRequestMultiError is an error wrapping multiple
+// validation errors returned by BatchCreateProjectsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type BatchCreateProjectsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchCreateProjectsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchCreateProjectsRequestMultiError) AllErrors() []error { return m }
+
+// BatchCreateProjectsRequestValidationError is the validation error returned
+// by BatchCreateProjectsRequest.Validate if the designated constraints aren't met.
+type BatchCreateProjectsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchCreateProjectsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchCreateProjectsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchCreateProjectsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchCreateProjectsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchCreateProjectsRequestValidationError) ErrorName() string {
+	return "BatchCreateProjectsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchCreateProjectsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchCreateProjectsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchCreateProjectsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchCreateProjectsRequestValidationError{}
+
+// Validate checks the field values on BatchCreateProjectsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchCreateProjectsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchCreateProjectsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchCreateProjectsResponseMultiError, or nil if none found.
+func (m *BatchCreateProjectsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchCreateProjectsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetProjects() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchCreateProjectsResponseValidationError{
+						field:  fmt.Sprintf("Projects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchCreateProjectsResponseValidationError{
+						field:  fmt.Sprintf("Projects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchCreateProjectsResponseValidationError{
+					field:  fmt.Sprintf("Projects[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchCreateProjectsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchCreateProjectsResponseMultiError is an error wrapping multiple
+// validation errors returned by BatchCreateProjectsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type BatchCreateProjectsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchCreateProjectsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchCreateProjectsResponseMultiError) AllErrors() []error { return m }
+
+// BatchCreateProjectsResponseValidationError is the validation error returned
+// by BatchCreateProjectsResponse.Validate if the designated constraints
+// aren't met.
+type BatchCreateProjectsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchCreateProjectsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchCreateProjectsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchCreateProjectsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchCreateProjectsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchCreateProjectsResponseValidationError) ErrorName() string {
+	return "BatchCreateProjectsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchCreateProjectsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchCreateProjectsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchCreateProjectsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchCreateProjectsResponseValidationError{}
+
+// Validate checks the field values on BatchGetProjectsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetProjectsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetProjectsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetProjectsRequestMultiError, or nil if none found.
+func (m *BatchGetProjectsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetProjectsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetNames()) < 1 {
+		err := BatchGetProjectsRequestValidationError{
+			field:  "Names",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BatchGetProjectsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetProjectsRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchGetProjectsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetProjectsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetProjectsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetProjectsRequestMultiError) AllErrors() []error { return m }
+
+// BatchGetProjectsRequestValidationError is the validation error returned by
+// BatchGetProjectsRequest.Validate if the designated constraints aren't met.
+type BatchGetProjectsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetProjectsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetProjectsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetProjectsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetProjectsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetProjectsRequestValidationError) ErrorName() string {
+	return "BatchGetProjectsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetProjectsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetProjectsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetProjectsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetProjectsRequestValidationError{}
+
+// Validate checks the field values on BatchGetProjectsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetProjectsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetProjectsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetProjectsResponseMultiError, or nil if none found.
+func (m *BatchGetProjectsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetProjectsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetProjects() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchGetProjectsResponseValidationError{
+						field:  fmt.Sprintf("Projects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchGetProjectsResponseValidationError{
+						field:  fmt.Sprintf("Projects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchGetProjectsResponseValidationError{
+					field:  fmt.Sprintf("Projects[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchGetProjectsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetProjectsResponseMultiError is an error wrapping multiple validation
+// errors returned by BatchGetProjectsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetProjectsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetProjectsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetProjectsResponseMultiError) AllErrors() []error { return m }
+
+// BatchGetProjectsResponseValidationError is the validation error returned by
+// BatchGetProjectsResponse.Validate if the designated constraints aren't met.
+type BatchGetProjectsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetProjectsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetProjectsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetProjectsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetProjectsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetProjectsResponseValidationError) ErrorName() string {
+	return "BatchGetProjectsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetProjectsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetProjectsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetProjectsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetProjectsResponseValidationError{}
+
+// Validate checks the field values on BatchDeleteProjectsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchDeleteProjectsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchDeleteProjectsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchDeleteProjectsRequestMultiError, or nil if none found.
+func (m *BatchDeleteProjectsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchDeleteProjectsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetNames()) < 1 {
+		err := BatchDeleteProjectsRequestValidationError{
+			field:  "Names",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_BatchDeleteProjectsRequest_Names_Unique := make(map[string]struct{}, len(m.GetNames()))
+
+	for idx, item := range m.GetNames() {
+		_, _ = idx, item
+
+		if _, exists := _BatchDeleteProjectsRequest_Names_Unique[item]; exists {
+			err := BatchDeleteProjectsRequestValidationError{
+				field:  fmt.Sprintf("Names[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_BatchDeleteProjectsRequest_Names_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for Names[idx]
+	}
+
+	if len(errors) > 0 {
+		return BatchDeleteProjectsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchDeleteProjectsRequestMultiError is an error wrapping multiple
+// validation errors returned by BatchDeleteProjectsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type BatchDeleteProjectsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchDeleteProjectsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchDeleteProjectsRequestMultiError) AllErrors() []error { return m }
+
+// BatchDeleteProjectsRequestValidationError is the validation error returned
+// by BatchDeleteProjectsRequest.Validate if the designated constraints aren't met.
+type BatchDeleteProjectsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchDeleteProjectsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchDeleteProjectsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchDeleteProjectsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchDeleteProjectsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchDeleteProjectsRequestValidationError) ErrorName() string {
+	return "BatchDeleteProjectsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchDeleteProjectsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchDeleteProjectsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchDeleteProjectsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchDeleteProjectsRequestValidationError{}
+
+// Validate checks the field values on BatchDeleteProjectsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchDeleteProjectsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchDeleteProjectsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchDeleteProjectsResponseMultiError, or nil if none found.
+func (m *BatchDeleteProjectsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchDeleteProjectsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetProjects() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchDeleteProjectsResponseValidationError{
+						field:  fmt.Sprintf("Projects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchDeleteProjectsResponseValidationError{
+						field:  fmt.Sprintf("Projects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchDeleteProjectsResponseValidationError{
+					field:  fmt.Sprintf("Projects[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchDeleteProjectsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchDeleteProjectsResponseMultiError is an error wrapping multiple
+// validation errors returned by BatchDeleteProjectsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type BatchDeleteProjectsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchDeleteProjectsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchDeleteProjectsResponseMultiError) AllErrors() []error { return m }
+
+// BatchDeleteProjectsResponseValidationError is the validation error returned
+// by BatchDeleteProjectsResponse.Validate if the designated constraints
+// aren't met.
+type BatchDeleteProjectsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchDeleteProjectsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchDeleteProjectsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchDeleteProjectsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchDeleteProjectsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchDeleteProjectsResponseValidationError) ErrorName() string {
+	return "BatchDeleteProjectsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchDeleteProjectsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchDeleteProjectsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchDeleteProjectsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchDeleteProjectsResponseValidationError{}
+
+// Validate checks the field values on WatchProjectsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchProjectsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchProjectsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchProjectsRequestMultiError, or nil if none found.
+func (m *WatchProjectsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchProjectsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetChangeToken()) > 256 {
+		err := WatchProjectsRequestValidationError{
+			field:  "ChangeToken",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return WatchProjectsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchProjectsRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchProjectsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WatchProjectsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchProjectsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchProjectsRequestMultiError) AllErrors() []error { return m }
+
+// WatchProjectsRequestValidationError is the validation error returned by
+// WatchProjectsRequest.Validate if the designated constraints aren't met.
+type WatchProjectsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchProjectsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchProjectsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchProjectsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchProjectsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchProjectsRequestValidationError) ErrorName() string {
+	return "WatchProjectsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchProjectsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchProjectsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchProjectsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchProjectsRequestValidationError{}
+
+// Validate checks the field values on WatchProjectsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchProjectsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchProjectsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchProjectsResponseMultiError, or nil if none found.
+func (m *WatchProjectsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchProjectsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ChangeType
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetProject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchProjectsResponseValidationError{
+					field:  "Project",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchProjectsResponseValidationError{
+					field:  "Project",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchProjectsResponseValidationError{
+				field:  "Project",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ChangeToken
+
+	if all {
+		switch v := interface{}(m.GetChangeTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchProjectsResponseValidationError{
+					field:  "ChangeTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchProjectsResponseValidationError{
+					field:  "ChangeTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChangeTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchProjectsResponseValidationError{
+				field:  "ChangeTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WatchProjectsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchProjectsResponseMultiError is an error wrapping multiple validation
+// errors returned by WatchProjectsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type WatchProjectsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchProjectsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchProjectsResponseMultiError) AllErrors() []error { return m }
+
+// WatchProjectsResponseValidationError is the validation error returned by
+// WatchProjectsResponse.Validate if the designated constraints aren't met.
+type WatchProjectsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchProjectsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchProjectsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchProjectsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchProjectsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchProjectsResponseValidationError) ErrorName() string {
+	return "WatchProjectsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchProjectsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchProjectsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchProjectsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchProjectsResponseValidationError{}
@@ -0,0 +1,980 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: proto/tasks/v1/task_service.proto
+
+package tasksv1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Task_Priority int32
+
+const (
+	Task_PRIORITY_UNSPECIFIED Task_Priority = 0
+	Task_LOW                  Task_Priority = 1
+	Task_MEDIUM               Task_Priority = 2
+	Task_HIGH                 Task_Priority = 3
+	Task_URGENT               Task_Priority = 4
+)
+
+// Enum value maps for Task_Priority.
+var (
+	Task_Priority_name = map[int32]string{
+		0: "PRIORITY_UNSPECIFIED",
+		1: "LOW",
+		2: "MEDIUM",
+		3: "HIGH",
+		4: "URGENT",
+	}
+	Task_Priority_value = map[string]int32{
+		"PRIORITY_UNSPECIFIED": 0,
+		"LOW":                  1,
+		"MEDIUM":               2,
+		"HIGH":                 3,
+		"URGENT":               4,
+	}
+)
+
+func (x Task_Priority) Enum() *Task_Priority {
+	p := new(Task_Priority)
+	*p = x
+	return p
+}
+
+func (x Task_Priority) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Task_Priority) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_tasks_v1_task_service_proto_enumTypes[0].Descriptor()
+}
+
+func (Task_Priority) Type() protoreflect.EnumType {
+	return &file_proto_tasks_v1_task_service_proto_enumTypes[0]
+}
+
+func (x Task_Priority) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Task_Priority.Descriptor instead.
+func (Task_Priority) EnumDescriptor() ([]byte, []int) {
+	return file_proto_tasks_v1_task_service_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Task_State int32
+
+const (
+	Task_STATE_UNSPECIFIED Task_State = 0
+	Task_OPEN              Task_State = 1
+	Task_COMPLETED         Task_State = 2
+)
+
+// Enum value maps for Task_State.
+var (
+	Task_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "OPEN",
+		2: "COMPLETED",
+	}
+	Task_State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"OPEN":              1,
+		"COMPLETED":         2,
+	}
+)
+
+func (x Task_State) Enum() *Task_State {
+	p := new(Task_State)
+	*p = x
+	return p
+}
+
+func (x Task_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Task_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_tasks_v1_task_service_proto_enumTypes[1].Descriptor()
+}
+
+func (Task_State) Type() protoreflect.EnumType {
+	return &file_proto_tasks_v1_task_service_proto_enumTypes[1]
+}
+
+func (x Task_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Task_State.Descriptor instead.
+func (Task_State) EnumDescriptor() ([]byte, []int) {
+	return file_proto_tasks_v1_task_service_proto_rawDescGZIP(), []int{0, 1}
+}
+
+type Task struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Title string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Notes string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	// The moment the task is due.
+	DueTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=due_time,json=dueTime,proto3" json:"due_time,omitempty"`
+	// The IANA time zone the due time was set in, e.g. "Europe/Berlin".
+	// Clients use it to display the due time in the zone it was planned for.
+	DueTimeZone string        `protobuf:"bytes,5,opt,name=due_time_zone,json=dueTimeZone,proto3" json:"due_time_zone,omitempty"`
+	Priority    Task_Priority `protobuf:"varint,6,opt,name=priority,proto3,enum=tasks.v1.Task_Priority" json:"priority,omitempty"`
+	// Changed with CompleteTask and ReopenTask.
+	State Task_State `protobuf:"varint,7,opt,name=state,proto3,enum=tasks.v1.Task_State" json:"state,omitempty"`
+	// The time the task was completed. Unset unless state is COMPLETED.
+	CompletedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// The task this task is a subtask of, in the same project. Empty for
+	// top-level tasks. Set on creation and changed with MoveTask.
+	ParentTask string `protobuf:"bytes,11,opt,name=parent_task,json=parentTask,proto3" json:"parent_task,omitempty"`
+	// The number of ancestors of the task. Top-level tasks have depth 0.
+	Depth int32 `protobuf:"varint,12,opt,name=depth,proto3" json:"depth,omitempty"`
+	// The names of the labels assigned to the task, e.g. "labels/urgent".
+	Labels        []string `protobuf:"bytes,13,rep,name=labels,proto3" json:"labels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Task) Reset() {
+	*x = Task{}
+	mi := &file_proto_tasks_v1_task_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Task) ProtoMessage() {}
+
+func (x *Task) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tasks_v1_task_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Task.ProtoReflect.Descriptor instead.
+func (*Task) Descriptor() ([]byte, []int) {
+	return file_proto_tasks_v1_task_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Task) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Task) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Task) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Task) GetDueTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueTime
+	}
+	return nil
+}
+
+func (x *Task) GetDueTimeZone() string {
+	if x != nil {
+		return x.DueTimeZone
+	}
+	return ""
+}
+
+func (x *Task) GetPriority() Task_Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return Task_PRIORITY_UNSPECIFIED
+}
+
+func (x *Task) GetState() Task_State {
+	if x != nil {
+		return x.State
+	}
+	return Task_STATE_UNSPECIFIED
+}
+
+func (x *Task) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *Task) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Task) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Task) GetParentTask() string {
+	if x != nil {
+		return x.ParentTask
+	}
+	return ""
+}
+
+func (x *Task) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *Task) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type ListTasksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The project whose tasks are listed.
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The maximum number of tasks to return. Zero selects the server default,
+	// values above the server maximum are coerced to it.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// A page token received from a previous ListTasks call. All other request
+	// parameters must match the call that provided the token.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// An AIP-160 filter over title, notes, priority, state, due_time,
+	// completed_at, created_at, updated_at, parent_task, depth and labels, e.g.
+	// `state = OPEN AND priority = HIGH`. Use `parent_task = ""` to list
+	// top-level tasks only and `labels:"urgent"` to list tasks labeled
+	// labels/urgent.
+	Filter string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// One of created_at, updated_at, due_time, priority or title, optionally
+	// followed by " asc" or " desc". Defaults to "created_at".
+	OrderBy string `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// Lists the tasks as a flattened tree: every task is followed by its
+	// subtasks, depth first, with siblings in creation order. order_by must be
+	// empty.
+	Tree          bool `protobuf:"varint,6,opt,name=tree,proto3" json:"tree,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksRequest) Reset() {
+	*x = ListTasksRequest{}
+	mi := &file_proto_tasks_v1_task_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksRequest) ProtoMessage() {}
+
+func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tasks_v1_task_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListTasksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tasks_v1_task_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListTasksRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTasksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetTree() bool {
+	if x != nil {
+		return x.Tree
+	}
+	return false
+}
+
+type ListTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksResponse) Reset() {
+	*x = ListTasksResponse{}
+	mi := &file_proto_tasks_v1_task_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksResponse) ProtoMessage() {}
+
+func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tasks_v1_task_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListTasksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tasks_v1_task_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListTasksResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *ListTasksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskRequest) Reset() {
+	*x = GetTaskRequest{}
+	mi := &file_proto_tasks_v1_task_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskRequest) ProtoMessage() {}
+
+func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tasks_v1_task_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tasks_v1_task_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetTaskRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateTaskRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The project the task is created in.
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	TaskId        string `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Task          *Task  `protobuf:"bytes,3,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTaskRequest) Reset() {
+	*x = CreateTaskRequest{}
+	mi := &file_proto_tasks_v1_task_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskRequest) ProtoMessage() {}
+
+func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tasks_v1_task_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskRequest.ProtoReflect.Descriptor instead.
+func (*CreateTaskRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tasks_v1_task_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateTaskRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type UpdateTaskRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The task to update. Its name identifies the task.
+	Task *Task `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	// The fields to update: any of title, notes, due_time, due_time_zone,
+	// priority and labels, or "*" to replace all of them.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskRequest) Reset() {
+	*x = UpdateTaskRequest{}
+	mi := &file_proto_tasks_v1_task_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskRequest) ProtoMessage() {}
+
+func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tasks_v1_task_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tasks_v1_task_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateTaskRequest) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaskRequest) Reset() {
+	*x = DeleteTaskRequest{}
+	mi := &file_proto_tasks_v1_task_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskRequest) ProtoMessage() {}
+
+func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tasks_v1_task_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTaskRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tasks_v1_task_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteTaskRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CompleteTaskRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Also completes all OPEN subtasks of the task.
+	Cascade       bool `protobuf:"varint,2,opt,name=cascade,proto3" json:"cascade,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteTaskRequest) Reset() {
+	*x = CompleteTaskRequest{}
+	mi := &file_proto_tasks_v1_task_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteTaskRequest) ProtoMessage() {}
+
+func (x *CompleteTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tasks_v1_task_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteTaskRequest.ProtoReflect.Descriptor instead.
+func (*CompleteTaskRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tasks_v1_task_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CompleteTaskRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CompleteTaskRequest) GetCascade() bool {
+	if x != nil {
+		return x.Cascade
+	}
+	return false
+}
+
+type ReopenTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReopenTaskRequest) Reset() {
+	*x = ReopenTaskRequest{}
+	mi := &file_proto_tasks_v1_task_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReopenTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenTaskRequest) ProtoMessage() {}
+
+func (x *ReopenTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tasks_v1_task_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenTaskRequest.ProtoReflect.Descriptor instead.
+func (*ReopenTaskRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tasks_v1_task_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReopenTaskRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type MoveTaskRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The task to nest the moved task under. Empty moves the task to the top
+	// level.
+	DestinationParentTask string `protobuf:"bytes,2,opt,name=destination_parent_task,json=destinationParentTask,proto3" json:"destination_parent_task,omitempty"`
+	// The project to move the task to. Defaults to the project of
+	// destination_parent_task if it is set and to the current project otherwise.
+	DestinationProject string `protobuf:"bytes,3,opt,name=destination_project,json=destinationProject,proto3" json:"destination_project,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *MoveTaskRequest) Reset() {
+	*x = MoveTaskRequest{}
+	mi := &file_proto_tasks_v1_task_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveTaskRequest) ProtoMessage() {}
+
+func (x *MoveTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tasks_v1_task_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveTaskRequest.ProtoReflect.Descriptor instead.
+func (*MoveTaskRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tasks_v1_task_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MoveTaskRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MoveTaskRequest) GetDestinationParentTask() string {
+	if x != nil {
+		return x.DestinationParentTask
+	}
+	return ""
+}
+
+func (x *MoveTaskRequest) GetDestinationProject() string {
+	if x != nil {
+		return x.DestinationProject
+	}
+	return ""
+}
+
+var File_proto_tasks_v1_task_service_proto protoreflect.FileDescriptor
+
+const file_proto_tasks_v1_task_service_proto_rawDesc = "" +
+	"\n" +
+	"!proto/tasks/v1/task_service.proto\x12\btasks.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x17validate/validate.proto\"\x86\a\n" +
+	"\x04Task\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x1e\n" +
+	"\x05title\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x04R\x05title\x12\x1f\n" +
+	"\x05notes\x18\x03 \x01(\tB\t\xfaB\x06r\x04\x18\x80\x80\x01R\x05notes\x125\n" +
+	"\bdue_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\adueTime\x12+\n" +
+	"\rdue_time_zone\x18\x05 \x01(\tB\a\xfaB\x04r\x02\x18@R\vdueTimeZone\x123\n" +
+	"\bpriority\x18\x06 \x01(\x0e2\x17.tasks.v1.Task.PriorityR\bpriority\x12/\n" +
+	"\x05state\x18\a \x01(\x0e2\x14.tasks.v1.Task.StateB\x03\xe0A\x03R\x05state\x12B\n" +
+	"\fcompleted_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\vcompletedAt\x12>\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\tcreatedAt\x12>\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\tupdatedAt\x12A\n" +
+	"\vparent_task\x18\v \x01(\tB \xe0A\x05\xfaA\x1a\n" +
+	"\x18tasks.readytogo.com/TaskR\n" +
+	"parentTask\x12\x19\n" +
+	"\x05depth\x18\f \x01(\x05B\x03\xe0A\x03R\x05depth\x12n\n" +
+	"\x06labels\x18\r \x03(\tBV\xfaA\x1b\n" +
+	"\x19tasks.readytogo.com/Label\xfaB5\x92\x012\x10@\x18\x01\",r*2(^labels/[a-z]([a-z0-9-]{0,61}[a-z0-9])?$R\x06labels\"O\n" +
+	"\bPriority\x12\x18\n" +
+	"\x14PRIORITY_UNSPECIFIED\x10\x00\x12\a\n" +
+	"\x03LOW\x10\x01\x12\n" +
+	"\n" +
+	"\x06MEDIUM\x10\x02\x12\b\n" +
+	"\x04HIGH\x10\x03\x12\n" +
+	"\n" +
+	"\x06URGENT\x10\x04\"7\n" +
+	"\x05State\x12\x15\n" +
+	"\x11STATE_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04OPEN\x10\x01\x12\r\n" +
+	"\tCOMPLETED\x10\x02:>\xeaA;\n" +
+	"\x18tasks.readytogo.com/Task\x12\x1fprojects/{project}/tasks/{task}\"\xc3\x02\n" +
+	"\x10ListTasksRequest\x12;\n" +
+	"\x06parent\x18\x01 \x01(\tB#\xe0A\x02\xfaA\x1d\n" +
+	"\x1btasks.readytogo.com/ProjectR\x06parent\x12'\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\n" +
+	"\xe0A\x01\xfaB\x04\x1a\x02(\x00R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\x03\xe0A\x01R\tpageToken\x12#\n" +
+	"\x06filter\x18\x04 \x01(\tB\v\xe0A\x01\xfaB\x05r\x03\x18\x80\x10R\x06filter\x12g\n" +
+	"\border_by\x18\x05 \x01(\tBL\xe0A\x01\xfaBFrD2B^((created_at|updated_at|due_time|priority|title)( (asc|desc))?)?$R\aorderBy\x12\x17\n" +
+	"\x04tree\x18\x06 \x01(\bB\x03\xe0A\x01R\x04tree\"a\n" +
+	"\x11ListTasksResponse\x12$\n" +
+	"\x05tasks\x18\x01 \x03(\v2\x0e.tasks.v1.TaskR\x05tasks\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"F\n" +
+	"\x0eGetTaskRequest\x124\n" +
+	"\x04name\x18\x01 \x01(\tB \xe0A\x02\xfaA\x1a\n" +
+	"\x18tasks.readytogo.com/TaskR\x04name\"\xa7\x01\n" +
+	"\x11CreateTaskRequest\x12;\n" +
+	"\x06parent\x18\x01 \x01(\tB#\xe0A\x02\xfaA\x1d\n" +
+	"\x1btasks.readytogo.com/ProjectR\x06parent\x12$\n" +
+	"\atask_id\x18\x02 \x01(\tB\v\xe0A\x02\xfaB\x05r\x03\xb0\x01\x01R\x06taskId\x12/\n" +
+	"\x04task\x18\x03 \x01(\v2\x0e.tasks.v1.TaskB\v\xe0A\x02\xfaB\x05\x8a\x01\x02\x10\x01R\x04task\"\x8e\x01\n" +
+	"\x11UpdateTaskRequest\x12/\n" +
+	"\x04task\x18\x01 \x01(\v2\x0e.tasks.v1.TaskB\v\xe0A\x02\xfaB\x05\x8a\x01\x02\x10\x01R\x04task\x12H\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\v\xe0A\x02\xfaB\x05\x8a\x01\x02\x10\x01R\n" +
+	"updateMask\"I\n" +
+	"\x11DeleteTaskRequest\x124\n" +
+	"\x04name\x18\x01 \x01(\tB \xe0A\x02\xfaA\x1a\n" +
+	"\x18tasks.readytogo.com/TaskR\x04name\"j\n" +
+	"\x13CompleteTaskRequest\x124\n" +
+	"\x04name\x18\x01 \x01(\tB \xe0A\x02\xfaA\x1a\n" +
+	"\x18tasks.readytogo.com/TaskR\x04name\x12\x1d\n" +
+	"\acascade\x18\x02 \x01(\bB\x03\xe0A\x01R\acascade\"I\n" +
+	"\x11ReopenTaskRequest\x124\n" +
+	"\x04name\x18\x01 \x01(\tB \xe0A\x02\xfaA\x1a\n" +
+	"\x18tasks.readytogo.com/TaskR\x04name\"\xf7\x01\n" +
+	"\x0fMoveTaskRequest\x124\n" +
+	"\x04name\x18\x01 \x01(\tB \xe0A\x02\xfaA\x1a\n" +
+	"\x18tasks.readytogo.com/TaskR\x04name\x12X\n" +
+	"\x17destination_parent_task\x18\x02 \x01(\tB \xe0A\x01\xfaA\x1a\n" +
+	"\x18tasks.readytogo.com/TaskR\x15destinationParentTask\x12T\n" +
+	"\x13destination_project\x18\x03 \x01(\tB#\xe0A\x01\xfaA\x1d\n" +
+	"\x1btasks.readytogo.com/ProjectR\x12destinationProject2\xd9\x06\n" +
+	"\vTaskService\x12k\n" +
+	"\tListTasks\x12\x1a.tasks.v1.ListTasksRequest\x1a\x1b.tasks.v1.ListTasksResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/{parent=projects/*}/tasks\x12Z\n" +
+	"\aGetTask\x12\x18.tasks.v1.GetTaskRequest\x1a\x0e.tasks.v1.Task\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/{name=projects/*/tasks/*}\x12f\n" +
+	"\n" +
+	"CreateTask\x12\x1b.tasks.v1.CreateTaskRequest\x1a\x0e.tasks.v1.Task\"+\x82\xd3\xe4\x93\x02%:\x04task\"\x1d/v1/{parent=projects/*}/tasks\x12k\n" +
+	"\n" +
+	"UpdateTask\x12\x1b.tasks.v1.UpdateTaskRequest\x1a\x0e.tasks.v1.Task\"0\x82\xd3\xe4\x93\x02*:\x04task2\"/v1/{task.name=projects/*/tasks/*}\x12h\n" +
+	"\n" +
+	"DeleteTask\x12\x1b.tasks.v1.DeleteTaskRequest\x1a\x16.google.protobuf.Empty\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/v1/{name=projects/*/tasks/*}\x12p\n" +
+	"\fCompleteTask\x12\x1d.tasks.v1.CompleteTaskRequest\x1a\x0e.tasks.v1.Task\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/v1/{name=projects/*/tasks/*}:complete\x12j\n" +
+	"\n" +
+	"ReopenTask\x12\x1b.tasks.v1.ReopenTaskRequest\x1a\x0e.tasks.v1.Task\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/{name=projects/*/tasks/*}:reopen\x12d\n" +
+	"\bMoveTask\x12\x19.tasks.v1.MoveTaskRequest\x1a\x0e.tasks.v1.Task\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/{name=projects/*/tasks/*}:moveBGZEgithub.com/10Narratives/ready-to-do/contracts/gen/go/tasks/v1;tasksv1b\x06proto3"
+
+var (
+	file_proto_tasks_v1_task_service_proto_rawDescOnce sync.Once
+	file_proto_tasks_v1_task_service_proto_rawDescData []byte
+)
+
+func file_proto_tasks_v1_task_service_proto_rawDescGZIP() []byte {
+	file_proto_tasks_v1_task_service_proto_rawDescOnce.Do(func() {
+		file_proto_tasks_v1_task_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_tasks_v1_task_service_proto_rawDesc), len(file_proto_tasks_v1_task_service_proto_rawDesc)))
+	})
+	return file_proto_tasks_v1_task_service_proto_rawDescData
+}
+
+var file_proto_tasks_v1_task_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_tasks_v1_task_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_proto_tasks_v1_task_service_proto_goTypes = []any{
+	(Task_Priority)(0),            // 0: tasks.v1.Task.Priority
+	(Task_State)(0),               // 1: tasks.v1.Task.State
+	(*Task)(nil),                  // 2: tasks.v1.Task
+	(*ListTasksRequest)(nil),      // 3: tasks.v1.ListTasksRequest
+	(*ListTasksResponse)(nil),     // 4: tasks.v1.ListTasksResponse
+	(*GetTaskRequest)(nil),        // 5: tasks.v1.GetTaskRequest
+	(*CreateTaskRequest)(nil),     // 6: tasks.v1.CreateTaskRequest
+	(*UpdateTaskRequest)(nil),     // 7: tasks.v1.UpdateTaskRequest
+	(*DeleteTaskRequest)(nil),     // 8: tasks.v1.DeleteTaskRequest
+	(*CompleteTaskRequest)(nil),   // 9: tasks.v1.CompleteTaskRequest
+	(*ReopenTaskRequest)(nil),     // 10: tasks.v1.ReopenTaskRequest
+	(*MoveTaskRequest)(nil),       // 11: tasks.v1.MoveTaskRequest
+	(*timestamppb.Timestamp)(nil), // 12: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil), // 13: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),         // 14: google.protobuf.Empty
+}
+var file_proto_tasks_v1_task_service_proto_depIdxs = []int32{
+	12, // 0: tasks.v1.Task.due_time:type_name -> google.protobuf.Timestamp
+	0,  // 1: tasks.v1.Task.priority:type_name -> tasks.v1.Task.Priority
+	1,  // 2: tasks.v1.Task.state:type_name -> tasks.v1.Task.State
+	12, // 3: tasks.v1.Task.completed_at:type_name -> google.protobuf.Timestamp
+	12, // 4: tasks.v1.Task.created_at:type_name -> google.protobuf.Timestamp
+	12, // 5: tasks.v1.Task.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 6: tasks.v1.ListTasksResponse.tasks:type_name -> tasks.v1.Task
+	2,  // 7: tasks.v1.CreateTaskRequest.task:type_name -> tasks.v1.Task
+	2,  // 8: tasks.v1.UpdateTaskRequest.task:type_name -> tasks.v1.Task
+	13, // 9: tasks.v1.UpdateTaskRequest.update_mask:type_name -> google.protobuf.FieldMask
+	3,  // 10: tasks.v1.TaskService.ListTasks:input_type -> tasks.v1.ListTasksRequest
+	5,  // 11: tasks.v1.TaskService.GetTask:input_type -> tasks.v1.GetTaskRequest
+	6,  // 12: tasks.v1.TaskService.CreateTask:input_type -> tasks.v1.CreateTaskRequest
+	7,  // 13: tasks.v1.TaskService.UpdateTask:input_type -> tasks.v1.UpdateTaskRequest
+	8,  // 14: tasks.v1.TaskService.DeleteTask:input_type -> tasks.v1.DeleteTaskRequest
+	9,  // 15: tasks.v1.TaskService.CompleteTask:input_type -> tasks.v1.CompleteTaskRequest
+	10, // 16: tasks.v1.TaskService.ReopenTask:input_type -> tasks.v1.ReopenTaskRequest
+	11, // 17: tasks.v1.TaskService.MoveTask:input_type -> tasks.v1.MoveTaskRequest
+	4,  // 18: tasks.v1.TaskService.ListTasks:output_type -> tasks.v1.ListTasksResponse
+	2,  // 19: tasks.v1.TaskService.GetTask:output_type -> tasks.v1.Task
+	2,  // 20: tasks.v1.TaskService.CreateTask:output_type -> tasks.v1.Task
+	2,  // 21: tasks.v1.TaskService.UpdateTask:output_type -> tasks.v1.Task
+	14, // 22: tasks.v1.TaskService.DeleteTask:output_type -> google.protobuf.Empty
+	2,  // 23: tasks.v1.TaskService.CompleteTask:output_type -> tasks.v1.Task
+	2,  // 24: tasks.v1.TaskService.ReopenTask:output_type -> tasks.v1.Task
+	2,  // 25: tasks.v1.TaskService.MoveTask:output_type -> tasks.v1.Task
+	18, // [18:26] is the sub-list for method output_type
+	10, // [10:18] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_proto_tasks_v1_task_service_proto_init() }
+func file_proto_tasks_v1_task_service_proto_init() {
+	if File_proto_tasks_v1_task_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_tasks_v1_task_service_proto_rawDesc), len(file_proto_tasks_v1_task_service_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_tasks_v1_task_service_proto_goTypes,
+		DependencyIndexes: file_proto_tasks_v1_task_service_proto_depIdxs,
+		EnumInfos:         file_proto_tasks_v1_task_service_proto_enumTypes,
+		MessageInfos:      file_proto_tasks_v1_task_service_proto_msgTypes,
+	}.Build()
+	File_proto_tasks_v1_task_service_proto = out.File
+	file_proto_tasks_v1_task_service_proto_goTypes = nil
+	file_proto_tasks_v1_task_service_proto_depIdxs = nil
+}
@@ -0,0 +1,96 @@
+# -*- coding: utf-8 -*-
+# Generated by the protocol buffer compiler.  DO NOT EDIT!
+# NO CHECKED-IN PROTOBUF GENCODE
+# source: proto/tasks/v1/label_service.proto
+# Protobuf Python Version: 6.31.0
+"""Generated protocol buffer code."""
+from google.protobuf import descriptor as _descriptor
+from google.protobuf import descriptor_pool as _descriptor_pool
+from google.protobuf import runtime_version as _runtime_version
+from google.protobuf import symbol_database as _symbol_database
+from google.protobuf.internal import builder as _builder
+_runtime_version.ValidateProtobufRuntimeVersion(
+    _runtime_version.Domain.PUBLIC,
+    6,
+    31,
+    0,
+    '',
+    'proto/tasks/v1/label_service.proto'
+)
+# @@protoc_insertion_point(imports)
+
+_sym_db = _symbol_database.Default()
+
+
+from google.api import annotations_pb2 as google_dot_api_dot_annotations__pb2
+from google.api import field_behavior_pb2 as google_dot_api_dot_field__behavior__pb2
+from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__pb2
+from google.api import resource_pb2 as google_dot_api_dot_resource__pb2
+from google.protobuf import empty_pb2 as google_dot_protobuf_dot_empty__pb2
+from google.protobuf import field_mask_pb2 as google_dot_protobuf_dot_field__mask__pb2
+from validate import validate_pb2 as validate_dot_validate__pb2
+
+
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\"proto/tasks/v1/label_service.proto\x12\x08tasks.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x17validate/validate.proto\"\xec\x01\n\x05Label\x12\x11\n\x04name\x18\x01 \x01(\tB\x03\xe0\x41\x08\x12\x1e\n\x0c\x64isplay_name\x18\x02 \x01(\tB\x08\xfa\x42\x05r\x03\x18\x80\x01\x12\x16\n\x05\x63olor\x18\x03 \x01(\tB\x07\xfa\x42\x04r\x02\x18@\x12\x33\n\ncreated_at\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x03\xe0\x41\x03\x12\x33\n\nupdated_at\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x03\xe0\x41\x03:.\xea\x41+\n\x19tasks.readytogo.com/Label\x12\x0elabels/{label}\"K\n\x11ListLabelsRequest\x12\x1d\n\tpage_size\x18\x01 \x01(\x05\x42\n\xe0\x41\x01\xfa\x42\x04\x1a\x02(\x00\x12\x17\n\npage_token\x18\x02 \x01(\tB\x03\xe0\x41\x01\"N\n\x12ListLabelsResponse\x12\x1f\n\x06labels\x18\x01 \x03(\x0b\x32\x0f.tasks.v1.Label\x12\x17\n\x0fnext_page_token\x18\x02 \x01(\t\"B\n\x0fGetLabelRequest\x12/\n\x04name\x18\x01 \x01(\tB!\xe0\x41\x02\xfa\x41\x1b\n\x19tasks.readytogo.com/Label\"\x80\x01\n\x12\x43reateLabelRequest\x12=\n\x08label_id\x18\x01 \x01(\tB+\xe0\x41\x02\xfa\x42%r#2!^[a-z]([a-z0-9-]{0,61}[a-z0-9])?$\x12+\n\x05label\x18\x02 \x01(\x0b\x32\x0f.tasks.v1.LabelB\x0b\xe0\x41\x02\xfa\x42\x05\x8a\x01\x02\x10\x01\"\x7f\n\x12UpdateLabelRequest\x12+\n\x05label\x18\x01 \x01(\x0b\x32\x0f.tasks.v1.LabelB\x0b\xe0\x41\x02\xfa\x42\x05\x8a\x01\x02\x10\x01\x12<\n\x0bupdate_mask\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.FieldMaskB\x0b\xe0\x41\x02\xfa\x42\x05\x8a\x01\x02\x10\x01\"E\n\x12\x44\x65leteLabelRequest\x12/\n\x04name\x18\x01 \x01(\tB!\xe0\x41\x02\xfa\x41\x1b\n\x19tasks.readytogo.com/Label2\xe3\x03\n\x0cLabelService\x12[\n\nListLabels\x12\x1b.tasks.v1.ListLabelsRequest\x1a\x1c.tasks.v1.ListLabelsResponse\"\x12\x82\xd3\xe4\x93\x02\x0c\x12\n/v1/labels\x12S\n\x08GetLabel\x12\x19.tasks.v1.GetLabelRequest\x1a\x0f.tasks.v1.Label\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/{name=labels/*}\x12W\n\x0b\x43reateLabel\x12\x1c.tasks.v1.CreateLabelRequest\x1a\x0f.tasks.v1.Label\"\x19\x82\xd3\xe4\x93\x02\x13\"\n/v1/labels:\x05label\x12\x66\n\x0bUpdateLabel\x12\x1c.tasks.v1.UpdateLabelRequest\x1a\x0f.tasks.v1.Label\"(\x82\xd3\xe4\x93\x02\"2\x19/v1/{label.name=labels/*}:\x05label\x12`\n\x0b\x44\x65leteLabel\x12\x1c.tasks.v1.DeleteLabelRequest\x1a\x16.google.protobuf.Empty\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/v1/{name=labels/*}BGZEgithub.com/10Narratives/ready-to-do/contracts/gen/go/tasks/v1;tasksv1b\x06proto3')
+
+_globals = globals()
+_builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
+_builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'proto.tasks.v1.label_service_pb2', _globals)
+if not _descriptor._USE_C_DESCRIPTORS:
+  _globals['DESCRIPTOR']._loaded_options = None
+  _globals['DESCRIPTOR']._serialized_options = b'ZEgithub.com/10Narratives/ready-to-do/contracts/gen/go/tasks/v1;tasksv1'
+  _globals['_LABEL'].fields_by_name['name']._loaded_options = None
+  _globals['_LABEL'].fields_by_name['name']._serialized_options = b'\340A\010'
+  _globals['_LABEL'].fields_by_name['display_name']._loaded_options = None
+  _globals['_LABEL'].fields_by_name['display_name']._serialized_options = b'\372B\005r\003\030\200\001'
+  _globals['_LABEL'].fields_by_name['color']._loaded_options = None
+  _globals['_LABEL'].fields_by_name['color']._serialized_options = b'\372B\004r\002\030@'
+  _globals['_LABEL'].fields_by_name['created_at']._loaded_options = None
+  _globals['_LABEL'].fields_by_name['created_at']._serialized_options = b'\340A\003'
+  _globals['_LABEL'].fields_by_name['updated_at']._loaded_options = None
+  _globals['_LABEL'].fields_by_name['updated_at']._serialized_options = b'\340A\003'
+  _globals['_LABEL']._loaded_options = None
+  _globals['_LABEL']._serialized_options = b'\352A+\n\031tasks.readytogo.com/Label\022\016labels/{label}'
+  _globals['_LISTLABELSREQUEST'].fields_by_name['page_size']._loaded_options = None
+  _globals['_LISTLABELSREQUEST'].fields_by_name['page_size']._serialized_options = b'\340A\001\372B\004\032\002(\000'
+  _globals['_LISTLABELSREQUEST'].fields_by_name['page_token']._loaded_options = None
+  _globals['_LISTLABELSREQUEST'].fields_by_name['page_token']._serialized_options = b'\340A\001'
+  _globals['_GETLABELREQUEST'].fields_by_name['name']._loaded_options = None
+  _globals['_GETLABELREQUEST'].fields_by_name['name']._serialized_options = b'\340A\002\372A\033\n\031tasks.readytogo.com/Label'
+  _globals['_CREATELABELREQUEST'].fields_by_name['label_id']._loaded_options = None
+  _globals['_CREATELABELREQUEST'].fields_by_name['label_id']._serialized_options = b'\340A\002\372B%r#2!^[a-z]([a-z0-9-]{0,61}[a-z0-9])?$'
+  _globals['_CREATELABELREQUEST'].fields_by_name['label']._loaded_options = None
+  _globals['_CREATELABELREQUEST'].fields_by_name['label']._serialized_options = b'\340A\002\372B\005\212\001\002\020\001'
+  _globals['_UPDATELABELREQUEST'].fields_by_name['label']._loaded_options = None
+  _globals['_UPDATELABELREQUEST'].fields_by_name['label']._serialized_options = b'\340A\002\372B\005\212\001\002\020\001'
+  _globals['_UPDATELABELREQUEST'].fields_by_name['update_mask']._loaded_options = None
+  _globals['_UPDATELABELREQUEST'].fields_by_name['update_mask']._serialized_options = b'\340A\002\372B\005\212\001\002\020\001'
+  _globals['_DELETELABELREQUEST'].fields_by_name['name']._loaded_options = None
+  _globals['_DELETELABELREQUEST'].fields_by_name['name']._serialized_options = b'\340A\002\372A\033\n\031tasks.readytogo.com/Label'
+  _globals['_LABELSERVICE'].methods_by_name['ListLabels']._loaded_options = None
+  _globals['_LABELSERVICE'].methods_by_name['ListLabels']._serialized_options = b'\202\323\344\223\002\014\022\n/v1/labels'
+  _globals['_LABELSERVICE'].methods_by_name['GetLabel']._loaded_options = None
+  _globals['_LABELSERVICE'].methods_by_name['GetLabel']._serialized_options = b'\202\323\344\223\002\025\022\023/v1/{name=labels/*}'
+  _globals['_LABELSERVICE'].methods_by_name['CreateLabel']._loaded_options = None
+  _globals['_LABELSERVICE'].methods_by_name['CreateLabel']._serialized_options = b'\202\323\344\223\002\023\"\n/v1/labels:\005label'
+  _globals['_LABELSERVICE'].methods_by_name['UpdateLabel']._loaded_options = None
+  _globals['_LABELSERVICE'].methods_by_name['UpdateLabel']._serialized_options = b'\202\323\344\223\002\"2\031/v1/{label.name=labels/*}:\005label'
+  _globals['_LABELSERVICE'].methods_by_name['DeleteLabel']._loaded_options = None
+  _globals['_LABELSERVICE'].methods_by_name['DeleteLabel']._serialized_options = b'\202\323\344\223\002\025*\023/v1/{name=labels/*}'
+  _globals['_LABEL']._serialized_start=260
+  _globals['_LABEL']._serialized_end=496
+  _globals['_LISTLABELSREQUEST']._serialized_start=498
+  _globals['_LISTLABELSREQUEST']._serialized_end=573
+  _globals['_LISTLABELSRESPONSE']._serialized_start=575
+  _globals['_LISTLABELSRESPONSE']._serialized_end=653
+  _globals['_GETLABELREQUEST']._serialized_start=655
+  _globals['_GETLABELREQUEST']._serialized_end=721
+  _globals['_CREATELABELREQUEST']._serialized_start=724
+  _globals['_CREATELABELREQUEST']._serialized_end=852
+  _globals['_UPDATELABELREQUEST']._serialized_start=854
+  _globals['_UPDATELABELREQUEST']._serialized_end=981
+  _globals['_DELETELABELREQUEST']._serialized_start=983
+  _globals['_DELETELABELREQUEST']._serialized_end=1052
+  _globals['_LABELSERVICE']._serialized_start=1055
+  _globals['_LABELSERVICE']._serialized_end=1538
+# @@protoc_insertion_point(module_scope)
@@ -0,0 +1,285 @@
+# Generated by the gRPC Python protocol compiler plugin. DO NOT EDIT!
+"""Client and server classes corresponding to protobuf-defined services."""
+import grpc
+import warnings
+
+from google.protobuf import empty_pb2 as google_dot_protobuf_dot_empty__pb2
+from proto.tasks.v1 import label_service_pb2 as proto_dot_tasks_dot_v1_dot_label__service__pb2
+
+GRPC_GENERATED_VERSION = '1.73.1'
+GRPC_VERSION = grpc.__version__
+_version_not_supported = False
+
+try:
+    from grpc._utilities import first_version_is_lower
+    _version_not_supported = first_version_is_lower(GRPC_VERSION, GRPC_GENERATED_VERSION)
+except ImportError:
+    _version_not_supported = True
+
+if _version_not_supported:
+    raise RuntimeError(
+        f'The grpc package installed is at version {GRPC_VERSION},'
+        + f' but the generated code in proto/tasks/v1/label_service_pb2_grpc.py depends on'
+        + f' grpcio>={GRPC_GENERATED_VERSION}.'
+        + f' Please upgrade your grpc module to grpcio>={GRPC_GENERATED_VERSION}'
+        + f' or downgrade your generated code using grpcio-tools<={GRPC_VERSION}.'
+    )
+
+
+class LabelServiceStub(object):
+    """LabelService is the service for managing labels. Labels categorize projects
+    and tasks across projects; they are assigned with the labels field of a
+    project or a task.
+    """
+
+    def __init__(self, channel):
+        """Constructor.
+
+        Args:
+            channel: A grpc.Channel.
+        """
+        self.ListLabels = channel.unary_unary(
+                '/tasks.v1.LabelService/ListLabels',
+                request_serializer=proto_dot_tasks_dot_v1_dot_label__service__pb2.ListLabelsRequest.SerializeToString,
+                response_deserializer=proto_dot_tasks_dot_v1_dot_label__service__pb2.ListLabelsResponse.FromString,
+                _registered_method=True)
+        self.GetLabel = channel.unary_unary(
+                '/tasks.v1.LabelService/GetLabel',
+                request_serializer=proto_dot_tasks_dot_v1_dot_label__service__pb2.GetLabelRequest.SerializeToString,
+                response_deserializer=proto_dot_tasks_dot_v1_dot_label__service__pb2.Label.FromString,
+                _registered_method=True)
+        self.CreateLabel = channel.unary_unary(
+                '/tasks.v1.LabelService/CreateLabel',
+                request_serializer=proto_dot_tasks_dot_v1_dot_label__service__pb2.CreateLabelRequest.SerializeToString,
+                response_deserializer=proto_dot_tasks_dot_v1_dot_label__service__pb2.Label.FromString,
+                _registered_method=True)
+        self.UpdateLabel = channel.unary_unary(
+                '/tasks.v1.LabelService/UpdateLabel',
+                request_serializer=proto_dot_tasks_dot_v1_dot_label__service__pb2.UpdateLabelRequest.SerializeToString,
+                response_deserializer=proto_dot_tasks_dot_v1_dot_label__service__pb2.Label.FromString,
+                _registered_method=True)
+        self.DeleteLabel = channel.unary_unary(
+                '/tasks.v1.LabelService/DeleteLabel',
+                request_serializer=proto_dot_tasks_dot_v1_dot_label__service__pb2.DeleteLabelRequest.SerializeToString,
+                response_deserializer=google_dot_protobuf_dot_empty__pb2.Empty.FromString,
+                _registered_method=True)
+
+
+class LabelServiceServicer(object):
+    """LabelService is the service for managing labels. Labels categorize projects
+    and tasks across projects; they are assigned with the labels field of a
+    project or a task.
+    """
+
+    def ListLabels(self, request, context):
+        """ListLabels lists labels ordered by name.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetLabel(self, request, context):
+        """GetLabel gets a label.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def CreateLabel(self, request, context):
+        """CreateLabel creates a label.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def UpdateLabel(self, request, context):
+        """UpdateLabel updates a label. Assignments keep referring to the label.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeleteLabel(self, request, context):
+        """DeleteLabel permanently deletes a label and removes it from all projects
+        and tasks it is assigned to.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+
+def add_LabelServiceServicer_to_server(servicer, server):
+    rpc_method_handlers = {
+            'ListLabels': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListLabels,
+                    request_deserializer=proto_dot_tasks_dot_v1_dot_label__service__pb2.ListLabelsRequest.FromString,
+                    response_serializer=proto_dot_tasks_dot_v1_dot_label__service__pb2.ListLabelsResponse.SerializeToString,
+            ),
+            'GetLabel': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetLabel,
+                    request_deserializer=proto_dot_tasks_dot_v1_dot_label__service__pb2.GetLabelRequest.FromString,
+                    response_serializer=proto_dot_tasks_dot_v1_dot_label__service__pb2.Label.SerializeToString,
+            ),
+            'CreateLabel': grpc.unary_unary_rpc_method_handler(
+                    servicer.CreateLabel,
+                    request_deserializer=proto_dot_tasks_dot_v1_dot_label__service__pb2.CreateLabelRequest.FromString,
+                    response_serializer=proto_dot_tasks_dot_v1_dot_label__service__pb2.Label.SerializeToString,
+            ),
+            'UpdateLabel': grpc.unary_unary_rpc_method_handler(
+                    servicer.UpdateLabel,
+                    request_deserializer=proto_dot_tasks_dot_v1_dot_label__service__pb2.UpdateLabelRequest.FromString,
+                    response_serializer=proto_dot_tasks_dot_v1_dot_label__service__pb2.Label.SerializeToString,
+            ),
+            'DeleteLabel': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeleteLabel,
+                    request_deserializer=proto_dot_tasks_dot_v1_dot_label__service__pb2.DeleteLabelRequest.FromString,
+                    response_serializer=google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
+            ),
+    }
+    generic_handler = grpc.method_handlers_generic_handler(
+            'tasks.v1.LabelService', rpc_method_handlers)
+    server.add_generic_rpc_handlers((generic_handler,))
+    server.add_registered_method_handlers('tasks.v1.LabelService', rpc_method_handlers)
+
+
+ # This class is part of an EXPERIMENTAL API.
+class LabelService(object):
+    """LabelService is the service for managing labels. Labels categorize projects
+    and tasks across projects; they are assigned with the labels field of a
+    project or a task.
+    """
+
+    @staticmethod
+    def ListLabels(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/tasks.v1.LabelService/ListLabels',
+            proto_dot_tasks_dot_v1_dot_label__service__pb2.ListLabelsRequest.SerializeToString,
+            proto_dot_tasks_dot_v1_dot_label__service__pb2.ListLabelsResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def GetLabel(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/tasks.v1.LabelService/GetLabel',
+            proto_dot_tasks_dot_v1_dot_label__service__pb2.GetLabelRequest.SerializeToString,
+            proto_dot_tasks_dot_v1_dot_label__service__pb2.Label.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def CreateLabel(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/tasks.v1.LabelService/CreateLabel',
+            proto_dot_tasks_dot_v1_dot_label__service__pb2.CreateLabelRequest.SerializeToString,
+            proto_dot_tasks_dot_v1_dot_label__service__pb2.Label.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def UpdateLabel(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/tasks.v1.LabelService/UpdateLabel',
+            proto_dot_tasks_dot_v1_dot_label__service__pb2.UpdateLabelRequest.SerializeToString,
+            proto_dot_tasks_dot_v1_dot_label__service__pb2.Label.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def DeleteLabel(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/tasks.v1.LabelService/DeleteLabel',
+            proto_dot_tasks_dot_v1_dot_label__service__pb2.DeleteLabelRequest.SerializeToString,
+            google_dot_protobuf_dot_empty__pb2.Empty.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
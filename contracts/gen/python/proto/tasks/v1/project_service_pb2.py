@@ -26,12 +26,11 @@ from google.api import annotations_pb2 as google_dot_api_dot_annotations__pb2
 from google.api import field_behavior_pb2 as google_dot_api_dot_field__behavior__pb2
 from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__pb2
 from google.api import resource_pb2 as google_dot_api_dot_resource__pb2
-from google.protobuf import empty_pb2 as google_dot_protobuf_dot_empty__pb2
 from google.protobuf import field_mask_pb2 as google_dot_protobuf_dot_field__mask__pb2
 from validate import validate_pb2 as validate_dot_validate__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n$proto/tasks/v1/project_service.proto\x12\x08tasks.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x17validate/validate.proto\"\xdf\x02\n\x07Project\x12\x11\n\x04name\x18\x01 \x01(\tB\x03\xe0\x41\x08\x12\x14\n\x0c\x64isplay_name\x18\x02 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x03 \x01(\t\x12\x11\n\tcolor_tag\x18\x04 \x01(\t\x12.\n\ncreated_at\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\nupdated_at\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12&\n\x05state\x18\x07 \x01(\x0e\x32\x17.tasks.v1.Project.State\"E\n\x05State\x12\x15\n\x11STATE_UNSPECIFIED\x10\x00\x12\n\n\x06\x41\x43TIVE\x10\x01\x12\x0c\n\x08\x41RCHIVED\x10\x02\x12\x0b\n\x07\x44\x45LETED\x10\x03:4\xea\x41\x31\n\x1btasks.readytogo.com/Project\x12\x12projects/{project}\"\xf0\x01\n\x13ListProjectsRequest\x12\x1d\n\tpage_size\x18\x01 \x01(\x05\x42\n\xe0\x41\x01\xfa\x42\x04\x1a\x02(\x01\x12\x17\n\npage_token\x18\x02 \x01(\tB\x03\xe0\x41\x01\x12\x13\n\x06\x66ilter\x18\x03 \x01(\tB\x03\xe0\x41\x01\x12G\n\x08order_by\x18\x04 \x01(\tB5\xe0\x41\x01\xfa\x42/r-R\ncreated_atR\nupdated_atR\x0c\x64isplay_nameR\x05state\x12!\n\rshow_archived\x18\x05 \x01(\x08\x42\n\xe0\x41\x01\xfa\x42\x04j\x02\x08\x01\x12 \n\x0cshow_deleted\x18\x06 \x01(\x08\x42\n\xe0\x41\x01\xfa\x42\x04j\x02\x08\x01\"T\n\x14ListProjectsResponse\x12#\n\x08projects\x18\x01 \x03(\x0b\x32\x11.tasks.v1.Project\x12\x17\n\x0fnext_page_token\x18\x02 \x01(\t\"F\n\x11GetProjectRequest\x12\x31\n\x04name\x18\x01 \x01(\tB#\xe0\x41\x02\xfa\x41\x1d\n\x1btasks.readytogo.com/Project\"`\n\x14\x43reateProjectRequest\x12\x1f\n\nproject_id\x18\x01 \x01(\tB\x0b\xe0\x41\x02\xfa\x42\x05r\x03\xb0\x01\x01\x12\'\n\x07project\x18\x02 \x01(\x0b\x32\x11.tasks.v1.ProjectB\x03\xe0\x41\x02\"u\n\x14UpdateProjectRequest\x12\'\n\x07project\x18\x01 \x01(\x0b\x32\x11.tasks.v1.ProjectB\x03\xe0\x41\x02\x12\x34\n\x0bupdate_mask\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.FieldMaskB\x03\xe0\x41\x02\"I\n\x14\x44\x65leteProjectRequest\x12\x31\n\x04name\x18\x01 \x01(\tB#\xe0\x41\x02\xfa\x41\x1d\n\x1btasks.readytogo.com/Project2\x91\x04\n\x0eProjectService\x12\x63\n\x0cListProjects\x12\x1d.tasks.v1.ListProjectsRequest\x1a\x1e.tasks.v1.ListProjectsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\x0c/v1/Projects\x12[\n\nGetProject\x12\x1b.tasks.v1.GetProjectRequest\x1a\x11.tasks.v1.Project\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/{name=Projects/*}\x12\x61\n\rCreateProject\x12\x1e.tasks.v1.CreateProjectRequest\x1a\x11.tasks.v1.Project\"\x1d\x82\xd3\xe4\x93\x02\x17\"\x0c/v1/Projects:\x07project\x12r\n\rUpdateProject\x12\x1e.tasks.v1.UpdateProjectRequest\x1a\x11.tasks.v1.Project\".\x82\xd3\xe4\x93\x02(2\x1d/v1/{project.name=Projects/*}:\x07project\x12\x66\n\rDeleteProject\x12\x1e.tasks.v1.DeleteProjectRequest\x1a\x16.google.protobuf.Empty\"\x1d\x82\xd3\xe4\x93\x02\x17*\x15/v1/{name=Projects/*}BGZEgithub.com/10Narratives/ready-to-do/contracts/gen/go/tasks/v1;tasksv1b\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n$proto/tasks/v1/project_service.proto\x12\x08tasks.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19google/api/resource.proto\x1a google/protobuf/field_mask.proto\x1a\x17validate/validate.proto\"\x93\x06\n\x07Project\x12\x11\n\x04name\x18\x01 \x01(\tB\x03\xe0\x41\x08\x12\x14\n\x0c\x64isplay_name\x18\x02 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x03 \x01(\t\x12\x8c\x01\n\tcolor_tag\x18\x04 \x01(\tBy\xfa\x42vrt2r^(#([0-9a-fA-F]{3}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})|(?i:red|orange|yellow|green|teal|blue|purple|pink|brown|gray))?$\x12\x33\n\ncreated_at\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x03\xe0\x41\x03\x12\x33\n\nupdated_at\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x03\xe0\x41\x03\x12&\n\x05state\x18\x07 \x01(\x0e\x32\x17.tasks.v1.Project.State\x12\x34\n\x0b\x64\x65lete_time\x18\x08 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x03\xe0\x41\x03\x12\x33\n\npurge_time\x18\t \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x03\xe0\x41\x03\x12\x15\n\x08position\x18\n \x01(\tB\x03\xe0\x41\x03\x12\x66\n\x06labels\x18\x0b \x03(\tBV\xfa\x41\x1b\n\x19tasks.readytogo.com/Label\xfa\x42\x35\x92\x01\x32\x10@\x18\x01\",r*2(^labels/[a-z]([a-z0-9-]{0,61}[a-z0-9])?$\x12\x11\n\x04\x65tag\x18\x0c \x01(\tB\x03\xe0\x41\x01\x12/\n\x05owner\x18\r \x01(\tB \xe0\x41\x03\xfa\x41\x1a\n\x18tasks.readytogo.com/User\"E\n\x05State\x12\x15\n\x11STATE_UNSPECIFIED\x10\x00\x12\n\n\x06\x41\x43TIVE\x10\x01\x12\x0c\n\x08\x41RCHIVED\x10\x02\x12\x0b\n\x07\x44\x45LETED\x10\x03:4\xea\x41\x31\n\x1btasks.readytogo.com/Project\x12\x12projects/{project}\"\x85\x02\n\x13ListProjectsRequest\x12\x1d\n\tpage_size\x18\x01 \x01(\x05\x42\n\xe0\x41\x01\xfa\x42\x04\x1a\x02(\x00\x12\x17\n\npage_token\x18\x02 \x01(\tB\x03\xe0\x41\x01\x12\x1b\n\x06\x66ilter\x18\x03 \x01(\tB\x0b\xe0\x41\x01\xfa\x42\x05r\x03\x18\x80\x10\x12\x62\n\x08order_by\x18\x04 \x01(\tBP\xe0\x41\x01\xfa\x42JrH2F^((created_at|updated_at|display_name|state|position)( (asc|desc))?)?$\x12\x1a\n\rshow_archived\x18\x05 \x01(\x08\x42\x03\xe0\x41\x01\x12\x19\n\x0cshow_deleted\x18\x06 \x01(\x08\x42\x03\xe0\x41\x01\"T\n\x14ListProjectsResponse\x12#\n\x08projects\x18\x01 \x03(\x0b\x32\x11.tasks.v1.Project\x12\x17\n\x0fnext_page_token\x18\x02 \x01(\t\"F\n\x11GetProjectRequest\x12\x31\n\x04name\x18\x01 \x01(\tB#\xe0\x41\x02\xfa\x41\x1d\n\x1btasks.readytogo.com/Project\"\x84\x01\n\x14\x43reateProjectRequest\x12\x1f\n\nproject_id\x18\x01 \x01(\tB\x0b\xe0\x41\x02\xfa\x42\x05r\x03\xb0\x01\x01\x12\'\n\x07project\x18\x02 \x01(\x0b\x32\x11.tasks.v1.ProjectB\x03\xe0\x41\x02\x12\"\n\nrequest_id\x18\x03 \x01(\tB\x0e\xe0\x41\x01\xfa\x42\x08r\x06\xb0\x01\x01\xd0\x01\x01\"\x85\x01\n\x14UpdateProjectRequest\x12/\n\x07project\x18\x01 \x01(\x0b\x32\x11.tasks.v1.ProjectB\x0b\xe0\x41\x02\xfa\x42\x05\x8a\x01\x02\x10\x01\x12<\n\x0bupdate_mask\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.FieldMaskB\x0b\xe0\x41\x02\xfa\x42\x05\x8a\x01\x02\x10\x01\"c\n\x14\x44\x65leteProjectRequest\x12\x31\n\x04name\x18\x01 \x01(\tB#\xe0\x41\x02\xfa\x41\x1d\n\x1btasks.readytogo.com/Project\x12\x18\n\x04\x65tag\x18\x02 \x01(\tB\n\xe0\x41\x01\xfa\x42\x04r\x02\x18@\"K\n\x16UndeleteProjectRequest\x12\x31\n\x04name\x18\x01 \x01(\tB#\xe0\x41\x02\xfa\x41\x1d\n\x1btasks.readytogo.com/Project\"J\n\x15\x41rchiveProjectRequest\x12\x31\n\x04name\x18\x01 \x01(\tB#\xe0\x41\x02\xfa\x41\x1d\n\x1btasks.readytogo.com/Project\"L\n\x17UnarchiveProjectRequest\x12\x31\n\x04name\x18\x01 \x01(\tB#\xe0\x41\x02\xfa\x41\x1d\n\x1btasks.readytogo.com/Project\"\xc2\x01\n\x12MoveProjectRequest\x12\x31\n\x04name\x18\x01 \x01(\tB#\xe0\x41\x02\xfa\x41\x1d\n\x1btasks.readytogo.com/Project\x12\x31\n\x05\x61\x66ter\x18\x02 \x01(\tB \xfa\x41\x1d\n\x1btasks.readytogo.com/ProjectH\x00\x12\x32\n\x06\x62\x65\x66ore\x18\x03 \x01(\tB \xfa\x41\x1d\n\x1btasks.readytogo.com/ProjectH\x00\x42\x12\n\x0b\x64\x65stination\x12\x03\xf8\x42\x01\"[\n\x1a\x42\x61tchCreateProjectsRequest\x12=\n\x08requests\x18\x01 \x03(\x0b\x32\x1e.tasks.v1.CreateProjectRequestB\x0b\xe0\x41\x02\xfa\x42\x05\x92\x01\x02\x08\x01\"B\n\x1b\x42\x61tchCreateProjectsResponse\x12#\n\x08projects\x18\x01 \x03(\x0b\x32\x11.tasks.v1.Project\"U\n\x17\x42\x61tchGetProjectsRequest\x12:\n\x05names\x18\x01 \x03(\tB+\xe0\x41\x02\xfa\x41\x1d\n\x1btasks.readytogo.com/Project\xfa\x42\x05\x92\x01\x02\x08\x01\"?\n\x18\x42\x61tchGetProjectsResponse\x12#\n\x08projects\x18\x01 \x03(\x0b\x32\x11.tasks.v1.Project\"Z\n\x1a\x42\x61tchDeleteProjectsRequest\x12<\n\x05names\x18\x01 \x03(\tB-\xe0\x41\x02\xfa\x41\x1d\n\x1btasks.readytogo.com/Project\xfa\x42\x07\x92\x01\x04\x08\x01\x18\x01\"B\n\x1b\x42\x61tchDeleteProjectsResponse\x12#\n\x08projects\x18\x01 \x03(\x0b\x32\x11.tasks.v1.Project\"9\n\x14WatchProjectsRequest\x12!\n\x0c\x63hange_token\x18\x01 \x01(\tB\x0b\xe0\x41\x01\xfa\x42\x05r\x03\x18\x80\x02\"\xd4\x02\n\x15WatchProjectsResponse\x12?\n\x0b\x63hange_type\x18\x01 \x01(\x0e\x32*.tasks.v1.WatchProjectsResponse.ChangeType\x12.\n\x04name\x18\x02 \x01(\tB \xfa\x41\x1d\n\x1btasks.readytogo.com/Project\x12\"\n\x07project\x18\x03 \x01(\x0b\x32\x11.tasks.v1.Project\x12\x14\n\x0c\x63hange_token\x18\x04 \x01(\t\x12/\n\x0b\x63hange_time\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"_\n\nChangeType\x12\x1b\n\x17\x43HANGE_TYPE_UNSPECIFIED\x10\x00\x12\x0b\n\x07\x43REATED\x10\x01\x12\x0b\n\x07UPDATED\x10\x02\x12\x0b\n\x07\x44\x45LETED\x10\x03\x12\r\n\tHEARTBEAT\x10\x04\x32\xca\x0b\n\x0eProjectService\x12\x63\n\x0cListProjects\x12\x1d.tasks.v1.ListProjectsRequest\x1a\x1e.tasks.v1.ListProjectsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\x0c/v1/projects\x12[\n\nGetProject\x12\x1b.tasks.v1.GetProjectRequest\x1a\x11.tasks.v1.Project\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/{name=projects/*}\x12\x61\n\rCreateProject\x12\x1e.tasks.v1.CreateProjectRequest\x1a\x11.tasks.v1.Project\"\x1d\x82\xd3\xe4\x93\x02\x17\"\x0c/v1/projects:\x07project\x12r\n\rUpdateProject\x12\x1e.tasks.v1.UpdateProjectRequest\x1a\x11.tasks.v1.Project\".\x82\xd3\xe4\x93\x02(2\x1d/v1/{project.name=projects/*}:\x07project\x12\x61\n\rDeleteProject\x12\x1e.tasks.v1.DeleteProjectRequest\x1a\x11.tasks.v1.Project\"\x1d\x82\xd3\xe4\x93\x02\x17*\x15/v1/{name=projects/*}\x12q\n\x0fUndeleteProject\x12 .tasks.v1.UndeleteProjectRequest\x1a\x11.tasks.v1.Project\")\x82\xd3\xe4\x93\x02#\"\x1e/v1/{name=projects/*}:undelete:\x01*\x12n\n\x0e\x41rchiveProject\x12\x1f.tasks.v1.ArchiveProjectRequest\x1a\x11.tasks.v1.Project\"(\x82\xd3\xe4\x93\x02\"\"\x1d/v1/{name=projects/*}:archive:\x01*\x12t\n\x10UnarchiveProject\x12!.tasks.v1.UnarchiveProjectRequest\x1a\x11.tasks.v1.Project\"*\x82\xd3\xe4\x93\x02$\"\x1f/v1/{name=projects/*}:unarchive:\x01*\x12\x65\n\x0bMoveProject\x12\x1c.tasks.v1.MoveProjectRequest\x1a\x11.tasks.v1.Project\"%\x82\xd3\xe4\x93\x02\x1f\"\x1a/v1/{name=projects/*}:move:\x01*\x12\x87\x01\n\x13\x42\x61tchCreateProjects\x12$.tasks.v1.BatchCreateProjectsRequest\x1a%.tasks.v1.BatchCreateProjectsResponse\"#\x82\xd3\xe4\x93\x02\x1d\"\x18/v1/projects:batchCreate:\x01*\x12x\n\x10\x42\x61tchGetProjects\x12!.tasks.v1.BatchGetProjectsRequest\x1a\".tasks.v1.BatchGetProjectsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/projects:batchGet\x12\x87\x01\n\x13\x42\x61tchDeleteProjects\x12$.tasks.v1.BatchDeleteProjectsRequest\x1a%.tasks.v1.BatchDeleteProjectsResponse\"#\x82\xd3\xe4\x93\x02\x1d\"\x18/v1/projects:batchDelete:\x01*\x12n\n\rWatchProjects\x12\x1e.tasks.v1.WatchProjectsRequest\x1a\x1f.tasks.v1.WatchProjectsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/projects:watch0\x01\x42GZEgithub.com/10Narratives/ready-to-do/contracts/gen/go/tasks/v1;tasksv1b\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -41,58 +40,146 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['DESCRIPTOR']._serialized_options = b'ZEgithub.com/10Narratives/ready-to-do/contracts/gen/go/tasks/v1;tasksv1'
   _globals['_PROJECT'].fields_by_name['name']._loaded_options = None
   _globals['_PROJECT'].fields_by_name['name']._serialized_options = b'\340A\010'
+  _globals['_PROJECT'].fields_by_name['color_tag']._loaded_options = None
+  _globals['_PROJECT'].fields_by_name['color_tag']._serialized_options = b'\372Bvrt2r^(#([0-9a-fA-F]{3}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})|(?i:red|orange|yellow|green|teal|blue|purple|pink|brown|gray))?$'
+  _globals['_PROJECT'].fields_by_name['created_at']._loaded_options = None
+  _globals['_PROJECT'].fields_by_name['created_at']._serialized_options = b'\340A\003'
+  _globals['_PROJECT'].fields_by_name['updated_at']._loaded_options = None
+  _globals['_PROJECT'].fields_by_name['updated_at']._serialized_options = b'\340A\003'
+  _globals['_PROJECT'].fields_by_name['delete_time']._loaded_options = None
+  _globals['_PROJECT'].fields_by_name['delete_time']._serialized_options = b'\340A\003'
+  _globals['_PROJECT'].fields_by_name['purge_time']._loaded_options = None
+  _globals['_PROJECT'].fields_by_name['purge_time']._serialized_options = b'\340A\003'
+  _globals['_PROJECT'].fields_by_name['position']._loaded_options = None
+  _globals['_PROJECT'].fields_by_name['position']._serialized_options = b'\340A\003'
+  _globals['_PROJECT'].fields_by_name['labels']._loaded_options = None
+  _globals['_PROJECT'].fields_by_name['labels']._serialized_options = b'\372A\033\n\031tasks.readytogo.com/Label\372B5\222\0012\020@\030\001\",r*2(^labels/[a-z]([a-z0-9-]{0,61}[a-z0-9])?$'
+  _globals['_PROJECT'].fields_by_name['etag']._loaded_options = None
+  _globals['_PROJECT'].fields_by_name['etag']._serialized_options = b'\340A\001'
+  _globals['_PROJECT'].fields_by_name['owner']._loaded_options = None
+  _globals['_PROJECT'].fields_by_name['owner']._serialized_options = b'\340A\003\372A\032\n\030tasks.readytogo.com/User'
   _globals['_PROJECT']._loaded_options = None
   _globals['_PROJECT']._serialized_options = b'\352A1\n\033tasks.readytogo.com/Project\022\022projects/{project}'
   _globals['_LISTPROJECTSREQUEST'].fields_by_name['page_size']._loaded_options = None
-  _globals['_LISTPROJECTSREQUEST'].fields_by_name['page_size']._serialized_options = b'\340A\001\372B\004\032\002(\001'
+  _globals['_LISTPROJECTSREQUEST'].fields_by_name['page_size']._serialized_options = b'\340A\001\372B\004\032\002(\000'
   _globals['_LISTPROJECTSREQUEST'].fields_by_name['page_token']._loaded_options = None
   _globals['_LISTPROJECTSREQUEST'].fields_by_name['page_token']._serialized_options = b'\340A\001'
   _globals['_LISTPROJECTSREQUEST'].fields_by_name['filter']._loaded_options = None
-  _globals['_LISTPROJECTSREQUEST'].fields_by_name['filter']._serialized_options = b'\340A\001'
+  _globals['_LISTPROJECTSREQUEST'].fields_by_name['filter']._serialized_options = b'\340A\001\372B\005r\003\030\200\020'
   _globals['_LISTPROJECTSREQUEST'].fields_by_name['order_by']._loaded_options = None
-  _globals['_LISTPROJECTSREQUEST'].fields_by_name['order_by']._serialized_options = b'\340A\001\372B/r-R\ncreated_atR\nupdated_atR\014display_nameR\005state'
+  _globals['_LISTPROJECTSREQUEST'].fields_by_name['order_by']._serialized_options = b'\340A\001\372BJrH2F^((created_at|updated_at|display_name|state|position)( (asc|desc))?)?$'
   _globals['_LISTPROJECTSREQUEST'].fields_by_name['show_archived']._loaded_options = None
-  _globals['_LISTPROJECTSREQUEST'].fields_by_name['show_archived']._serialized_options = b'\340A\001\372B\004j\002\010\001'
+  _globals['_LISTPROJECTSREQUEST'].fields_by_name['show_archived']._serialized_options = b'\340A\001'
   _globals['_LISTPROJECTSREQUEST'].fields_by_name['show_deleted']._loaded_options = None
-  _globals['_LISTPROJECTSREQUEST'].fields_by_name['show_deleted']._serialized_options = b'\340A\001\372B\004j\002\010\001'
+  _globals['_LISTPROJECTSREQUEST'].fields_by_name['show_deleted']._serialized_options = b'\340A\001'
   _globals['_GETPROJECTREQUEST'].fields_by_name['name']._loaded_options = None
   _globals['_GETPROJECTREQUEST'].fields_by_name['name']._serialized_options = b'\340A\002\372A\035\n\033tasks.readytogo.com/Project'
   _globals['_CREATEPROJECTREQUEST'].fields_by_name['project_id']._loaded_options = None
   _globals['_CREATEPROJECTREQUEST'].fields_by_name['project_id']._serialized_options = b'\340A\002\372B\005r\003\260\001\001'
   _globals['_CREATEPROJECTREQUEST'].fields_by_name['project']._loaded_options = None
   _globals['_CREATEPROJECTREQUEST'].fields_by_name['project']._serialized_options = b'\340A\002'
+  _globals['_CREATEPROJECTREQUEST'].fields_by_name['request_id']._loaded_options = None
+  _globals['_CREATEPROJECTREQUEST'].fields_by_name['request_id']._serialized_options = b'\340A\001\372B\010r\006\260\001\001\320\001\001'
   _globals['_UPDATEPROJECTREQUEST'].fields_by_name['project']._loaded_options = None
-  _globals['_UPDATEPROJECTREQUEST'].fields_by_name['project']._serialized_options = b'\340A\002'
+  _globals['_UPDATEPROJECTREQUEST'].fields_by_name['project']._serialized_options = b'\340A\002\372B\005\212\001\002\020\001'
   _globals['_UPDATEPROJECTREQUEST'].fields_by_name['update_mask']._loaded_options = None
-  _globals['_UPDATEPROJECTREQUEST'].fields_by_name['update_mask']._serialized_options = b'\340A\002'
+  _globals['_UPDATEPROJECTREQUEST'].fields_by_name['update_mask']._serialized_options = b'\340A\002\372B\005\212\001\002\020\001'
   _globals['_DELETEPROJECTREQUEST'].fields_by_name['name']._loaded_options = None
   _globals['_DELETEPROJECTREQUEST'].fields_by_name['name']._serialized_options = b'\340A\002\372A\035\n\033tasks.readytogo.com/Project'
+  _globals['_DELETEPROJECTREQUEST'].fields_by_name['etag']._loaded_options = None
+  _globals['_DELETEPROJECTREQUEST'].fields_by_name['etag']._serialized_options = b'\340A\001\372B\004r\002\030@'
+  _globals['_UNDELETEPROJECTREQUEST'].fields_by_name['name']._loaded_options = None
+  _globals['_UNDELETEPROJECTREQUEST'].fields_by_name['name']._serialized_options = b'\340A\002\372A\035\n\033tasks.readytogo.com/Project'
+  _globals['_ARCHIVEPROJECTREQUEST'].fields_by_name['name']._loaded_options = None
+  _globals['_ARCHIVEPROJECTREQUEST'].fields_by_name['name']._serialized_options = b'\340A\002\372A\035\n\033tasks.readytogo.com/Project'
+  _globals['_UNARCHIVEPROJECTREQUEST'].fields_by_name['name']._loaded_options = None
+  _globals['_UNARCHIVEPROJECTREQUEST'].fields_by_name['name']._serialized_options = b'\340A\002\372A\035\n\033tasks.readytogo.com/Project'
+  _globals['_MOVEPROJECTREQUEST'].oneofs_by_name['destination']._loaded_options = None
+  _globals['_MOVEPROJECTREQUEST'].oneofs_by_name['destination']._serialized_options = b'\370B\001'
+  _globals['_MOVEPROJECTREQUEST'].fields_by_name['name']._loaded_options = None
+  _globals['_MOVEPROJECTREQUEST'].fields_by_name['name']._serialized_options = b'\340A\002\372A\035\n\033tasks.readytogo.com/Project'
+  _globals['_MOVEPROJECTREQUEST'].fields_by_name['after']._loaded_options = None
+  _globals['_MOVEPROJECTREQUEST'].fields_by_name['after']._serialized_options = b'\372A\035\n\033tasks.readytogo.com/Project'
+  _globals['_MOVEPROJECTREQUEST'].fields_by_name['before']._loaded_options = None
+  _globals['_MOVEPROJECTREQUEST'].fields_by_name['before']._serialized_options = b'\372A\035\n\033tasks.readytogo.com/Project'
+  _globals['_BATCHCREATEPROJECTSREQUEST'].fields_by_name['requests']._loaded_options = None
+  _globals['_BATCHCREATEPROJECTSREQUEST'].fields_by_name['requests']._serialized_options = b'\340A\002\372B\005\222\001\002\010\001'
+  _globals['_BATCHGETPROJECTSREQUEST'].fields_by_name['names']._loaded_options = None
+  _globals['_BATCHGETPROJECTSREQUEST'].fields_by_name['names']._serialized_options = b'\340A\002\372A\035\n\033tasks.readytogo.com/Project\372B\005\222\001\002\010\001'
+  _globals['_BATCHDELETEPROJECTSREQUEST'].fields_by_name['names']._loaded_options = None
+  _globals['_BATCHDELETEPROJECTSREQUEST'].fields_by_name['names']._serialized_options = b'\340A\002\372A\035\n\033tasks.readytogo.com/Project\372B\007\222\001\004\010\001\030\001'
+  _globals['_WATCHPROJECTSREQUEST'].fields_by_name['change_token']._loaded_options = None
+  _globals['_WATCHPROJECTSREQUEST'].fields_by_name['change_token']._serialized_options = b'\340A\001\372B\005r\003\030\200\002'
+  _globals['_WATCHPROJECTSRESPONSE'].fields_by_name['name']._loaded_options = None
+  _globals['_WATCHPROJECTSRESPONSE'].fields_by_name['name']._serialized_options = b'\372A\035\n\033tasks.readytogo.com/Project'
   _globals['_PROJECTSERVICE'].methods_by_name['ListProjects']._loaded_options = None
-  _globals['_PROJECTSERVICE'].methods_by_name['ListProjects']._serialized_options = b'\202\323\344\223\002\016\022\014/v1/Projects'
+  _globals['_PROJECTSERVICE'].methods_by_name['ListProjects']._serialized_options = b'\202\323\344\223\002\016\022\014/v1/projects'
   _globals['_PROJECTSERVICE'].methods_by_name['GetProject']._loaded_options = None
-  _globals['_PROJECTSERVICE'].methods_by_name['GetProject']._serialized_options = b'\202\323\344\223\002\027\022\025/v1/{name=Projects/*}'
+  _globals['_PROJECTSERVICE'].methods_by_name['GetProject']._serialized_options = b'\202\323\344\223\002\027\022\025/v1/{name=projects/*}'
   _globals['_PROJECTSERVICE'].methods_by_name['CreateProject']._loaded_options = None
-  _globals['_PROJECTSERVICE'].methods_by_name['CreateProject']._serialized_options = b'\202\323\344\223\002\027\"\014/v1/Projects:\007project'
+  _globals['_PROJECTSERVICE'].methods_by_name['CreateProject']._serialized_options = b'\202\323\344\223\002\027\"\014/v1/projects:\007project'
   _globals['_PROJECTSERVICE'].methods_by_name['UpdateProject']._loaded_options = None
-  _globals['_PROJECTSERVICE'].methods_by_name['UpdateProject']._serialized_options = b'\202\323\344\223\002(2\035/v1/{project.name=Projects/*}:\007project'
+  _globals['_PROJECTSERVICE'].methods_by_name['UpdateProject']._serialized_options = b'\202\323\344\223\002(2\035/v1/{project.name=projects/*}:\007project'
   _globals['_PROJECTSERVICE'].methods_by_name['DeleteProject']._loaded_options = None
-  _globals['_PROJECTSERVICE'].methods_by_name['DeleteProject']._serialized_options = b'\202\323\344\223\002\027*\025/v1/{name=Projects/*}'
-  _globals['_PROJECT']._serialized_start=262
-  _globals['_PROJECT']._serialized_end=613
-  _globals['_PROJECT_STATE']._serialized_start=490
-  _globals['_PROJECT_STATE']._serialized_end=559
-  _globals['_LISTPROJECTSREQUEST']._serialized_start=616
-  _globals['_LISTPROJECTSREQUEST']._serialized_end=856
-  _globals['_LISTPROJECTSRESPONSE']._serialized_start=858
-  _globals['_LISTPROJECTSRESPONSE']._serialized_end=942
-  _globals['_GETPROJECTREQUEST']._serialized_start=944
-  _globals['_GETPROJECTREQUEST']._serialized_end=1014
-  _globals['_CREATEPROJECTREQUEST']._serialized_start=1016
-  _globals['_CREATEPROJECTREQUEST']._serialized_end=1112
-  _globals['_UPDATEPROJECTREQUEST']._serialized_start=1114
-  _globals['_UPDATEPROJECTREQUEST']._serialized_end=1231
-  _globals['_DELETEPROJECTREQUEST']._serialized_start=1233
-  _globals['_DELETEPROJECTREQUEST']._serialized_end=1306
-  _globals['_PROJECTSERVICE']._serialized_start=1309
-  _globals['_PROJECTSERVICE']._serialized_end=1838
+  _globals['_PROJECTSERVICE'].methods_by_name['DeleteProject']._serialized_options = b'\202\323\344\223\002\027*\025/v1/{name=projects/*}'
+  _globals['_PROJECTSERVICE'].methods_by_name['UndeleteProject']._loaded_options = None
+  _globals['_PROJECTSERVICE'].methods_by_name['UndeleteProject']._serialized_options = b'\202\323\344\223\002#\"\036/v1/{name=projects/*}:undelete:\001*'
+  _globals['_PROJECTSERVICE'].methods_by_name['ArchiveProject']._loaded_options = None
+  _globals['_PROJECTSERVICE'].methods_by_name['ArchiveProject']._serialized_options = b'\202\323\344\223\002\"\"\035/v1/{name=projects/*}:archive:\001*'
+  _globals['_PROJECTSERVICE'].methods_by_name['UnarchiveProject']._loaded_options = None
+  _globals['_PROJECTSERVICE'].methods_by_name['UnarchiveProject']._serialized_options = b'\202\323\344\223\002$\"\037/v1/{name=projects/*}:unarchive:\001*'
+  _globals['_PROJECTSERVICE'].methods_by_name['MoveProject']._loaded_options = None
+  _globals['_PROJECTSERVICE'].methods_by_name['MoveProject']._serialized_options = b'\202\323\344\223\002\037\"\032/v1/{name=projects/*}:move:\001*'
+  _globals['_PROJECTSERVICE'].methods_by_name['BatchCreateProjects']._loaded_options = None
+  _globals['_PROJECTSERVICE'].methods_by_name['BatchCreateProjects']._serialized_options = b'\202\323\344\223\002\035\"\030/v1/projects:batchCreate:\001*'
+  _globals['_PROJECTSERVICE'].methods_by_name['BatchGetProjects']._loaded_options = None
+  _globals['_PROJECTSERVICE'].methods_by_name['BatchGetProjects']._serialized_options = b'\202\323\344\223\002\027\022\025/v1/projects:batchGet'
+  _globals['_PROJECTSERVICE'].methods_by_name['BatchDeleteProjects']._loaded_options = None
+  _globals['_PROJECTSERVICE'].methods_by_name['BatchDeleteProjects']._serialized_options = b'\202\323\344\223\002\035\"\030/v1/projects:batchDelete:\001*'
+  _globals['_PROJECTSERVICE'].methods_by_name['WatchProjects']._loaded_options = None
+  _globals['_PROJECTSERVICE'].methods_by_name['WatchProjects']._serialized_options = b'\202\323\344\223\002\024\022\022/v1/projects:watch'
+  _globals['_PROJECT']._serialized_start=233
+  _globals['_PROJECT']._serialized_end=1020
+  _globals['_PROJECT_STATE']._serialized_start=897
+  _globals['_PROJECT_STATE']._serialized_end=966
+  _globals['_LISTPROJECTSREQUEST']._serialized_start=1023
+  _globals['_LISTPROJECTSREQUEST']._serialized_end=1284
+  _globals['_LISTPROJECTSRESPONSE']._serialized_start=1286
+  _globals['_LISTPROJECTSRESPONSE']._serialized_end=1370
+  _globals['_GETPROJECTREQUEST']._serialized_start=1372
+  _globals['_GETPROJECTREQUEST']._serialized_end=1442
+  _globals['_CREATEPROJECTREQUEST']._serialized_start=1445
+  _globals['_CREATEPROJECTREQUEST']._serialized_end=1577
+  _globals['_UPDATEPROJECTREQUEST']._serialized_start=1580
+  _globals['_UPDATEPROJECTREQUEST']._serialized_end=1713
+  _globals['_DELETEPROJECTREQUEST']._serialized_start=1715
+  _globals['_DELETEPROJECTREQUEST']._serialized_end=1814
+  _globals['_UNDELETEPROJECTREQUEST']._serialized_start=1816
+  _globals['_UNDELETEPROJECTREQUEST']._serialized_end=1891
+  _globals['_ARCHIVEPROJECTREQUEST']._serialized_start=1893
+  _globals['_ARCHIVEPROJECTREQUEST']._serialized_end=1967
+  _globals['_UNARCHIVEPROJECTREQUEST']._serialized_start=1969
+  _globals['_UNARCHIVEPROJECTREQUEST']._serialized_end=2045
+  _globals['_MOVEPROJECTREQUEST']._serialized_start=2048
+  _globals['_MOVEPROJECTREQUEST']._serialized_end=2242
+  _globals['_BATCHCREATEPROJECTSREQUEST']._serialized_start=2244
+  _globals['_BATCHCREATEPROJECTSREQUEST']._serialized_end=2335
+  _globals['_BATCHCREATEPROJECTSRESPONSE']._serialized_start=2337
+  _globals['_BATCHCREATEPROJECTSRESPONSE']._serialized_end=2403
+  _globals['_BATCHGETPROJECTSREQUEST']._serialized_start=2405
+  _globals['_BATCHGETPROJECTSREQUEST']._serialized_end=2490
+  _globals['_BATCHGETPROJECTSRESPONSE']._serialized_start=2492
+  _globals['_BATCHGETPROJECTSRESPONSE']._serialized_end=2555
+  _globals['_BATCHDELETEPROJECTSREQUEST']._serialized_start=2557
+  _globals['_BATCHDELETEPROJECTSREQUEST']._serialized_end=2647
+  _globals['_BATCHDELETEPROJECTSRESPONSE']._serialized_start=2649
+  _globals['_BATCHDELETEPROJECTSRESPONSE']._serialized_end=2715
+  _globals['_WATCHPROJECTSREQUEST']._serialized_start=2717
+  _globals['_WATCHPROJECTSREQUEST']._serialized_end=2774
+  _globals['_WATCHPROJECTSRESPONSE']._serialized_start=2777
+  _globals['_WATCHPROJECTSRESPONSE']._serialized_end=3117
+  _globals['_WATCHPROJECTSRESPONSE_CHANGETYPE']._serialized_start=3022
+  _globals['_WATCHPROJECTSRESPONSE_CHANGETYPE']._serialized_end=3117
+  _globals['_PROJECTSERVICE']._serialized_start=3120
+  _globals['_PROJECTSERVICE']._serialized_end=4602
 # @@protoc_insertion_point(module_scope)
@@ -3,7 +3,6 @@
 import grpc
 import warnings
 
-from google.protobuf import empty_pb2 as google_dot_protobuf_dot_empty__pb2
 from proto.tasks.v1 import project_service_pb2 as proto_dot_tasks_dot_v1_dot_project__service__pb2
 
 GRPC_GENERATED_VERSION = '1.73.1'
@@ -59,7 +58,47 @@ class ProjectServiceStub(object):
         self.DeleteProject = channel.unary_unary(
                 '/tasks.v1.ProjectService/DeleteProject',
                 request_serializer=proto_dot_tasks_dot_v1_dot_project__service__pb2.DeleteProjectRequest.SerializeToString,
-                response_deserializer=google_dot_protobuf_dot_empty__pb2.Empty.FromString,
+                response_deserializer=proto_dot_tasks_dot_v1_dot_project__service__pb2.Project.FromString,
+                _registered_method=True)
+        self.UndeleteProject = channel.unary_unary(
+                '/tasks.v1.ProjectService/UndeleteProject',
+                request_serializer=proto_dot_tasks_dot_v1_dot_project__service__pb2.UndeleteProjectRequest.SerializeToString,
+                response_deserializer=proto_dot_tasks_dot_v1_dot_project__service__pb2.Project.FromString,
+                _registered_method=True)
+        self.ArchiveProject = channel.unary_unary(
+                '/tasks.v1.ProjectService/ArchiveProject',
+                request_serializer=proto_dot_tasks_dot_v1_dot_project__service__pb2.ArchiveProjectRequest.SerializeToString,
+                response_deserializer=proto_dot_tasks_dot_v1_dot_project__service__pb2.Project.FromString,
+                _registered_method=True)
+        self.UnarchiveProject = channel.unary_unary(
+                '/tasks.v1.ProjectService/UnarchiveProject',
+                request_serializer=proto_dot_tasks_dot_v1_dot_project__service__pb2.UnarchiveProjectRequest.SerializeToString,
+                response_deserializer=proto_dot_tasks_dot_v1_dot_project__service__pb2.Project.FromString,
+                _registered_method=True)
+        self.MoveProject = channel.unary_unary(
+                '/tasks.v1.ProjectService/MoveProject',
+                request_serializer=proto_dot_tasks_dot_v1_dot_project__service__pb2.MoveProjectRequest.SerializeToString,
+                response_deserializer=proto_dot_tasks_dot_v1_dot_project__service__pb2.Project.FromString,
+                _registered_method=True)
+        self.BatchCreateProjects = channel.unary_unary(
+                '/tasks.v1.ProjectService/BatchCreateProjects',
+                request_serializer=proto_dot_tasks_dot_v1_dot_project__service__pb2.BatchCreateProjectsRequest.SerializeToString,
+                response_deserializer=proto_dot_tasks_dot_v1_dot_project__service__pb2.BatchCreateProjectsResponse.FromString,
+                _registered_method=True)
+        self.BatchGetProjects = channel.unary_unary(
+                '/tasks.v1.ProjectService/BatchGetProjects',
+                request_serializer=proto_dot_tasks_dot_v1_dot_project__service__pb2.BatchGetProjectsRequest.SerializeToString,
+                response_deserializer=proto_dot_tasks_dot_v1_dot_project__service__pb2.BatchGetProjectsResponse.FromString,
+                _registered_method=True)
+        self.BatchDeleteProjects = channel.unary_unary(
+                '/tasks.v1.ProjectService/BatchDeleteProjects',
+                request_serializer=proto_dot_tasks_dot_v1_dot_project__service__pb2.BatchDeleteProjectsRequest.SerializeToString,
+                response_deserializer=proto_dot_tasks_dot_v1_dot_project__service__pb2.BatchDeleteProjectsResponse.FromString,
+                _registered_method=True)
+        self.WatchProjects = channel.unary_stream(
+                '/tasks.v1.ProjectService/WatchProjects',
+                request_serializer=proto_dot_tasks_dot_v1_dot_project__service__pb2.WatchProjectsRequest.SerializeToString,
+                response_deserializer=proto_dot_tasks_dot_v1_dot_project__service__pb2.WatchProjectsResponse.FromString,
                 _registered_method=True)
 
 
@@ -96,7 +135,71 @@ class ProjectServiceServicer(object):
         raise NotImplementedError('Method not implemented!')
 
     def DeleteProject(self, request, context):
-        """DeleteProject deletes a project.
+        """DeleteProject soft-deletes a project. The project is kept in the DELETED
+        state until its purge_time and can be restored with UndeleteProject.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def UndeleteProject(self, request, context):
+        """UndeleteProject restores a soft-deleted project.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ArchiveProject(self, request, context):
+        """ArchiveProject moves an ACTIVE project to the ARCHIVED state.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def UnarchiveProject(self, request, context):
+        """UnarchiveProject moves an ARCHIVED project back to the ACTIVE state.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def MoveProject(self, request, context):
+        """MoveProject places a project right after or right before another project
+        in the manual order. Only the position of the moved project changes.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def BatchCreateProjects(self, request, context):
+        """BatchCreateProjects creates several projects at once. Either all of them
+        are created or none is.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def BatchGetProjects(self, request, context):
+        """BatchGetProjects gets several projects at once. Fails if any of them is
+        not found.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def BatchDeleteProjects(self, request, context):
+        """BatchDeleteProjects soft-deletes several projects at once. Either all of
+        them are deleted or none is.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def WatchProjects(self, request, context):
+        """WatchProjects streams the changes of projects in the order they were
+        committed. Every response carries a change token; a new call with the
+        last received token resumes the stream without losing changes. While
+        nothing changes, heartbeats are sent periodically.
         """
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
         context.set_details('Method not implemented!')
@@ -128,7 +231,47 @@ def add_ProjectServiceServicer_to_server(servicer, server):
             'DeleteProject': grpc.unary_unary_rpc_method_handler(
                     servicer.DeleteProject,
                     request_deserializer=proto_dot_tasks_dot_v1_dot_project__service__pb2.DeleteProjectRequest.FromString,
-                    response_serializer=google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
+                    response_serializer=proto_dot_tasks_dot_v1_dot_project__service__pb2.Project.SerializeToString,
+            ),
+            'UndeleteProject': grpc.unary_unary_rpc_method_handler(
+                    servicer.UndeleteProject,
+                    request_deserializer=proto_dot_tasks_dot_v1_dot_project__service__pb2.UndeleteProjectRequest.FromString,
+                    response_serializer=proto_dot_tasks_dot_v1_dot_project__service__pb2.Project.SerializeToString,
+            ),
+            'ArchiveProject': grpc.unary_unary_rpc_method_handler(
+                    servicer.ArchiveProject,
+                    request_deserializer=proto_dot_tasks_dot_v1_dot_project__service__pb2.ArchiveProjectRequest.FromString,
+                    response_serializer=proto_dot_tasks_dot_v1_dot_project__service__pb2.Project.SerializeToString,
+            ),
+            'UnarchiveProject': grpc.unary_unary_rpc_method_handler(
+                    servicer.UnarchiveProject,
+                    request_deserializer=proto_dot_tasks_dot_v1_dot_project__service__pb2.UnarchiveProjectRequest.FromString,
+                    response_serializer=proto_dot_tasks_dot_v1_dot_project__service__pb2.Project.SerializeToString,
+            ),
+            'MoveProject': grpc.unary_unary_rpc_method_handler(
+                    servicer.MoveProject,
+                    request_deserializer=proto_dot_tasks_dot_v1_dot_project__service__pb2.MoveProjectRequest.FromString,
+                    response_serializer=proto_dot_tasks_dot_v1_dot_project__service__pb2.Project.SerializeToString,
+            ),
+            'BatchCreateProjects': grpc.unary_unary_rpc_method_handler(
+                    servicer.BatchCreateProjects,
+                    request_deserializer=proto_dot_tasks_dot_v1_dot_project__service__pb2.BatchCreateProjectsRequest.FromString,
+                    response_serializer=proto_dot_tasks_dot_v1_dot_project__service__pb2.BatchCreateProjectsResponse.SerializeToString,
+            ),
+            'BatchGetProjects': grpc.unary_unary_rpc_method_handler(
+                    servicer.BatchGetProjects,
+                    request_deserializer=proto_dot_tasks_dot_v1_dot_project__service__pb2.BatchGetProjectsRequest.FromString,
+                    response_serializer=proto_dot_tasks_dot_v1_dot_project__service__pb2.BatchGetProjectsResponse.SerializeToString,
+            ),
+            'BatchDeleteProjects': grpc.unary_unary_rpc_method_handler(
+                    servicer.BatchDeleteProjects,
+                    request_deserializer=proto_dot_tasks_dot_v1_dot_project__service__pb2.BatchDeleteProjectsRequest.FromString,
+                    response_serializer=proto_dot_tasks_dot_v1_dot_project__service__pb2.BatchDeleteProjectsResponse.SerializeToString,
+            ),
+            'WatchProjects': grpc.unary_stream_rpc_method_handler(
+                    servicer.WatchProjects,
+                    request_deserializer=proto_dot_tasks_dot_v1_dot_project__service__pb2.WatchProjectsRequest.FromString,
+                    response_serializer=proto_dot_tasks_dot_v1_dot_project__service__pb2.WatchProjectsResponse.SerializeToString,
             ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
@@ -266,7 +409,223 @@ class ProjectService(object):
             target,
             '/tasks.v1.ProjectService/DeleteProject',
             proto_dot_tasks_dot_v1_dot_project__service__pb2.DeleteProjectRequest.SerializeToString,
-            google_dot_protobuf_dot_empty__pb2.Empty.FromString,
+            proto_dot_tasks_dot_v1_dot_project__service__pb2.Project.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def UndeleteProject(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/tasks.v1.ProjectService/UndeleteProject',
+            proto_dot_tasks_dot_v1_dot_project__service__pb2.UndeleteProjectRequest.SerializeToString,
+            proto_dot_tasks_dot_v1_dot_project__service__pb2.Project.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ArchiveProject(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/tasks.v1.ProjectService/ArchiveProject',
+            proto_dot_tasks_dot_v1_dot_project__service__pb2.ArchiveProjectRequest.SerializeToString,
+            proto_dot_tasks_dot_v1_dot_project__service__pb2.Project.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def UnarchiveProject(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/tasks.v1.ProjectService/UnarchiveProject',
+            proto_dot_tasks_dot_v1_dot_project__service__pb2.UnarchiveProjectRequest.SerializeToString,
+            proto_dot_tasks_dot_v1_dot_project__service__pb2.Project.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def MoveProject(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/tasks.v1.ProjectService/MoveProject',
+            proto_dot_tasks_dot_v1_dot_project__service__pb2.MoveProjectRequest.SerializeToString,
+            proto_dot_tasks_dot_v1_dot_project__service__pb2.Project.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def BatchCreateProjects(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/tasks.v1.ProjectService/BatchCreateProjects',
+            proto_dot_tasks_dot_v1_dot_project__service__pb2.BatchCreateProjectsRequest.SerializeToString,
+            proto_dot_tasks_dot_v1_dot_project__service__pb2.BatchCreateProjectsResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def BatchGetProjects(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/tasks.v1.ProjectService/BatchGetProjects',
+            proto_dot_tasks_dot_v1_dot_project__service__pb2.BatchGetProjectsRequest.SerializeToString,
+            proto_dot_tasks_dot_v1_dot_project__service__pb2.BatchGetProjectsResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def BatchDeleteProjects(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/tasks.v1.ProjectService/BatchDeleteProjects',
+            proto_dot_tasks_dot_v1_dot_project__service__pb2.BatchDeleteProjectsRequest.SerializeToString,
+            proto_dot_tasks_dot_v1_dot_project__service__pb2.BatchDeleteProjectsResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def WatchProjects(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(
+            request,
+            target,
+            '/tasks.v1.ProjectService/WatchProjects',
+            proto_dot_tasks_dot_v1_dot_project__service__pb2.WatchProjectsRequest.SerializeToString,
+            proto_dot_tasks_dot_v1_dot_project__service__pb2.WatchProjectsResponse.FromString,
             options,
             channel_credentials,
             insecure,
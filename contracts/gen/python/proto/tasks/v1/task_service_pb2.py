@@ -0,0 +1,146 @@
+# -*- coding: utf-8 -*-
+# Generated by the protocol buffer compiler.  DO NOT EDIT!
+# NO CHECKED-IN PROTOBUF GENCODE
+# source: proto/tasks/v1/task_service.proto
+# Protobuf Python Version: 6.31.0
+"""Generated protocol buffer code."""
+from google.protobuf import descriptor as _descriptor
+from google.protobuf import descriptor_pool as _descriptor_pool
+from google.protobuf import runtime_version as _runtime_version
+from google.protobuf import symbol_database as _symbol_database
+from google.protobuf.internal import builder as _builder
+_runtime_version.ValidateProtobufRuntimeVersion(
+    _runtime_version.Domain.PUBLIC,
+    6,
+    31,
+    0,
+    '',
+    'proto/tasks/v1/task_service.proto'
+)
+# @@protoc_insertion_point(imports)
+
+_sym_db = _symbol_database.Default()
+
+
+from google.api import annotations_pb2 as google_dot_api_dot_annotations__pb2
+from google.api import field_behavior_pb2 as google_dot_api_dot_field__behavior__pb2
+from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__pb2
+from google.api import resource_pb2 as google_dot_api_dot_resource__pb2
+from google.protobuf import empty_pb2 as google_dot_protobuf_dot_empty__pb2
+from google.protobuf import field_mask_pb2 as google_dot_protobuf_dot_field__mask__pb2
+from validate import validate_pb2 as validate_dot_validate__pb2
+
+
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n!proto/tasks/v1/task_service.proto\x12\x08tasks.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x17validate/validate.proto\"\x8d\x06\n\x04Task\x12\x11\n\x04name\x18\x01 \x01(\tB\x03\xe0\x41\x08\x12\x17\n\x05title\x18\x02 \x01(\tB\x08\xfa\x42\x05r\x03\x18\x80\x04\x12\x18\n\x05notes\x18\x03 \x01(\tB\t\xfa\x42\x06r\x04\x18\x80\x80\x01\x12,\n\x08\x64ue_time\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x1e\n\rdue_time_zone\x18\x05 \x01(\tB\x07\xfa\x42\x04r\x02\x18@\x12)\n\x08priority\x18\x06 \x01(\x0e\x32\x17.tasks.v1.Task.Priority\x12(\n\x05state\x18\x07 \x01(\x0e\x32\x14.tasks.v1.Task.StateB\x03\xe0\x41\x03\x12\x35\n\x0c\x63ompleted_at\x18\x08 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x03\xe0\x41\x03\x12\x33\n\ncreated_at\x18\t \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x03\xe0\x41\x03\x12\x33\n\nupdated_at\x18\n \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x03\xe0\x41\x03\x12\x35\n\x0bparent_task\x18\x0b \x01(\tB \xe0\x41\x05\xfa\x41\x1a\n\x18tasks.readytogo.com/Task\x12\x12\n\x05\x64\x65pth\x18\x0c \x01(\x05\x42\x03\xe0\x41\x03\x12\x66\n\x06labels\x18\r \x03(\tBV\xfa\x41\x1b\n\x19tasks.readytogo.com/Label\xfa\x42\x35\x92\x01\x32\x10@\x18\x01\",r*2(^labels/[a-z]([a-z0-9-]{0,61}[a-z0-9])?$\"O\n\x08Priority\x12\x18\n\x14PRIORITY_UNSPECIFIED\x10\x00\x12\x07\n\x03LOW\x10\x01\x12\n\n\x06MEDIUM\x10\x02\x12\x08\n\x04HIGH\x10\x03\x12\n\n\x06URGENT\x10\x04\"7\n\x05State\x12\x15\n\x11STATE_UNSPECIFIED\x10\x00\x12\x08\n\x04OPEN\x10\x01\x12\r\n\tCOMPLETED\x10\x02:>\xea\x41;\n\x18tasks.readytogo.com/Task\x12\x1fprojects/{project}/tasks/{task}\"\x8f\x02\n\x10ListTasksRequest\x12\x33\n\x06parent\x18\x01 \x01(\tB#\xe0\x41\x02\xfa\x41\x1d\n\x1btasks.readytogo.com/Project\x12\x1d\n\tpage_size\x18\x02 \x01(\x05\x42\n\xe0\x41\x01\xfa\x42\x04\x1a\x02(\x00\x12\x17\n\npage_token\x18\x03 \x01(\tB\x03\xe0\x41\x01\x12\x1b\n\x06\x66ilter\x18\x04 \x01(\tB\x0b\xe0\x41\x01\xfa\x42\x05r\x03\x18\x80\x10\x12^\n\x08order_by\x18\x05 \x01(\tBL\xe0\x41\x01\xfa\x42\x46rD2B^((created_at|updated_at|due_time|priority|title)( (asc|desc))?)?$\x12\x11\n\x04tree\x18\x06 \x01(\x08\x42\x03\xe0\x41\x01\"K\n\x11ListTasksResponse\x12\x1d\n\x05tasks\x18\x01 \x03(\x0b\x32\x0e.tasks.v1.Task\x12\x17\n\x0fnext_page_token\x18\x02 \x01(\t\"@\n\x0eGetTaskRequest\x12.\n\x04name\x18\x01 \x01(\tB \xe0\x41\x02\xfa\x41\x1a\n\x18tasks.readytogo.com/Task\"\x91\x01\n\x11\x43reateTaskRequest\x12\x33\n\x06parent\x18\x01 \x01(\tB#\xe0\x41\x02\xfa\x41\x1d\n\x1btasks.readytogo.com/Project\x12\x1c\n\x07task_id\x18\x02 \x01(\tB\x0b\xe0\x41\x02\xfa\x42\x05r\x03\xb0\x01\x01\x12)\n\x04task\x18\x03 \x01(\x0b\x32\x0e.tasks.v1.TaskB\x0b\xe0\x41\x02\xfa\x42\x05\x8a\x01\x02\x10\x01\"|\n\x11UpdateTaskRequest\x12)\n\x04task\x18\x01 \x01(\x0b\x32\x0e.tasks.v1.TaskB\x0b\xe0\x41\x02\xfa\x42\x05\x8a\x01\x02\x10\x01\x12<\n\x0bupdate_mask\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.FieldMaskB\x0b\xe0\x41\x02\xfa\x42\x05\x8a\x01\x02\x10\x01\"C\n\x11\x44\x65leteTaskRequest\x12.\n\x04name\x18\x01 \x01(\tB \xe0\x41\x02\xfa\x41\x1a\n\x18tasks.readytogo.com/Task\"[\n\x13\x43ompleteTaskRequest\x12.\n\x04name\x18\x01 \x01(\tB \xe0\x41\x02\xfa\x41\x1a\n\x18tasks.readytogo.com/Task\x12\x14\n\x07\x63\x61scade\x18\x02 \x01(\x08\x42\x03\xe0\x41\x01\"C\n\x11ReopenTaskRequest\x12.\n\x04name\x18\x01 \x01(\tB \xe0\x41\x02\xfa\x41\x1a\n\x18tasks.readytogo.com/Task\"\xc6\x01\n\x0fMoveTaskRequest\x12.\n\x04name\x18\x01 \x01(\tB \xe0\x41\x02\xfa\x41\x1a\n\x18tasks.readytogo.com/Task\x12\x41\n\x17\x64\x65stination_parent_task\x18\x02 \x01(\tB \xe0\x41\x01\xfa\x41\x1a\n\x18tasks.readytogo.com/Task\x12@\n\x13\x64\x65stination_project\x18\x03 \x01(\tB#\xe0\x41\x01\xfa\x41\x1d\n\x1btasks.readytogo.com/Project2\xd9\x06\n\x0bTaskService\x12k\n\tListTasks\x12\x1a.tasks.v1.ListTasksRequest\x1a\x1b.tasks.v1.ListTasksResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/{parent=projects/*}/tasks\x12Z\n\x07GetTask\x12\x18.tasks.v1.GetTaskRequest\x1a\x0e.tasks.v1.Task\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/{name=projects/*/tasks/*}\x12\x66\n\nCreateTask\x12\x1b.tasks.v1.CreateTaskRequest\x1a\x0e.tasks.v1.Task\"+\x82\xd3\xe4\x93\x02%\"\x1d/v1/{parent=projects/*}/tasks:\x04task\x12k\n\nUpdateTask\x12\x1b.tasks.v1.UpdateTaskRequest\x1a\x0e.tasks.v1.Task\"0\x82\xd3\xe4\x93\x02*2\"/v1/{task.name=projects/*/tasks/*}:\x04task\x12h\n\nDeleteTask\x12\x1b.tasks.v1.DeleteTaskRequest\x1a\x16.google.protobuf.Empty\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/v1/{name=projects/*/tasks/*}\x12p\n\x0c\x43ompleteTask\x12\x1d.tasks.v1.CompleteTaskRequest\x1a\x0e.tasks.v1.Task\"1\x82\xd3\xe4\x93\x02+\"&/v1/{name=projects/*/tasks/*}:complete:\x01*\x12j\n\nReopenTask\x12\x1b.tasks.v1.ReopenTaskRequest\x1a\x0e.tasks.v1.Task\"/\x82\xd3\xe4\x93\x02)\"$/v1/{name=projects/*/tasks/*}:reopen:\x01*\x12\x64\n\x08MoveTask\x12\x19.tasks.v1.MoveTaskRequest\x1a\x0e.tasks.v1.Task\"-\x82\xd3\xe4\x93\x02\'\"\"/v1/{name=projects/*/tasks/*}:move:\x01*BGZEgithub.com/10Narratives/ready-to-do/contracts/gen/go/tasks/v1;tasksv1b\x06proto3')
+
+_globals = globals()
+_builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
+_builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'proto.tasks.v1.task_service_pb2', _globals)
+if not _descriptor._USE_C_DESCRIPTORS:
+  _globals['DESCRIPTOR']._loaded_options = None
+  _globals['DESCRIPTOR']._serialized_options = b'ZEgithub.com/10Narratives/ready-to-do/contracts/gen/go/tasks/v1;tasksv1'
+  _globals['_TASK'].fields_by_name['name']._loaded_options = None
+  _globals['_TASK'].fields_by_name['name']._serialized_options = b'\340A\010'
+  _globals['_TASK'].fields_by_name['title']._loaded_options = None
+  _globals['_TASK'].fields_by_name['title']._serialized_options = b'\372B\005r\003\030\200\004'
+  _globals['_TASK'].fields_by_name['notes']._loaded_options = None
+  _globals['_TASK'].fields_by_name['notes']._serialized_options = b'\372B\006r\004\030\200\200\001'
+  _globals['_TASK'].fields_by_name['due_time_zone']._loaded_options = None
+  _globals['_TASK'].fields_by_name['due_time_zone']._serialized_options = b'\372B\004r\002\030@'
+  _globals['_TASK'].fields_by_name['state']._loaded_options = None
+  _globals['_TASK'].fields_by_name['state']._serialized_options = b'\340A\003'
+  _globals['_TASK'].fields_by_name['completed_at']._loaded_options = None
+  _globals['_TASK'].fields_by_name['completed_at']._serialized_options = b'\340A\003'
+  _globals['_TASK'].fields_by_name['created_at']._loaded_options = None
+  _globals['_TASK'].fields_by_name['created_at']._serialized_options = b'\340A\003'
+  _globals['_TASK'].fields_by_name['updated_at']._loaded_options = None
+  _globals['_TASK'].fields_by_name['updated_at']._serialized_options = b'\340A\003'
+  _globals['_TASK'].fields_by_name['parent_task']._loaded_options = None
+  _globals['_TASK'].fields_by_name['parent_task']._serialized_options = b'\340A\005\372A\032\n\030tasks.readytogo.com/Task'
+  _globals['_TASK'].fields_by_name['depth']._loaded_options = None
+  _globals['_TASK'].fields_by_name['depth']._serialized_options = b'\340A\003'
+  _globals['_TASK'].fields_by_name['labels']._loaded_options = None
+  _globals['_TASK'].fields_by_name['labels']._serialized_options = b'\372A\033\n\031tasks.readytogo.com/Label\372B5\222\0012\020@\030\001\",r*2(^labels/[a-z]([a-z0-9-]{0,61}[a-z0-9])?$'
+  _globals['_TASK']._loaded_options = None
+  _globals['_TASK']._serialized_options = b'\352A;\n\030tasks.readytogo.com/Task\022\037projects/{project}/tasks/{task}'
+  _globals['_LISTTASKSREQUEST'].fields_by_name['parent']._loaded_options = None
+  _globals['_LISTTASKSREQUEST'].fields_by_name['parent']._serialized_options = b'\340A\002\372A\035\n\033tasks.readytogo.com/Project'
+  _globals['_LISTTASKSREQUEST'].fields_by_name['page_size']._loaded_options = None
+  _globals['_LISTTASKSREQUEST'].fields_by_name['page_size']._serialized_options = b'\340A\001\372B\004\032\002(\000'
+  _globals['_LISTTASKSREQUEST'].fields_by_name['page_token']._loaded_options = None
+  _globals['_LISTTASKSREQUEST'].fields_by_name['page_token']._serialized_options = b'\340A\001'
+  _globals['_LISTTASKSREQUEST'].fields_by_name['filter']._loaded_options = None
+  _globals['_LISTTASKSREQUEST'].fields_by_name['filter']._serialized_options = b'\340A\001\372B\005r\003\030\200\020'
+  _globals['_LISTTASKSREQUEST'].fields_by_name['order_by']._loaded_options = None
+  _globals['_LISTTASKSREQUEST'].fields_by_name['order_by']._serialized_options = b'\340A\001\372BFrD2B^((created_at|updated_at|due_time|priority|title)( (asc|desc))?)?$'
+  _globals['_LISTTASKSREQUEST'].fields_by_name['tree']._loaded_options = None
+  _globals['_LISTTASKSREQUEST'].fields_by_name['tree']._serialized_options = b'\340A\001'
+  _globals['_GETTASKREQUEST'].fields_by_name['name']._loaded_options = None
+  _globals['_GETTASKREQUEST'].fields_by_name['name']._serialized_options = b'\340A\002\372A\032\n\030tasks.readytogo.com/Task'
+  _globals['_CREATETASKREQUEST'].fields_by_name['parent']._loaded_options = None
+  _globals['_CREATETASKREQUEST'].fields_by_name['parent']._serialized_options = b'\340A\002\372A\035\n\033tasks.readytogo.com/Project'
+  _globals['_CREATETASKREQUEST'].fields_by_name['task_id']._loaded_options = None
+  _globals['_CREATETASKREQUEST'].fields_by_name['task_id']._serialized_options = b'\340A\002\372B\005r\003\260\001\001'
+  _globals['_CREATETASKREQUEST'].fields_by_name['task']._loaded_options = None
+  _globals['_CREATETASKREQUEST'].fields_by_name['task']._serialized_options = b'\340A\002\372B\005\212\001\002\020\001'
+  _globals['_UPDATETASKREQUEST'].fields_by_name['task']._loaded_options = None
+  _globals['_UPDATETASKREQUEST'].fields_by_name['task']._serialized_options = b'\340A\002\372B\005\212\001\002\020\001'
+  _globals['_UPDATETASKREQUEST'].fields_by_name['update_mask']._loaded_options = None
+  _globals['_UPDATETASKREQUEST'].fields_by_name['update_mask']._serialized_options = b'\340A\002\372B\005\212\001\002\020\001'
+  _globals['_DELETETASKREQUEST'].fields_by_name['name']._loaded_options = None
+  _globals['_DELETETASKREQUEST'].fields_by_name['name']._serialized_options = b'\340A\002\372A\032\n\030tasks.readytogo.com/Task'
+  _globals['_COMPLETETASKREQUEST'].fields_by_name['name']._loaded_options = None
+  _globals['_COMPLETETASKREQUEST'].fields_by_name['name']._serialized_options = b'\340A\002\372A\032\n\030tasks.readytogo.com/Task'
+  _globals['_COMPLETETASKREQUEST'].fields_by_name['cascade']._loaded_options = None
+  _globals['_COMPLETETASKREQUEST'].fields_by_name['cascade']._serialized_options = b'\340A\001'
+  _globals['_REOPENTASKREQUEST'].fields_by_name['name']._loaded_options = None
+  _globals['_REOPENTASKREQUEST'].fields_by_name['name']._serialized_options = b'\340A\002\372A\032\n\030tasks.readytogo.com/Task'
+  _globals['_MOVETASKREQUEST'].fields_by_name['name']._loaded_options = None
+  _globals['_MOVETASKREQUEST'].fields_by_name['name']._serialized_options = b'\340A\002\372A\032\n\030tasks.readytogo.com/Task'
+  _globals['_MOVETASKREQUEST'].fields_by_name['destination_parent_task']._loaded_options = None
+  _globals['_MOVETASKREQUEST'].fields_by_name['destination_parent_task']._serialized_options = b'\340A\001\372A\032\n\030tasks.readytogo.com/Task'
+  _globals['_MOVETASKREQUEST'].fields_by_name['destination_project']._loaded_options = None
+  _globals['_MOVETASKREQUEST'].fields_by_name['destination_project']._serialized_options = b'\340A\001\372A\035\n\033tasks.readytogo.com/Project'
+  _globals['_TASKSERVICE'].methods_by_name['ListTasks']._loaded_options = None
+  _globals['_TASKSERVICE'].methods_by_name['ListTasks']._serialized_options = b'\202\323\344\223\002\037\022\035/v1/{parent=projects/*}/tasks'
+  _globals['_TASKSERVICE'].methods_by_name['GetTask']._loaded_options = None
+  _globals['_TASKSERVICE'].methods_by_name['GetTask']._serialized_options = b'\202\323\344\223\002\037\022\035/v1/{name=projects/*/tasks/*}'
+  _globals['_TASKSERVICE'].methods_by_name['CreateTask']._loaded_options = None
+  _globals['_TASKSERVICE'].methods_by_name['CreateTask']._serialized_options = b'\202\323\344\223\002%\"\035/v1/{parent=projects/*}/tasks:\004task'
+  _globals['_TASKSERVICE'].methods_by_name['UpdateTask']._loaded_options = None
+  _globals['_TASKSERVICE'].methods_by_name['UpdateTask']._serialized_options = b'\202\323\344\223\002*2\"/v1/{task.name=projects/*/tasks/*}:\004task'
+  _globals['_TASKSERVICE'].methods_by_name['DeleteTask']._loaded_options = None
+  _globals['_TASKSERVICE'].methods_by_name['DeleteTask']._serialized_options = b'\202\323\344\223\002\037*\035/v1/{name=projects/*/tasks/*}'
+  _globals['_TASKSERVICE'].methods_by_name['CompleteTask']._loaded_options = None
+  _globals['_TASKSERVICE'].methods_by_name['CompleteTask']._serialized_options = b'\202\323\344\223\002+\"&/v1/{name=projects/*/tasks/*}:complete:\001*'
+  _globals['_TASKSERVICE'].methods_by_name['ReopenTask']._loaded_options = None
+  _globals['_TASKSERVICE'].methods_by_name['ReopenTask']._serialized_options = b'\202\323\344\223\002)\"$/v1/{name=projects/*/tasks/*}:reopen:\001*'
+  _globals['_TASKSERVICE'].methods_by_name['MoveTask']._loaded_options = None
+  _globals['_TASKSERVICE'].methods_by_name['MoveTask']._serialized_options = b'\202\323\344\223\002\'\"\"/v1/{name=projects/*/tasks/*}:move:\001*'
+  _globals['_TASK']._serialized_start=259
+  _globals['_TASK']._serialized_end=1040
+  _globals['_TASK_PRIORITY']._serialized_start=840
+  _globals['_TASK_PRIORITY']._serialized_end=919
+  _globals['_TASK_STATE']._serialized_start=921
+  _globals['_TASK_STATE']._serialized_end=976
+  _globals['_LISTTASKSREQUEST']._serialized_start=1043
+  _globals['_LISTTASKSREQUEST']._serialized_end=1314
+  _globals['_LISTTASKSRESPONSE']._serialized_start=1316
+  _globals['_LISTTASKSRESPONSE']._serialized_end=1391
+  _globals['_GETTASKREQUEST']._serialized_start=1393
+  _globals['_GETTASKREQUEST']._serialized_end=1457
+  _globals['_CREATETASKREQUEST']._serialized_start=1460
+  _globals['_CREATETASKREQUEST']._serialized_end=1605
+  _globals['_UPDATETASKREQUEST']._serialized_start=1607
+  _globals['_UPDATETASKREQUEST']._serialized_end=1731
+  _globals['_DELETETASKREQUEST']._serialized_start=1733
+  _globals['_DELETETASKREQUEST']._serialized_end=1800
+  _globals['_COMPLETETASKREQUEST']._serialized_start=1802
+  _globals['_COMPLETETASKREQUEST']._serialized_end=1893
+  _globals['_REOPENTASKREQUEST']._serialized_start=1895
+  _globals['_REOPENTASKREQUEST']._serialized_end=1962
+  _globals['_MOVETASKREQUEST']._serialized_start=1965
+  _globals['_MOVETASKREQUEST']._serialized_end=2163
+  _globals['_TASKSERVICE']._serialized_start=2166
+  _globals['_TASKSERVICE']._serialized_end=3023
+# @@protoc_insertion_point(module_scope)
@@ -0,0 +1,412 @@
+# Generated by the gRPC Python protocol compiler plugin. DO NOT EDIT!
+"""Client and server classes corresponding to protobuf-defined services."""
+import grpc
+import warnings
+
+from google.protobuf import empty_pb2 as google_dot_protobuf_dot_empty__pb2
+from proto.tasks.v1 import task_service_pb2 as proto_dot_tasks_dot_v1_dot_task__service__pb2
+
+GRPC_GENERATED_VERSION = '1.73.1'
+GRPC_VERSION = grpc.__version__
+_version_not_supported = False
+
+try:
+    from grpc._utilities import first_version_is_lower
+    _version_not_supported = first_version_is_lower(GRPC_VERSION, GRPC_GENERATED_VERSION)
+except ImportError:
+    _version_not_supported = True
+
+if _version_not_supported:
+    raise RuntimeError(
+        f'The grpc package installed is at version {GRPC_VERSION},'
+        + f' but the generated code in proto/tasks/v1/task_service_pb2_grpc.py depends on'
+        + f' grpcio>={GRPC_GENERATED_VERSION}.'
+        + f' Please upgrade your grpc module to grpcio>={GRPC_GENERATED_VERSION}'
+        + f' or downgrade your generated code using grpcio-tools<={GRPC_VERSION}.'
+    )
+
+
+class TaskServiceStub(object):
+    """TaskService is the service for managing the tasks of a project.
+    """
+
+    def __init__(self, channel):
+        """Constructor.
+
+        Args:
+            channel: A grpc.Channel.
+        """
+        self.ListTasks = channel.unary_unary(
+                '/tasks.v1.TaskService/ListTasks',
+                request_serializer=proto_dot_tasks_dot_v1_dot_task__service__pb2.ListTasksRequest.SerializeToString,
+                response_deserializer=proto_dot_tasks_dot_v1_dot_task__service__pb2.ListTasksResponse.FromString,
+                _registered_method=True)
+        self.GetTask = channel.unary_unary(
+                '/tasks.v1.TaskService/GetTask',
+                request_serializer=proto_dot_tasks_dot_v1_dot_task__service__pb2.GetTaskRequest.SerializeToString,
+                response_deserializer=proto_dot_tasks_dot_v1_dot_task__service__pb2.Task.FromString,
+                _registered_method=True)
+        self.CreateTask = channel.unary_unary(
+                '/tasks.v1.TaskService/CreateTask',
+                request_serializer=proto_dot_tasks_dot_v1_dot_task__service__pb2.CreateTaskRequest.SerializeToString,
+                response_deserializer=proto_dot_tasks_dot_v1_dot_task__service__pb2.Task.FromString,
+                _registered_method=True)
+        self.UpdateTask = channel.unary_unary(
+                '/tasks.v1.TaskService/UpdateTask',
+                request_serializer=proto_dot_tasks_dot_v1_dot_task__service__pb2.UpdateTaskRequest.SerializeToString,
+                response_deserializer=proto_dot_tasks_dot_v1_dot_task__service__pb2.Task.FromString,
+                _registered_method=True)
+        self.DeleteTask = channel.unary_unary(
+                '/tasks.v1.TaskService/DeleteTask',
+                request_serializer=proto_dot_tasks_dot_v1_dot_task__service__pb2.DeleteTaskRequest.SerializeToString,
+                response_deserializer=google_dot_protobuf_dot_empty__pb2.Empty.FromString,
+                _registered_method=True)
+        self.CompleteTask = channel.unary_unary(
+                '/tasks.v1.TaskService/CompleteTask',
+                request_serializer=proto_dot_tasks_dot_v1_dot_task__service__pb2.CompleteTaskRequest.SerializeToString,
+                response_deserializer=proto_dot_tasks_dot_v1_dot_task__service__pb2.Task.FromString,
+                _registered_method=True)
+        self.ReopenTask = channel.unary_unary(
+                '/tasks.v1.TaskService/ReopenTask',
+                request_serializer=proto_dot_tasks_dot_v1_dot_task__service__pb2.ReopenTaskRequest.SerializeToString,
+                response_deserializer=proto_dot_tasks_dot_v1_dot_task__service__pb2.Task.FromString,
+                _registered_method=True)
+        self.MoveTask = channel.unary_unary(
+                '/tasks.v1.TaskService/MoveTask',
+                request_serializer=proto_dot_tasks_dot_v1_dot_task__service__pb2.MoveTaskRequest.SerializeToString,
+                response_deserializer=proto_dot_tasks_dot_v1_dot_task__service__pb2.Task.FromString,
+                _registered_method=True)
+
+
+class TaskServiceServicer(object):
+    """TaskService is the service for managing the tasks of a project.
+    """
+
+    def ListTasks(self, request, context):
+        """ListTasks lists the tasks of a project.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetTask(self, request, context):
+        """GetTask gets a task.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def CreateTask(self, request, context):
+        """CreateTask creates a task in an ACTIVE project.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def UpdateTask(self, request, context):
+        """UpdateTask updates a task.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeleteTask(self, request, context):
+        """DeleteTask permanently deletes a task together with its subtasks.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def CompleteTask(self, request, context):
+        """CompleteTask moves an OPEN task to the COMPLETED state.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ReopenTask(self, request, context):
+        """ReopenTask moves a COMPLETED task back to the OPEN state.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def MoveTask(self, request, context):
+        """MoveTask moves a task with its subtasks under another parent task or to
+        the top level, possibly in another project. Moving a task to another
+        project changes the names of the task and all of its subtasks.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+
+def add_TaskServiceServicer_to_server(servicer, server):
+    rpc_method_handlers = {
+            'ListTasks': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListTasks,
+                    request_deserializer=proto_dot_tasks_dot_v1_dot_task__service__pb2.ListTasksRequest.FromString,
+                    response_serializer=proto_dot_tasks_dot_v1_dot_task__service__pb2.ListTasksResponse.SerializeToString,
+            ),
+            'GetTask': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetTask,
+                    request_deserializer=proto_dot_tasks_dot_v1_dot_task__service__pb2.GetTaskRequest.FromString,
+                    response_serializer=proto_dot_tasks_dot_v1_dot_task__service__pb2.Task.SerializeToString,
+            ),
+            'CreateTask': grpc.unary_unary_rpc_method_handler(
+                    servicer.CreateTask,
+                    request_deserializer=proto_dot_tasks_dot_v1_dot_task__service__pb2.CreateTaskRequest.FromString,
+                    response_serializer=proto_dot_tasks_dot_v1_dot_task__service__pb2.Task.SerializeToString,
+            ),
+            'UpdateTask': grpc.unary_unary_rpc_method_handler(
+                    servicer.UpdateTask,
+                    request_deserializer=proto_dot_tasks_dot_v1_dot_task__service__pb2.UpdateTaskRequest.FromString,
+                    response_serializer=proto_dot_tasks_dot_v1_dot_task__service__pb2.Task.SerializeToString,
+            ),
+            'DeleteTask': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeleteTask,
+                    request_deserializer=proto_dot_tasks_dot_v1_dot_task__service__pb2.DeleteTaskRequest.FromString,
+                    response_serializer=google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
+            ),
+            'CompleteTask': grpc.unary_unary_rpc_method_handler(
+                    servicer.CompleteTask,
+                    request_deserializer=proto_dot_tasks_dot_v1_dot_task__service__pb2.CompleteTaskRequest.FromString,
+                    response_serializer=proto_dot_tasks_dot_v1_dot_task__service__pb2.Task.SerializeToString,
+            ),
+            'ReopenTask': grpc.unary_unary_rpc_method_handler(
+                    servicer.ReopenTask,
+                    request_deserializer=proto_dot_tasks_dot_v1_dot_task__service__pb2.ReopenTaskRequest.FromString,
+                    response_serializer=proto_dot_tasks_dot_v1_dot_task__service__pb2.Task.SerializeToString,
+            ),
+            'MoveTask': grpc.unary_unary_rpc_method_handler(
+                    servicer.MoveTask,
+                    request_deserializer=proto_dot_tasks_dot_v1_dot_task__service__pb2.MoveTaskRequest.FromString,
+                    response_serializer=proto_dot_tasks_dot_v1_dot_task__service__pb2.Task.SerializeToString,
+            ),
+    }
+    generic_handler = grpc.method_handlers_generic_handler(
+            'tasks.v1.TaskService', rpc_method_handlers)
+    server.add_generic_rpc_handlers((generic_handler,))
+    server.add_registered_method_handlers('tasks.v1.TaskService', rpc_method_handlers)
+
+
+ # This class is part of an EXPERIMENTAL API.
+class TaskService(object):
+    """TaskService is the service for managing the tasks of a project.
+    """
+
+    @staticmethod
+    def ListTasks(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/tasks.v1.TaskService/ListTasks',
+            proto_dot_tasks_dot_v1_dot_task__service__pb2.ListTasksRequest.SerializeToString,
+            proto_dot_tasks_dot_v1_dot_task__service__pb2.ListTasksResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def GetTask(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/tasks.v1.TaskService/GetTask',
+            proto_dot_tasks_dot_v1_dot_task__service__pb2.GetTaskRequest.SerializeToString,
+            proto_dot_tasks_dot_v1_dot_task__service__pb2.Task.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def CreateTask(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/tasks.v1.TaskService/CreateTask',
+            proto_dot_tasks_dot_v1_dot_task__service__pb2.CreateTaskRequest.SerializeToString,
+            proto_dot_tasks_dot_v1_dot_task__service__pb2.Task.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def UpdateTask(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/tasks.v1.TaskService/UpdateTask',
+            proto_dot_tasks_dot_v1_dot_task__service__pb2.UpdateTaskRequest.SerializeToString,
+            proto_dot_tasks_dot_v1_dot_task__service__pb2.Task.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def DeleteTask(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/tasks.v1.TaskService/DeleteTask',
+            proto_dot_tasks_dot_v1_dot_task__service__pb2.DeleteTaskRequest.SerializeToString,
+            google_dot_protobuf_dot_empty__pb2.Empty.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def CompleteTask(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/tasks.v1.TaskService/CompleteTask',
+            proto_dot_tasks_dot_v1_dot_task__service__pb2.CompleteTaskRequest.SerializeToString,
+            proto_dot_tasks_dot_v1_dot_task__service__pb2.Task.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ReopenTask(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/tasks.v1.TaskService/ReopenTask',
+            proto_dot_tasks_dot_v1_dot_task__service__pb2.ReopenTaskRequest.SerializeToString,
+            proto_dot_tasks_dot_v1_dot_task__service__pb2.Task.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def MoveTask(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/tasks.v1.TaskService/MoveTask',
+            proto_dot_tasks_dot_v1_dot_task__service__pb2.MoveTaskRequest.SerializeToString,
+            proto_dot_tasks_dot_v1_dot_task__service__pb2.Task.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
@@ -0,0 +1,10 @@
+// Package contracts exposes artifacts generated from the API definitions.
+package contracts
+
+import "embed"
+
+// OpenAPI holds the generated OpenAPI v2 documents, one per proto file,
+// under gen/swagger.
+//
+//go:embed gen/swagger
+var OpenAPI embed.FS
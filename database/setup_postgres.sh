@@ -2,7 +2,12 @@
 
 
 # Docker PostgreSQL Setup Script
-# Usage: ./setup_postgres.sh [db_name] [username] [password] (defaults: mydb, postgres, postgres)
+# Usage: ./setup_postgres.sh [db_name] [username] [password] [app_username] [app_password]
+#   (defaults: db, postgres, postgres, ready_to_do, ready_to_do)
+#
+# username is the superuser of the container. The server connects as
+# app_username, an ordinary role that owns the database: row level security
+# does not apply to superusers and roles with BYPASSRLS.
 
 set -e
 
@@ -10,6 +15,8 @@ set -e
 DB_NAME=${1:-db}
 DB_USER=${2:-postgres}
 DB_PASSWORD=${3:-postgres}
+APP_USER=${4:-ready_to_do}
+APP_PASSWORD=${5:-ready_to_do}
 DIR=$(pwd)
 DATA_DIR="$DIR/data"
 CONTAINER_NAME="postgres_${DB_NAME}"
@@ -42,8 +49,20 @@ docker run --name "$CONTAINER_NAME" \
     -d postgres
 echo "The container started successfully"
 
-echo "Initializing tables"
-docker exec -i $CONTAINER_NAME psql -U $DB_USER -d $DB_NAME < init_tables.sql
+echo "Waiting for PostgreSQL to accept connections"
+until docker exec "$CONTAINER_NAME" pg_isready -U "$DB_USER" -d "$DB_NAME" > /dev/null 2>&1; do
+    sleep 1
+done
+echo "Done"
+
+echo "Creating the application role $APP_USER..."
+docker exec -i "$CONTAINER_NAME" psql -v ON_ERROR_STOP=1 -U "$DB_USER" -d "$DB_NAME" \
+    -v app_user="$APP_USER" -v app_password="$APP_PASSWORD" -v db_name="$DB_NAME" > /dev/null <<'SQL'
+CREATE ROLE :"app_user" LOGIN PASSWORD :'app_password'
+    NOSUPERUSER NOBYPASSRLS NOCREATEDB NOCREATEROLE NOREPLICATION;
+ALTER DATABASE :"db_name" OWNER TO :"app_user";
+ALTER SCHEMA public OWNER TO :"app_user";
+SQL
 echo "Done"
 
 echo ""
@@ -52,11 +71,14 @@ echo "Connection details:"
 echo "  Host: localhost"
 echo "  Port: $PORT"
 echo "  Database: $DB_NAME"
-echo "  Username: $DB_USER"
-echo "  Password: $DB_PASSWORD"
+echo "  Username: $APP_USER"
+echo "  Password: $APP_PASSWORD"
+echo "  Superuser: $DB_USER (password: $DB_PASSWORD), for administration only"
 echo ""
 echo "Data is stored in: $DATA_DIR"
+echo "Apply the schema migrations with:"
+echo "  cd ../server && go run ./cmd/server --config <config.yaml> migrate up"
 echo "To connect using psql:"
-echo "  docker exec -it $CONTAINER_NAME psql -U $DB_USER -d $DB_NAME"
+echo "  docker exec -it $CONTAINER_NAME psql -U $APP_USER -d $DB_NAME"
 echo "Or from host machine:"
-echo "  PGPASSWORD=$DB_PASSWORD psql -h localhost -p $PORT -U $DB_USER -d $DB_NAME"
+echo "  PGPASSWORD=$APP_PASSWORD psql -h localhost -p $PORT -U $APP_USER -d $DB_NAME"
@@ -1,16 +1,75 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/10Narratives/ready-to-do/server/internal/app"
 	"github.com/10Narratives/ready-to-do/server/internal/config"
 )
 
+// shutdownTimeout bounds how long in-flight requests may take to finish.
+const shutdownTimeout = 10 * time.Second
+
 func main() {
-	_, err := app.New(config.MustLoad())
+	cfg := config.MustLoad()
+	if flag.Arg(0) == "migrate" {
+		os.Exit(runMigrate(cfg, flag.Args()[1:]))
+	}
+
+	application, err := app.New(cfg)
 	if err != nil {
 		panic(fmt.Sprintf("cannot initialize server application: %s", err.Error()))
 	}
 
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	if err := application.PGApp.Run(); err != nil {
+		panic(fmt.Sprintf("cannot start postgres component: %s", err.Error()))
+	}
+
+	serveErr := make(chan error, 2)
+	go func() {
+		serveErr <- application.GRPCApp.Run()
+	}()
+	if application.GatewayApp != nil {
+		go func() {
+			serveErr <- application.GatewayApp.Run()
+		}()
+	}
+	go func() {
+		_ = application.PurgerApp.Run()
+	}()
+	go func() {
+		_ = application.IdempotencyPurgerApp.Run()
+	}()
+	go func() {
+		_ = application.RebalancerApp.Run()
+	}()
+	go func() {
+		_ = application.ChangeNotifierApp.Run()
+	}()
+
+	select {
+	case <-ctx.Done():
+		application.Logger.Info("shutting down")
+	case err := <-serveErr:
+		if err != nil {
+			application.Logger.Error("server failed", slog.String("error", err.Error()))
+		}
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := application.Stop(shutdownCtx); err != nil {
+		application.Logger.Error("cannot stop server application", slog.String("error", err.Error()))
+	}
 }
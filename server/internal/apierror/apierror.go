@@ -0,0 +1,130 @@
+// Package apierror builds the statuses the API fails with.
+//
+// Besides a code and a message, statuses carry google.rpc error details that
+// clients can act on without parsing messages: an ErrorInfo with a stable
+// reason in Domain, ResourceInfo for the resources involved, BadRequest field
+// violations for invalid requests and RetryInfo for errors worth retrying.
+package apierror
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Domain is the ErrorInfo domain of all reasons below.
+const Domain = "tasks.readytogo.com"
+
+// Reasons of ErrorInfo details. They are part of the API and must not change.
+const (
+	ReasonNotFound           = "RESOURCE_NOT_FOUND"
+	ReasonAlreadyExists      = "RESOURCE_ALREADY_EXISTS"
+	ReasonConcurrentChange   = "CONCURRENT_CHANGE"
+	ReasonEtagMismatch       = "ETAG_MISMATCH"
+	ReasonInvalidState       = "INVALID_STATE"
+	ReasonProjectArchived    = "PROJECT_ARCHIVED"
+	ReasonNestingTooDeep     = "NESTING_TOO_DEEP"
+	ReasonRequestIDReused    = "REQUEST_ID_REUSED"
+	ReasonRequestInProgress  = "REQUEST_IN_PROGRESS"
+	ReasonChangeTokenExpired = "CHANGE_TOKEN_EXPIRED"
+	ReasonShuttingDown       = "SHUTTING_DOWN"
+	ReasonMissingCredentials = "MISSING_CREDENTIALS"
+	ReasonInvalidCredentials = "INVALID_CREDENTIALS"
+	ReasonPermissionDenied   = "PERMISSION_DENIED"
+)
+
+// RetryDelay is the delay suggested to clients for errors that are expected
+// to go away on their own, such as lost races.
+const RetryDelay = time.Second
+
+// Metadata holds the ErrorInfo metadata of a status. Keys are lowerCamelCase.
+type Metadata map[string]string
+
+// New returns a status with an ErrorInfo detail of reason and metadata,
+// followed by details.
+func New(code codes.Code, reason string, metadata Metadata, message string, details ...protoadapt.MessageV1) *status.Status {
+	info := &errdetails.ErrorInfo{
+		Reason:   reason,
+		Domain:   Domain,
+		Metadata: metadata,
+	}
+	return withDetails(status.New(code, message), append([]protoadapt.MessageV1{info}, details...)...)
+}
+
+// NotFound returns a NotFound status for resources of resourceType, with one
+// ResourceInfo detail per name.
+func NotFound(resourceType string, names ...string) *status.Status {
+	noun := kind(resourceType)
+	quoted := make([]string, 0, len(names))
+	details := make([]protoadapt.MessageV1, 0, len(names))
+	for _, name := range names {
+		quoted = append(quoted, strconv.Quote(name))
+		details = append(details, Resource(resourceType, name, ""))
+	}
+
+	message := fmt.Sprintf("%s %s not found", noun, strings.Join(quoted, ", "))
+	if len(names) > 1 {
+		message = fmt.Sprintf("%ss %s not found", noun, strings.Join(quoted, ", "))
+	}
+	metadata := Metadata{"resourceType": resourceType, "resourceName": strings.Join(names, ",")}
+	return New(codes.NotFound, ReasonNotFound, metadata, message, details...)
+}
+
+// AlreadyExists returns an AlreadyExists status for the named resource of
+// resourceType.
+func AlreadyExists(resourceType, name string) *status.Status {
+	message := fmt.Sprintf("%s %q already exists", kind(resourceType), name)
+	metadata := Metadata{"resourceType": resourceType, "resourceName": name}
+	return New(codes.AlreadyExists, ReasonAlreadyExists, metadata, message, Resource(resourceType, name, ""))
+}
+
+// Aborted returns an Aborted status that suggests retrying after RetryDelay.
+func Aborted(reason string, metadata Metadata, message string, details ...protoadapt.MessageV1) *status.Status {
+	return New(codes.Aborted, reason, metadata, message, append(details, Retry(RetryDelay))...)
+}
+
+// Unavailable returns an Unavailable status that suggests retrying after
+// delay.
+func Unavailable(reason, message string, delay time.Duration) *status.Status {
+	return New(codes.Unavailable, reason, nil, message, Retry(delay))
+}
+
+// Resource returns a ResourceInfo detail.
+func Resource(resourceType, name, description string) *errdetails.ResourceInfo {
+	return &errdetails.ResourceInfo{
+		ResourceType: resourceType,
+		ResourceName: name,
+		Description:  description,
+	}
+}
+
+// Retry returns a RetryInfo detail.
+func Retry(delay time.Duration) *errdetails.RetryInfo {
+	return &errdetails.RetryInfo{RetryDelay: durationpb.New(delay)}
+}
+
+// kind returns the lower-case type name of a resource type, e.g. "project"
+// for "tasks.readytogo.com/Project".
+func kind(resourceType string) string {
+	_, name, _ := strings.Cut(resourceType, "/")
+	if name == "" {
+		return "resource"
+	}
+	return strings.ToLower(name)
+}
+
+// withDetails attaches details to stat. Details always marshal, so stat is
+// returned without them only if something is badly wrong.
+func withDetails(stat *status.Status, details ...protoadapt.MessageV1) *status.Status {
+	if detailed, err := stat.WithDetails(details...); err == nil {
+		return detailed
+	}
+	return stat
+}
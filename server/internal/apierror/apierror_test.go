@@ -0,0 +1,146 @@
+package apierror_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	tasksv1 "github.com/10Narratives/ready-to-do/contracts/gen/go/proto/tasks/v1"
+	"github.com/10Narratives/ready-to-do/server/internal/apierror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const projectType = "tasks.readytogo.com/Project"
+
+func TestInvalidRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		err            error
+		wantMessage    string
+		wantViolations []string
+	}{
+		{
+			name: "validation errors",
+			err: (&tasksv1.CreateProjectRequest{
+				ProjectId: "1",
+				Project:   &tasksv1.Project{ColorTag: "nope", Labels: []string{"Urgent"}},
+			}).ValidateAll(),
+			wantViolations: []string{"project_id", "project.color_tag", "project.labels[0]"},
+		},
+		{
+			name:           "field error",
+			err:            fmt.Errorf("cannot convert request: %w", apierror.Fieldf("update_mask", "update mask must not be empty")),
+			wantMessage:    "invalid request: update_mask: update mask must not be empty",
+			wantViolations: []string{"update_mask"},
+		},
+		{
+			name:        "other error",
+			err:         errors.New("boom"),
+			wantMessage: "invalid request: boom",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			stat := apierror.InvalidRequest(tt.err)
+			require.Equal(t, codes.InvalidArgument, stat.Code())
+			if tt.wantMessage != "" {
+				assert.Equal(t, tt.wantMessage, stat.Message())
+			}
+			assert.Equal(t, tt.wantViolations, violationFields(stat))
+		})
+	}
+}
+
+func TestBadRequest(t *testing.T) {
+	t.Parallel()
+
+	stat := apierror.BadRequest("invalid request",
+		apierror.Violation("names[0]", "not a name"),
+		apierror.Violation("names[2]", "not a name"))
+
+	assert.Equal(t, codes.InvalidArgument, stat.Code())
+	assert.Equal(t, "invalid request: names[0]: not a name (and 1 more)", stat.Message())
+	assert.Equal(t, []string{"names[0]", "names[2]"}, violationFields(stat))
+}
+
+func TestNotFound(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		names       []string
+		wantMessage string
+	}{
+		{
+			name:        "one resource",
+			names:       []string{"projects/a"},
+			wantMessage: `project "projects/a" not found`,
+		},
+		{
+			name:        "many resources",
+			names:       []string{"projects/a", "projects/b"},
+			wantMessage: `projects "projects/a", "projects/b" not found`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			stat := apierror.NotFound(projectType, tt.names...)
+			assert.Equal(t, codes.NotFound, stat.Code())
+			assert.Equal(t, tt.wantMessage, stat.Message())
+
+			var resources []string
+			for _, detail := range stat.Details() {
+				switch detail := detail.(type) {
+				case *errdetails.ErrorInfo:
+					assert.Equal(t, apierror.ReasonNotFound, detail.GetReason())
+					assert.Equal(t, apierror.Domain, detail.GetDomain())
+					assert.Equal(t, projectType, detail.GetMetadata()["resourceType"])
+				case *errdetails.ResourceInfo:
+					assert.Equal(t, projectType, detail.GetResourceType())
+					resources = append(resources, detail.GetResourceName())
+				}
+			}
+			assert.Equal(t, tt.names, resources)
+		})
+	}
+}
+
+func TestAborted(t *testing.T) {
+	t.Parallel()
+
+	stat := apierror.Aborted(apierror.ReasonConcurrentChange, apierror.Metadata{"resourceName": "projects/a"}, "changed concurrently")
+	assert.Equal(t, codes.Aborted, stat.Code())
+
+	var delay time.Duration
+	for _, detail := range stat.Details() {
+		if retry, ok := detail.(*errdetails.RetryInfo); ok {
+			delay = retry.GetRetryDelay().AsDuration()
+		}
+	}
+	assert.Equal(t, apierror.RetryDelay, delay)
+}
+
+func violationFields(stat *status.Status) []string {
+	var fields []string
+	for _, detail := range stat.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.GetFieldViolations() {
+				fields = append(fields, violation.GetField())
+			}
+		}
+	}
+	return fields
+}
@@ -0,0 +1,134 @@
+package apierror
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FieldError is an error about one field of a request. Request conversions
+// return it so that InvalidRequest can report the field.
+type FieldError struct {
+	// Field is the path of the field with proto field names, e.g.
+	// "project.color_tag".
+	Field string
+	Err   error
+}
+
+// Field returns a FieldError for field.
+func Field(field string, err error) error {
+	return &FieldError{Field: field, Err: err}
+}
+
+// Fieldf returns a FieldError for field with a formatted error.
+func Fieldf(field, format string, args ...any) error {
+	return &FieldError{Field: field, Err: fmt.Errorf(format, args...)}
+}
+
+func (e *FieldError) Error() string {
+	return e.Field + ": " + e.Err.Error()
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// Violation returns a field violation.
+func Violation(field, description string) *errdetails.BadRequest_FieldViolation {
+	return &errdetails.BadRequest_FieldViolation{Field: field, Description: description}
+}
+
+// BadRequest returns an InvalidArgument status carrying violations as a
+// BadRequest detail. The message is summary followed by the first violation.
+func BadRequest(summary string, violations ...*errdetails.BadRequest_FieldViolation) *status.Status {
+	message := summary
+	if len(violations) > 0 {
+		message = fmt.Sprintf("%s: %s: %s", summary, violations[0].GetField(), violations[0].GetDescription())
+		if len(violations) > 1 {
+			message += fmt.Sprintf(" (and %d more)", len(violations)-1)
+		}
+	}
+	return withDetails(status.New(codes.InvalidArgument, message), &errdetails.BadRequest{FieldViolations: violations})
+}
+
+// InvalidRequest returns an InvalidArgument status for an error of a
+// generated ValidateAll method or of a request conversion. Errors that name
+// their fields become field violations.
+func InvalidRequest(err error) *status.Status {
+	violations := FieldViolations("", err)
+	for _, violation := range violations {
+		if violation.GetField() == "" {
+			return status.Newf(codes.InvalidArgument, "invalid request: %v", err)
+		}
+	}
+	return BadRequest("invalid request", violations...)
+}
+
+// validationError is implemented by the errors of generated Validate methods.
+type validationError interface {
+	error
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// FieldViolations flattens an error of a generated ValidateAll method, or a
+// FieldError, into field violations. Field paths use proto field names below
+// prefix, e.g. "requests[2].project.color_tag". Other errors yield a single
+// violation of prefix itself.
+func FieldViolations(prefix string, err error) []*errdetails.BadRequest_FieldViolation {
+	if multi, ok := err.(interface{ AllErrors() []error }); ok {
+		var violations []*errdetails.BadRequest_FieldViolation
+		for _, err := range multi.AllErrors() {
+			violations = append(violations, FieldViolations(prefix, err)...)
+		}
+		return violations
+	}
+
+	var ferr *FieldError
+	if errors.As(err, &ferr) {
+		return []*errdetails.BadRequest_FieldViolation{Violation(join(prefix, ferr.Field), ferr.Err.Error())}
+	}
+
+	var verr validationError
+	if !errors.As(err, &verr) {
+		return []*errdetails.BadRequest_FieldViolation{Violation(prefix, err.Error())}
+	}
+
+	field := join(prefix, fieldPath(verr.Field()))
+	if cause := verr.Cause(); cause != nil {
+		var nested validationError
+		if _, ok := cause.(interface{ AllErrors() []error }); ok || errors.As(cause, &nested) {
+			return FieldViolations(field, cause)
+		}
+	}
+	return []*errdetails.BadRequest_FieldViolation{Violation(field, verr.Reason())}
+}
+
+func join(prefix, field string) string {
+	if prefix == "" {
+		return field
+	}
+	return prefix + "." + field
+}
+
+// fieldPath converts a generated Go field name such as "ColorTag" or
+// "Labels[3]" to the proto field name.
+func fieldPath(goName string) string {
+	var b strings.Builder
+	for i, r := range goName {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -2,18 +2,48 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 
 	"github.com/10Narratives/ready-to-do/common/pkg/logging/sl"
+	gatewayapp "github.com/10Narratives/ready-to-do/server/internal/app/gateway"
 	grpcapp "github.com/10Narratives/ready-to-do/server/internal/app/grpc"
+	notifierapp "github.com/10Narratives/ready-to-do/server/internal/app/notifier"
 	pgapp "github.com/10Narratives/ready-to-do/server/internal/app/postgres"
+	purgerapp "github.com/10Narratives/ready-to-do/server/internal/app/purger"
+	rebalancerapp "github.com/10Narratives/ready-to-do/server/internal/app/rebalancer"
+	"github.com/10Narratives/ready-to-do/server/internal/auth"
 	"github.com/10Narratives/ready-to-do/server/internal/config"
+	idempotencysrv "github.com/10Narratives/ready-to-do/server/internal/services/idempotency"
+	labelsrv "github.com/10Narratives/ready-to-do/server/internal/services/tasks/label"
+	projectsrv "github.com/10Narratives/ready-to-do/server/internal/services/tasks/project"
+	tasksrv "github.com/10Narratives/ready-to-do/server/internal/services/tasks/task"
+	usersrv "github.com/10Narratives/ready-to-do/server/internal/services/tasks/user"
+	idempotencystore "github.com/10Narratives/ready-to-do/server/internal/storages/idempotency"
+	labelstore "github.com/10Narratives/ready-to-do/server/internal/storages/tasks/label"
+	projectstore "github.com/10Narratives/ready-to-do/server/internal/storages/tasks/project"
+	taskstore "github.com/10Narratives/ready-to-do/server/internal/storages/tasks/task"
+	userstore "github.com/10Narratives/ready-to-do/server/internal/storages/tasks/user"
+	"github.com/10Narratives/ready-to-do/server/internal/transport/grpc/interceptors"
+	labelapi "github.com/10Narratives/ready-to-do/server/internal/transport/grpc/tasks/label"
+	projectapi "github.com/10Narratives/ready-to-do/server/internal/transport/grpc/tasks/project"
+	taskapi "github.com/10Narratives/ready-to-do/server/internal/transport/grpc/tasks/task"
+	userapi "github.com/10Narratives/ready-to-do/server/internal/transport/grpc/tasks/user"
 )
 
 type App struct {
 	GRPCApp *grpcapp.App
-	PGApp   *pgapp.App
+	// GatewayApp is nil when the HTTP gateway is disabled.
+	GatewayApp *gatewayapp.App
+	PGApp      *pgapp.App
+	PurgerApp  *purgerapp.App
+	// IdempotencyPurgerApp removes expired request IDs.
+	IdempotencyPurgerApp *purgerapp.App
+	// RebalancerApp keeps project positions short.
+	RebalancerApp *rebalancerapp.App
+	// ChangeNotifierApp wakes WatchProjects streams up on project changes.
+	ChangeNotifierApp *notifierapp.App
 
 	Logger *slog.Logger
 }
@@ -28,23 +58,108 @@ func New(cfg *config.Config) (*App, error) {
 		return nil, fmt.Errorf("cannot initialize logger: %s", err.Error())
 	}
 
-	grpcApp, err := grpcapp.New(&cfg.Transport.GRPC)
+	pgApp, err := pgapp.New(&cfg.Database)
 	if err != nil {
-		return nil, fmt.Errorf("cannot initialize gRPC component: %s", err.Error())
+		return nil, fmt.Errorf("cannot initalize postgres component: %s", err.Error())
 	}
 
-	pgApp, err := pgapp.New(&cfg.Database)
+	idempotencyService := idempotencysrv.New(
+		idempotencystore.New(pgApp.DB()),
+		idempotencysrv.WithTTL(cfg.Service.Idempotency.TTL),
+	)
+	changeNotifierApp := notifierapp.New(pgApp.DB(), projectstore.ChangesChannel, logger)
+	projectStorage := projectstore.New(pgApp.DB())
+	projectService := projectsrv.New(
+		projectStorage,
+		projectsrv.WithPageTokenSecret(cfg.Service.Projects.TokenSecret),
+		projectsrv.WithRetention(cfg.Service.Projects.Retention),
+		projectsrv.WithMaxPositionLength(cfg.Service.Projects.MaxPositionLength),
+		projectsrv.WithMaxBatchSize(cfg.Service.Projects.MaxBatchSize),
+		projectsrv.WithIdempotency(idempotencyService),
+		projectsrv.WithChangeNotifier(changeNotifierApp),
+		projectsrv.WithChangeRetention(cfg.Service.Projects.ChangeRetention),
+		projectsrv.WithWatchIntervals(cfg.Service.Projects.HeartbeatInterval, cfg.Service.Projects.PollInterval),
+	)
+	taskService := tasksrv.New(
+		taskstore.New(pgApp.DB()),
+		projectStorage,
+		tasksrv.WithPageTokenSecret(cfg.Service.Projects.TokenSecret),
+		tasksrv.WithMaxDepth(cfg.Service.Tasks.MaxDepth),
+	)
+	labelService := labelsrv.New(
+		labelstore.New(pgApp.DB()),
+		labelsrv.WithPageTokenSecret(cfg.Service.Projects.TokenSecret),
+	)
+
+	userService := usersrv.New(userstore.New(pgApp.DB()))
+	// A nil authenticator serves every call anonymously.
+	var authenticator interceptors.Authenticator
+	if cfg.Auth.Enabled {
+		jwtAuthenticator, err := auth.New(context.Background(), &cfg.Auth, userService)
+		if err != nil {
+			return nil, fmt.Errorf("cannot initialize authentication: %s", err.Error())
+		}
+		authenticator = jwtAuthenticator
+	}
+
+	grpcApp, err := grpcapp.New(&cfg.Transport.GRPC, authenticator, projectService, taskService, labelService, userService)
 	if err != nil {
-		return nil, fmt.Errorf("cannot initalize postgres component: %s", err.Error())
+		return nil, fmt.Errorf("cannot initialize gRPC component: %s", err.Error())
+	}
+
+	var gatewayApp *gatewayapp.App
+	if cfg.Transport.HTTP.Enabled {
+		gatewayApp, err = gatewayapp.New(&cfg.Transport.HTTP, &cfg.Transport.GRPC, projectapi.New(projectService), taskapi.New(taskService), labelapi.New(labelService), userapi.New(userService), grpcApp.UnaryInterceptor(), grpcApp.StreamInterceptor())
+		if err != nil {
+			return nil, fmt.Errorf("cannot initialize HTTP gateway component: %s", err.Error())
+		}
 	}
 
+	purgerApp := purgerapp.New(projectService, cfg.Service.Projects.PurgeInterval, logger)
+	idempotencyPurgerApp := purgerapp.New(idempotencyService, cfg.Service.Idempotency.PurgeInterval, logger)
+	rebalancerApp := rebalancerapp.New(projectService, cfg.Service.Projects.RebalanceInterval, logger)
+
 	return &App{
-		GRPCApp: grpcApp,
-		PGApp:   pgApp,
-		Logger:  logger,
+		GRPCApp:              grpcApp,
+		GatewayApp:           gatewayApp,
+		PGApp:                pgApp,
+		PurgerApp:            purgerApp,
+		IdempotencyPurgerApp: idempotencyPurgerApp,
+		RebalancerApp:        rebalancerApp,
+		ChangeNotifierApp:    changeNotifierApp,
+		Logger:               logger,
 	}, nil
 }
 
+// Stop shuts the components down in reverse dependency order: the servers
+// first, so no new requests reach the database while it closes. The change
+// notifier goes even before them, which ends the WatchProjects streams that
+// would otherwise keep the servers from stopping gracefully. Every component
+// is stopped even if others fail to; their errors are joined.
 func (a *App) Stop(ctx context.Context) error {
-	return nil
+	var errs []error
+	if err := a.ChangeNotifierApp.Stop(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("cannot stop change notifier: %w", err))
+	}
+	if a.GatewayApp != nil {
+		if err := a.GatewayApp.Stop(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("cannot stop HTTP gateway component: %w", err))
+		}
+	}
+	if err := a.GRPCApp.Stop(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("cannot stop gRPC component: %w", err))
+	}
+	if err := a.PurgerApp.Stop(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("cannot stop purger: %w", err))
+	}
+	if err := a.IdempotencyPurgerApp.Stop(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("cannot stop idempotency purger: %w", err))
+	}
+	if err := a.RebalancerApp.Stop(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("cannot stop rebalancer: %w", err))
+	}
+	if err := a.PGApp.Stop(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("cannot stop postgres component: %w", err))
+	}
+	return errors.Join(errs...)
 }
@@ -0,0 +1,190 @@
+package gatewayapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"strconv"
+
+	"github.com/10Narratives/ready-to-do/common/pkg/logging/sl"
+	tasksv1 "github.com/10Narratives/ready-to-do/contracts/gen/go/proto/tasks/v1"
+	transportcfg "github.com/10Narratives/ready-to-do/server/internal/config/transport"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+type App struct {
+	server *http.Server
+	conn   *grpc.ClientConn
+	logger *slog.Logger
+}
+
+// New builds the HTTP/JSON gateway. In the in-process mode requests are
+// passed to projectServer, taskServer, labelServer and userServer through the
+// unary and stream interceptors of the gRPC server, in the dial mode they are
+// forwarded to the gRPC server described by grpcCfg.
+func New(cfg *transportcfg.HTTP, grpcCfg *transportcfg.GRPC, projectServer tasksv1.ProjectServiceServer, taskServer tasksv1.TaskServiceServer, labelServer tasksv1.LabelServiceServer, userServer tasksv1.UserServiceServer, unary grpc.UnaryServerInterceptor, stream grpc.StreamServerInterceptor) (*App, error) {
+	logger, err := sl.New(
+		sl.WithLevel(cfg.Logging.Level),
+		sl.WithFormat(cfg.Logging.Format),
+		sl.WithOutput(cfg.Logging.Output),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot initialize gateway logger: %v", err)
+	}
+
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				UseProtoNames:   cfg.UseProtoNames,
+				EmitUnpopulated: cfg.EmitUnpopulated,
+			},
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				DiscardUnknown: true,
+			},
+		}),
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+		runtime.WithForwardResponseOption(setETag),
+		runtime.WithErrorHandler(handleError),
+	)
+
+	a := &App{logger: logger}
+
+	ctx := context.Background()
+	switch cfg.Mode {
+	case transportcfg.GatewayModeInProcess:
+		projectServer = &interceptedProjectServer{next: projectServer, unary: unary, stream: stream}
+		taskServer = &interceptedTaskServer{next: taskServer, unary: unary}
+		labelServer = &interceptedLabelServer{next: labelServer, unary: unary}
+		userServer = &interceptedUserServer{next: userServer, unary: unary}
+		if err := tasksv1.RegisterProjectServiceHandlerServer(ctx, mux, projectServer); err != nil {
+			return nil, fmt.Errorf("cannot register project service handler: %v", err)
+		}
+		if err := tasksv1.RegisterTaskServiceHandlerServer(ctx, mux, taskServer); err != nil {
+			return nil, fmt.Errorf("cannot register task service handler: %v", err)
+		}
+		if err := tasksv1.RegisterLabelServiceHandlerServer(ctx, mux, labelServer); err != nil {
+			return nil, fmt.Errorf("cannot register label service handler: %v", err)
+		}
+		if err := tasksv1.RegisterUserServiceHandlerServer(ctx, mux, userServer); err != nil {
+			return nil, fmt.Errorf("cannot register user service handler: %v", err)
+		}
+		if err := registerWatchProjects(mux, projectServer); err != nil {
+			return nil, fmt.Errorf("cannot register watch projects handler: %v", err)
+		}
+	case transportcfg.GatewayModeDial:
+		a.conn, err = dial(grpcCfg)
+		if err != nil {
+			return nil, err
+		}
+		if err := tasksv1.RegisterProjectServiceHandler(ctx, mux, a.conn); err != nil {
+			return nil, fmt.Errorf("cannot register project service handler: %v", err)
+		}
+		if err := tasksv1.RegisterTaskServiceHandler(ctx, mux, a.conn); err != nil {
+			return nil, fmt.Errorf("cannot register task service handler: %v", err)
+		}
+		if err := tasksv1.RegisterLabelServiceHandler(ctx, mux, a.conn); err != nil {
+			return nil, fmt.Errorf("cannot register label service handler: %v", err)
+		}
+		if err := tasksv1.RegisterUserServiceHandler(ctx, mux, a.conn); err != nil {
+			return nil, fmt.Errorf("cannot register user service handler: %v", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported gateway mode %q", cfg.Mode)
+	}
+
+	spec, err := openAPIDocument()
+	if err != nil {
+		return nil, fmt.Errorf("cannot build OpenAPI document: %v", err)
+	}
+
+	root := http.NewServeMux()
+	root.Handle("/", withStreaming(mux))
+	root.HandleFunc("GET /openapi.json", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(spec)
+	})
+
+	var handler http.Handler = root
+	if cfg.CORS.Enabled {
+		handler = withCORS(handler, &cfg.CORS)
+	}
+
+	a.server = &http.Server{
+		Addr:         net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port)),
+		Handler:      handler,
+		ReadTimeout:  cfg.ReadTimeout,
+		WriteTimeout: cfg.WriteTimeout,
+	}
+
+	return a, nil
+}
+
+// dial connects to the local gRPC server. Wildcard listen addresses are
+// reached through the loopback interface.
+func dial(cfg *transportcfg.GRPC) (*grpc.ClientConn, error) {
+	host := cfg.Host
+	if ip := net.ParseIP(host); host == "" || (ip != nil && ip.IsUnspecified()) {
+		host = "localhost"
+	}
+
+	creds := insecure.NewCredentials()
+	if cfg.TLS.Enabled {
+		tlsCreds, err := credentials.NewClientTLSFromFile(cfg.TLS.CertFile, "")
+		if err != nil {
+			return nil, fmt.Errorf("cannot load TLS credentials: %v", err)
+		}
+		creds = tlsCreds
+	}
+
+	conn, err := grpc.NewClient(
+		net.JoinHostPort(host, strconv.Itoa(cfg.Port)),
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(cfg.MaxSendMsgSize),
+			grpc.MaxCallSendMsgSize(cfg.MaxRecvMsgSize),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot connect to gRPC server: %v", err)
+	}
+	return conn, nil
+}
+
+// Run serves HTTP requests until Stop is called.
+func (a *App) Run() error {
+	listener, err := net.Listen("tcp", a.server.Addr)
+	if err != nil {
+		return fmt.Errorf("cannot listen on %s: %v", a.server.Addr, err)
+	}
+
+	a.logger.Info("HTTP gateway is running", slog.String("address", listener.Addr().String()))
+
+	if err := a.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("cannot serve HTTP: %v", err)
+	}
+	return nil
+}
+
+// Stop waits for in-flight requests to finish until ctx is done.
+func (a *App) Stop(ctx context.Context) error {
+	err := a.server.Shutdown(ctx)
+	if a.conn != nil {
+		if closeErr := a.conn.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("cannot stop HTTP gateway gracefully: %v", err)
+	}
+
+	a.logger.Info("HTTP gateway stopped")
+	return nil
+}
@@ -0,0 +1,115 @@
+package gatewayapp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// handleError writes errors the way Google APIs do over HTTP:
+//
+//	{"error": {"code": 404, "message": "...", "status": "NOT_FOUND", "details": [...]}}
+//
+// where details are the google.rpc error details of the status, each tagged
+// with its "@type". A RetryInfo detail is also sent as the Retry-After header
+// and UNAUTHENTICATED requests are challenged with WWW-Authenticate: Bearer.
+// An ABORTED request that carried an If-Match header is reported as 412
+// Precondition Failed instead of 409 Conflict.
+func handleError(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	stat := status.Convert(err)
+	httpStatus := runtime.HTTPStatusFromCode(stat.Code())
+
+	var customStatus *runtime.HTTPStatusError
+	if errors.As(err, &customStatus) {
+		stat = status.Convert(customStatus.Err)
+		httpStatus = customStatus.HTTPStatus
+	}
+	if r.Header.Get("If-Match") != "" && stat.Code() == codes.Aborted {
+		httpStatus = http.StatusPreconditionFailed
+		w = &statusOverride{ResponseWriter: w, code: httpStatus}
+	}
+
+	if stat.Code() == codes.Unauthenticated {
+		w = &bearerChallenge{ResponseWriter: w}
+	}
+	for _, detail := range stat.Details() {
+		if retry, ok := detail.(*errdetails.RetryInfo); ok && retry.GetRetryDelay() != nil {
+			seconds := math.Ceil(retry.GetRetryDelay().AsDuration().Seconds())
+			w.Header().Set("Retry-After", strconv.Itoa(int(max(seconds, 0))))
+		}
+	}
+
+	runtime.DefaultHTTPErrorHandler(ctx, mux, &errorMarshaler{Marshaler: marshaler, httpStatus: httpStatus}, w, r, err)
+}
+
+// statusOverride replaces the status code written to the wrapped writer.
+type statusOverride struct {
+	http.ResponseWriter
+	code int
+}
+
+func (w *statusOverride) WriteHeader(int) {
+	w.ResponseWriter.WriteHeader(w.code)
+}
+
+// bearerChallenge sets WWW-Authenticate: Bearer on the wrapped writer. The
+// default error handler sets the header to the status message, so it is only
+// set when the header is written.
+type bearerChallenge struct {
+	http.ResponseWriter
+}
+
+func (w *bearerChallenge) WriteHeader(code int) {
+	w.Header().Set("WWW-Authenticate", "Bearer")
+	w.ResponseWriter.WriteHeader(code)
+}
+
+// errorMarshaler wraps the google.rpc.Status the default error handler writes
+// into an error body. Details are marshaled by the wrapped marshaler, so they
+// follow its field naming options.
+type errorMarshaler struct {
+	runtime.Marshaler
+	httpStatus int
+}
+
+type errorBody struct {
+	Error errorStatus `json:"error"`
+}
+
+type errorStatus struct {
+	Code    int               `json:"code"`
+	Message string            `json:"message"`
+	Status  string            `json:"status"`
+	Details []json.RawMessage `json:"details,omitempty"`
+}
+
+func (m *errorMarshaler) Marshal(v any) ([]byte, error) {
+	stat, ok := v.(*spb.Status)
+	if !ok {
+		return m.Marshaler.Marshal(v)
+	}
+
+	body := errorBody{Error: errorStatus{
+		Code:    m.httpStatus,
+		Message: stat.GetMessage(),
+		Status:  code.Code(stat.GetCode()).String(),
+	}}
+	for _, detail := range stat.GetDetails() {
+		data, err := m.Marshaler.Marshal(detail)
+		if err != nil {
+			return nil, err
+		}
+		body.Error.Details = append(body.Error.Details, data)
+	}
+	return json.Marshal(body)
+}
@@ -0,0 +1,97 @@
+package gatewayapp
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	tasksv1 "github.com/10Narratives/ready-to-do/contracts/gen/go/proto/tasks/v1"
+	"github.com/10Narratives/ready-to-do/server/internal/apierror"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorProjectServer fails every GetProject call with err.
+type errorProjectServer struct {
+	tasksv1.UnimplementedProjectServiceServer
+	err error
+}
+
+func (s *errorProjectServer) GetProject(context.Context, *tasksv1.GetProjectRequest) (*tasksv1.Project, error) {
+	return nil, s.err
+}
+
+func TestHandleError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                string
+		err                 error
+		wantStatus          int
+		wantBody            string
+		wantRetryAfter      string
+		wantWWWAuthenticate string
+	}{
+		{
+			name:       "field violations",
+			err:        apierror.BadRequest("invalid request", apierror.Violation("name", "not a project name")).Err(),
+			wantStatus: http.StatusBadRequest,
+			wantBody: `{"error": {"code": 400, "message": "invalid request: name: not a project name", "status": "INVALID_ARGUMENT", "details": [
+				{"@type": "type.googleapis.com/google.rpc.BadRequest", "fieldViolations": [{"field": "name", "description": "not a project name"}]}
+			]}}`,
+		},
+		{
+			name:       "retry info",
+			err:        apierror.Unavailable(apierror.ReasonShuttingDown, "shutting down", 1500*time.Millisecond).Err(),
+			wantStatus: http.StatusServiceUnavailable,
+			wantBody: `{"error": {"code": 503, "message": "shutting down", "status": "UNAVAILABLE", "details": [
+				{"@type": "type.googleapis.com/google.rpc.ErrorInfo", "reason": "SHUTTING_DOWN", "domain": "tasks.readytogo.com"},
+				{"@type": "type.googleapis.com/google.rpc.RetryInfo", "retryDelay": "1.500s"}
+			]}}`,
+			wantRetryAfter: "2",
+		},
+		{
+			name:       "unauthenticated",
+			err:        apierror.New(codes.Unauthenticated, apierror.ReasonMissingCredentials, nil, "missing bearer token").Err(),
+			wantStatus: http.StatusUnauthorized,
+			wantBody: `{"error": {"code": 401, "message": "missing bearer token", "status": "UNAUTHENTICATED", "details": [
+				{"@type": "type.googleapis.com/google.rpc.ErrorInfo", "reason": "MISSING_CREDENTIALS", "domain": "tasks.readytogo.com"}
+			]}}`,
+			wantWWWAuthenticate: "Bearer",
+		},
+		{
+			name:       "plain status",
+			err:        status.Error(codes.Internal, "boom"),
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   `{"error": {"code": 500, "message": "boom", "status": "INTERNAL"}}`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mux := runtime.NewServeMux(
+				runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{}),
+				runtime.WithErrorHandler(handleError),
+			)
+			require.NoError(t, tasksv1.RegisterProjectServiceHandlerServer(context.Background(), mux, &errorProjectServer{err: tt.err}))
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/projects/a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11", nil)
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.Equal(t, tt.wantRetryAfter, rec.Header().Get("Retry-After"))
+			assert.Equal(t, tt.wantWWWAuthenticate, rec.Header().Get("WWW-Authenticate"))
+			require.True(t, json.Valid(rec.Body.Bytes()), rec.Body.String())
+			assert.JSONEq(t, tt.wantBody, rec.Body.String())
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package gatewayapp
+
+import (
+	"context"
+	"net/http"
+
+	projectapi "github.com/10Narratives/ready-to-do/server/internal/transport/grpc/tasks/project"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/proto"
+)
+
+// etagHeaderMatcher forwards the If-Match header under the metadata key the
+// gRPC handlers read etags from. Other headers are forwarded as usual.
+func etagHeaderMatcher(key string) (string, bool) {
+	if http.CanonicalHeaderKey(key) == "If-Match" {
+		return projectapi.IfMatchMetadataKey, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// setETag sends the etag of a returned resource as the ETag header.
+func setETag(_ context.Context, w http.ResponseWriter, msg proto.Message) error {
+	if resource, ok := msg.(interface{ GetEtag() string }); ok && resource.GetEtag() != "" {
+		w.Header().Set("ETag", resource.GetEtag())
+	}
+	return nil
+}
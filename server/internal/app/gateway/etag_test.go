@@ -0,0 +1,83 @@
+package gatewayapp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	tasksv1 "github.com/10Narratives/ready-to-do/contracts/gen/go/proto/tasks/v1"
+	projectapi "github.com/10Narratives/ready-to-do/server/internal/transport/grpc/tasks/project"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// etagProjectServer deletes the project only if the forwarded If-Match
+// metadata names its current etag.
+type etagProjectServer struct {
+	tasksv1.UnimplementedProjectServiceServer
+	etag string
+}
+
+func (s *etagProjectServer) DeleteProject(ctx context.Context, req *tasksv1.DeleteProjectRequest) (*tasksv1.Project, error) {
+	if values := metadata.ValueFromIncomingContext(ctx, projectapi.IfMatchMetadataKey); len(values) > 0 && values[0] != s.etag {
+		return nil, status.Error(codes.Aborted, "etag mismatch")
+	}
+	return &tasksv1.Project{Name: req.GetName(), State: tasksv1.Project_DELETED, Etag: s.etag}, nil
+}
+
+func TestETag(t *testing.T) {
+	t.Parallel()
+
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(etagHeaderMatcher),
+		runtime.WithForwardResponseOption(setETag),
+		runtime.WithErrorHandler(handleError),
+	)
+	err := tasksv1.RegisterProjectServiceHandlerServer(context.Background(), mux, &etagProjectServer{etag: `"2"`})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		ifMatch    string
+		wantStatus int
+		wantETag   string
+	}{
+		{
+			name:       "without If-Match",
+			wantStatus: http.StatusOK,
+			wantETag:   `"2"`,
+		},
+		{
+			name:       "matching If-Match",
+			ifMatch:    `"2"`,
+			wantStatus: http.StatusOK,
+			wantETag:   `"2"`,
+		},
+		{
+			name:       "stale If-Match",
+			ifMatch:    `"1"`,
+			wantStatus: http.StatusPreconditionFailed,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodDelete, "/v1/projects/a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11", nil)
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.Equal(t, tt.wantETag, rec.Header().Get("ETag"))
+		})
+	}
+}
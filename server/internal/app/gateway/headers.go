@@ -0,0 +1,29 @@
+package gatewayapp
+
+import (
+	"net/http"
+
+	"github.com/10Narratives/ready-to-do/server/internal/transport/grpc/interceptors"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+)
+
+// requestIDHeader carries request IDs over HTTP.
+const requestIDHeader = "X-Request-Id"
+
+// incomingHeaderMatcher forwards the X-Request-Id header under the metadata
+// key the gRPC server reads request IDs from.
+func incomingHeaderMatcher(key string) (string, bool) {
+	if http.CanonicalHeaderKey(key) == requestIDHeader {
+		return interceptors.RequestIDMetadataKey, true
+	}
+	return etagHeaderMatcher(key)
+}
+
+// outgoingHeaderMatcher returns the request ID of a call as the X-Request-Id
+// header. Other metadata is returned with the usual Grpc-Metadata- prefix.
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if key == interceptors.RequestIDMetadataKey {
+		return requestIDHeader, true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
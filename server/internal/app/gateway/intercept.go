@@ -0,0 +1,189 @@
+package gatewayapp
+
+import (
+	"context"
+	"io"
+
+	tasksv1 "github.com/10Narratives/ready-to-do/contracts/gen/go/proto/tasks/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// The generated in-process handlers call the servers directly, bypassing the
+// interceptors of the gRPC server. The servers below put every call through
+// them again, so that both transports share request IDs, logging, deadlines
+// and validation.
+
+// intercept runs call through interceptor as the unary method named method.
+func intercept[Req, Resp any](ctx context.Context, interceptor grpc.UnaryServerInterceptor, server any, method string, req Req, call func(context.Context, Req) (Resp, error)) (Resp, error) {
+	info := &grpc.UnaryServerInfo{Server: server, FullMethod: method}
+	resp, err := interceptor(ctx, req, info, func(ctx context.Context, req any) (any, error) {
+		return call(ctx, req.(Req))
+	})
+	out, _ := resp.(Resp)
+	return out, err
+}
+
+// interceptedProjectServer intercepts the calls of a project service server.
+type interceptedProjectServer struct {
+	tasksv1.UnimplementedProjectServiceServer
+	next   tasksv1.ProjectServiceServer
+	unary  grpc.UnaryServerInterceptor
+	stream grpc.StreamServerInterceptor
+}
+
+func (s *interceptedProjectServer) ListProjects(ctx context.Context, req *tasksv1.ListProjectsRequest) (*tasksv1.ListProjectsResponse, error) {
+	return intercept(ctx, s.unary, s.next, tasksv1.ProjectService_ListProjects_FullMethodName, req, s.next.ListProjects)
+}
+
+func (s *interceptedProjectServer) GetProject(ctx context.Context, req *tasksv1.GetProjectRequest) (*tasksv1.Project, error) {
+	return intercept(ctx, s.unary, s.next, tasksv1.ProjectService_GetProject_FullMethodName, req, s.next.GetProject)
+}
+
+func (s *interceptedProjectServer) CreateProject(ctx context.Context, req *tasksv1.CreateProjectRequest) (*tasksv1.Project, error) {
+	return intercept(ctx, s.unary, s.next, tasksv1.ProjectService_CreateProject_FullMethodName, req, s.next.CreateProject)
+}
+
+func (s *interceptedProjectServer) UpdateProject(ctx context.Context, req *tasksv1.UpdateProjectRequest) (*tasksv1.Project, error) {
+	return intercept(ctx, s.unary, s.next, tasksv1.ProjectService_UpdateProject_FullMethodName, req, s.next.UpdateProject)
+}
+
+func (s *interceptedProjectServer) DeleteProject(ctx context.Context, req *tasksv1.DeleteProjectRequest) (*tasksv1.Project, error) {
+	return intercept(ctx, s.unary, s.next, tasksv1.ProjectService_DeleteProject_FullMethodName, req, s.next.DeleteProject)
+}
+
+func (s *interceptedProjectServer) UndeleteProject(ctx context.Context, req *tasksv1.UndeleteProjectRequest) (*tasksv1.Project, error) {
+	return intercept(ctx, s.unary, s.next, tasksv1.ProjectService_UndeleteProject_FullMethodName, req, s.next.UndeleteProject)
+}
+
+func (s *interceptedProjectServer) ArchiveProject(ctx context.Context, req *tasksv1.ArchiveProjectRequest) (*tasksv1.Project, error) {
+	return intercept(ctx, s.unary, s.next, tasksv1.ProjectService_ArchiveProject_FullMethodName, req, s.next.ArchiveProject)
+}
+
+func (s *interceptedProjectServer) UnarchiveProject(ctx context.Context, req *tasksv1.UnarchiveProjectRequest) (*tasksv1.Project, error) {
+	return intercept(ctx, s.unary, s.next, tasksv1.ProjectService_UnarchiveProject_FullMethodName, req, s.next.UnarchiveProject)
+}
+
+func (s *interceptedProjectServer) MoveProject(ctx context.Context, req *tasksv1.MoveProjectRequest) (*tasksv1.Project, error) {
+	return intercept(ctx, s.unary, s.next, tasksv1.ProjectService_MoveProject_FullMethodName, req, s.next.MoveProject)
+}
+
+func (s *interceptedProjectServer) BatchCreateProjects(ctx context.Context, req *tasksv1.BatchCreateProjectsRequest) (*tasksv1.BatchCreateProjectsResponse, error) {
+	return intercept(ctx, s.unary, s.next, tasksv1.ProjectService_BatchCreateProjects_FullMethodName, req, s.next.BatchCreateProjects)
+}
+
+func (s *interceptedProjectServer) BatchGetProjects(ctx context.Context, req *tasksv1.BatchGetProjectsRequest) (*tasksv1.BatchGetProjectsResponse, error) {
+	return intercept(ctx, s.unary, s.next, tasksv1.ProjectService_BatchGetProjects_FullMethodName, req, s.next.BatchGetProjects)
+}
+
+func (s *interceptedProjectServer) BatchDeleteProjects(ctx context.Context, req *tasksv1.BatchDeleteProjectsRequest) (*tasksv1.BatchDeleteProjectsResponse, error) {
+	return intercept(ctx, s.unary, s.next, tasksv1.ProjectService_BatchDeleteProjects_FullMethodName, req, s.next.BatchDeleteProjects)
+}
+
+// WatchProjects runs the stream the way the gRPC server would: the handler
+// receives the request from the stream, so that stream interceptors see it.
+func (s *interceptedProjectServer) WatchProjects(req *tasksv1.WatchProjectsRequest, stream tasksv1.ProjectService_WatchProjectsServer) error {
+	info := &grpc.StreamServerInfo{FullMethod: tasksv1.ProjectService_WatchProjects_FullMethodName, IsServerStream: true}
+	return s.stream(s.next, &requestStream{ServerStream: stream, req: req}, info, func(srv any, stream grpc.ServerStream) error {
+		req := new(tasksv1.WatchProjectsRequest)
+		if err := stream.RecvMsg(req); err != nil {
+			return err
+		}
+		return srv.(tasksv1.ProjectServiceServer).WatchProjects(req, &grpc.GenericServerStream[tasksv1.WatchProjectsRequest, tasksv1.WatchProjectsResponse]{ServerStream: stream})
+	})
+}
+
+// requestStream receives the already decoded request of a server-streaming
+// call.
+type requestStream struct {
+	grpc.ServerStream
+	req      proto.Message
+	received bool
+}
+
+func (s *requestStream) RecvMsg(m any) error {
+	if s.received {
+		return io.EOF
+	}
+	s.received = true
+	proto.Merge(m.(proto.Message), s.req)
+	return nil
+}
+
+// interceptedTaskServer intercepts the calls of a task service server.
+type interceptedTaskServer struct {
+	tasksv1.UnimplementedTaskServiceServer
+	next  tasksv1.TaskServiceServer
+	unary grpc.UnaryServerInterceptor
+}
+
+func (s *interceptedTaskServer) ListTasks(ctx context.Context, req *tasksv1.ListTasksRequest) (*tasksv1.ListTasksResponse, error) {
+	return intercept(ctx, s.unary, s.next, tasksv1.TaskService_ListTasks_FullMethodName, req, s.next.ListTasks)
+}
+
+func (s *interceptedTaskServer) GetTask(ctx context.Context, req *tasksv1.GetTaskRequest) (*tasksv1.Task, error) {
+	return intercept(ctx, s.unary, s.next, tasksv1.TaskService_GetTask_FullMethodName, req, s.next.GetTask)
+}
+
+func (s *interceptedTaskServer) CreateTask(ctx context.Context, req *tasksv1.CreateTaskRequest) (*tasksv1.Task, error) {
+	return intercept(ctx, s.unary, s.next, tasksv1.TaskService_CreateTask_FullMethodName, req, s.next.CreateTask)
+}
+
+func (s *interceptedTaskServer) UpdateTask(ctx context.Context, req *tasksv1.UpdateTaskRequest) (*tasksv1.Task, error) {
+	return intercept(ctx, s.unary, s.next, tasksv1.TaskService_UpdateTask_FullMethodName, req, s.next.UpdateTask)
+}
+
+func (s *interceptedTaskServer) DeleteTask(ctx context.Context, req *tasksv1.DeleteTaskRequest) (*emptypb.Empty, error) {
+	return intercept(ctx, s.unary, s.next, tasksv1.TaskService_DeleteTask_FullMethodName, req, s.next.DeleteTask)
+}
+
+func (s *interceptedTaskServer) CompleteTask(ctx context.Context, req *tasksv1.CompleteTaskRequest) (*tasksv1.Task, error) {
+	return intercept(ctx, s.unary, s.next, tasksv1.TaskService_CompleteTask_FullMethodName, req, s.next.CompleteTask)
+}
+
+func (s *interceptedTaskServer) ReopenTask(ctx context.Context, req *tasksv1.ReopenTaskRequest) (*tasksv1.Task, error) {
+	return intercept(ctx, s.unary, s.next, tasksv1.TaskService_ReopenTask_FullMethodName, req, s.next.ReopenTask)
+}
+
+func (s *interceptedTaskServer) MoveTask(ctx context.Context, req *tasksv1.MoveTaskRequest) (*tasksv1.Task, error) {
+	return intercept(ctx, s.unary, s.next, tasksv1.TaskService_MoveTask_FullMethodName, req, s.next.MoveTask)
+}
+
+// interceptedLabelServer intercepts the calls of a label service server.
+type interceptedLabelServer struct {
+	tasksv1.UnimplementedLabelServiceServer
+	next  tasksv1.LabelServiceServer
+	unary grpc.UnaryServerInterceptor
+}
+
+func (s *interceptedLabelServer) ListLabels(ctx context.Context, req *tasksv1.ListLabelsRequest) (*tasksv1.ListLabelsResponse, error) {
+	return intercept(ctx, s.unary, s.next, tasksv1.LabelService_ListLabels_FullMethodName, req, s.next.ListLabels)
+}
+
+func (s *interceptedLabelServer) GetLabel(ctx context.Context, req *tasksv1.GetLabelRequest) (*tasksv1.Label, error) {
+	return intercept(ctx, s.unary, s.next, tasksv1.LabelService_GetLabel_FullMethodName, req, s.next.GetLabel)
+}
+
+func (s *interceptedLabelServer) CreateLabel(ctx context.Context, req *tasksv1.CreateLabelRequest) (*tasksv1.Label, error) {
+	return intercept(ctx, s.unary, s.next, tasksv1.LabelService_CreateLabel_FullMethodName, req, s.next.CreateLabel)
+}
+
+func (s *interceptedLabelServer) UpdateLabel(ctx context.Context, req *tasksv1.UpdateLabelRequest) (*tasksv1.Label, error) {
+	return intercept(ctx, s.unary, s.next, tasksv1.LabelService_UpdateLabel_FullMethodName, req, s.next.UpdateLabel)
+}
+
+func (s *interceptedLabelServer) DeleteLabel(ctx context.Context, req *tasksv1.DeleteLabelRequest) (*emptypb.Empty, error) {
+	return intercept(ctx, s.unary, s.next, tasksv1.LabelService_DeleteLabel_FullMethodName, req, s.next.DeleteLabel)
+}
+
+// interceptedUserServer intercepts the calls of a user service server.
+type interceptedUserServer struct {
+	tasksv1.UnimplementedUserServiceServer
+	next  tasksv1.UserServiceServer
+	unary grpc.UnaryServerInterceptor
+}
+
+func (s *interceptedUserServer) GetUser(ctx context.Context, req *tasksv1.GetUserRequest) (*tasksv1.User, error) {
+	return intercept(ctx, s.unary, s.next, tasksv1.UserService_GetUser_FullMethodName, req, s.next.GetUser)
+}
@@ -0,0 +1,110 @@
+package gatewayapp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	tasksv1 "github.com/10Narratives/ready-to-do/contracts/gen/go/proto/tasks/v1"
+	"github.com/10Narratives/ready-to-do/server/internal/transport/grpc/interceptors"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// listProjectServer counts the ListProjects calls that reach it.
+type listProjectServer struct {
+	tasksv1.UnimplementedProjectServiceServer
+	calls atomic.Int32
+}
+
+func (s *listProjectServer) ListProjects(context.Context, *tasksv1.ListProjectsRequest) (*tasksv1.ListProjectsResponse, error) {
+	s.calls.Add(1)
+	return &tasksv1.ListProjectsResponse{}, nil
+}
+
+func TestInterceptedProjectServer(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		url           string
+		requestID     string
+		wantStatus    int
+		wantCalls     int32
+		wantRequestID string
+	}{
+		{
+			name:          "request id is forwarded and returned",
+			url:           "/v1/projects",
+			requestID:     "req-42",
+			wantStatus:    http.StatusOK,
+			wantCalls:     1,
+			wantRequestID: "req-42",
+		},
+		{
+			name:          "invalid request is rejected by interceptor",
+			url:           "/v1/projects?page_size=-1",
+			requestID:     "req-42",
+			wantStatus:    http.StatusBadRequest,
+			wantRequestID: "req-42",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mux := runtime.NewServeMux(
+				runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+				runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+				runtime.WithErrorHandler(handleError),
+			)
+			server := &listProjectServer{}
+			intercepted := &interceptedProjectServer{
+				next:  server,
+				unary: interceptors.ChainUnary(interceptors.UnaryRequestID(), interceptors.UnaryValidate()),
+			}
+			require.NoError(t, tasksv1.RegisterProjectServiceHandlerServer(context.Background(), mux, intercepted))
+
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			req.Header.Set("X-Request-Id", tt.requestID)
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.Equal(t, tt.wantCalls, server.calls.Load())
+			assert.Equal(t, tt.wantRequestID, rec.Header().Get("X-Request-Id"))
+		})
+	}
+}
+
+func TestInterceptedProjectServer_WatchProjects(t *testing.T) {
+	t.Parallel()
+
+	var methods []string
+	mux := runtime.NewServeMux()
+	server := &interceptedProjectServer{
+		next: &watchProjectServer{},
+		stream: interceptors.ChainStream(
+			func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+				methods = append(methods, info.FullMethod)
+				return handler(srv, stream)
+			},
+			interceptors.StreamValidate(),
+		),
+	}
+	require.NoError(t, registerWatchProjects(mux, server))
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/projects:watch?change_token="+strings.Repeat("a", 257), nil)
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+
+	assert.Equal(t, []string{tasksv1.ProjectService_WatchProjects_FullMethodName}, methods)
+	assert.Contains(t, rec.Body.String(), `"error"`)
+	assert.NotContains(t, rec.Body.String(), `"result"`)
+}
@@ -0,0 +1,56 @@
+package gatewayapp
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"maps"
+	"strings"
+
+	"github.com/10Narratives/ready-to-do/contracts"
+)
+
+// openAPIDocument merges the per-file OpenAPI documents generated for the
+// contracts into a single document.
+func openAPIDocument() ([]byte, error) {
+	merged := map[string]any{
+		"swagger":     "2.0",
+		"info":        map[string]any{"title": "Ready-to-Do API", "version": "v1"},
+		"consumes":    []string{"application/json"},
+		"produces":    []string{"application/json"},
+		"tags":        []any{},
+		"paths":       map[string]any{},
+		"definitions": map[string]any{},
+	}
+
+	err := fs.WalkDir(contracts.OpenAPI, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(path, ".swagger.json") {
+			return err
+		}
+
+		raw, err := fs.ReadFile(contracts.OpenAPI, path)
+		if err != nil {
+			return err
+		}
+
+		var doc map[string]any
+		if err := json.Unmarshal(raw, &doc); err != nil {
+			return fmt.Errorf("cannot parse %s: %v", path, err)
+		}
+
+		if tags, ok := doc["tags"].([]any); ok {
+			merged["tags"] = append(merged["tags"].([]any), tags...)
+		}
+		for _, section := range []string{"paths", "definitions"} {
+			if entries, ok := doc[section].(map[string]any); ok {
+				maps.Copy(merged[section].(map[string]any), entries)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(merged)
+}
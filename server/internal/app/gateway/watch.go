@@ -0,0 +1,112 @@
+package gatewayapp
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	tasksv1 "github.com/10Narratives/ready-to-do/contracts/gen/go/proto/tasks/v1"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// watchPath is the HTTP route of WatchProjects.
+const watchPath = "/v1/projects:watch"
+
+// registerWatchProjects serves WatchProjects in the in-process mode, whose
+// generated handlers reject server-streaming methods. Responses are written
+// like in the dial mode: one JSON object per line, wrapping the message in
+// "result" or, if the stream fails, the status in "error".
+func registerWatchProjects(mux *runtime.ServeMux, server tasksv1.ProjectServiceServer) error {
+	return mux.HandlePath(http.MethodGet, watchPath, func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+
+		_, marshaler := runtime.MarshalerForRequest(mux, r)
+		ctx, err := runtime.AnnotateIncomingContext(ctx, mux, r, tasksv1.ProjectService_WatchProjects_FullMethodName, runtime.WithHTTPPathPattern(watchPath))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, marshaler, w, r, err)
+			return
+		}
+
+		var req tasksv1.WatchProjectsRequest
+		if err := r.ParseForm(); err != nil {
+			runtime.HTTPError(ctx, mux, marshaler, w, r, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+		if err := runtime.PopulateQueryParameters(&req, r.Form, utilities.NewDoubleArray(nil)); err != nil {
+			runtime.HTTPError(ctx, mux, marshaler, w, r, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+
+		stream := &watchStream{ctx: ctx, responses: make(chan *tasksv1.WatchProjectsResponse)}
+		done := make(chan error, 1)
+		go func() {
+			done <- server.WatchProjects(&req, stream)
+		}()
+
+		// Send blocks until its response is received, so every response is
+		// forwarded before the result of the call.
+		recv := func() (proto.Message, error) {
+			select {
+			case resp := <-stream.responses:
+				return resp, nil
+			case err := <-done:
+				if err == nil {
+					return nil, io.EOF
+				}
+				return nil, err
+			}
+		}
+
+		ctx = runtime.NewServerMetadataContext(ctx, runtime.ServerMetadata{})
+		runtime.ForwardResponseStream(ctx, mux, marshaler, w, r, recv, mux.GetForwardResponseOptions()...)
+	})
+}
+
+// watchStream passes the responses of an in-process WatchProjects call to the
+// HTTP handler.
+type watchStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses chan *tasksv1.WatchProjectsResponse
+}
+
+func (s *watchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *watchStream) Send(resp *tasksv1.WatchProjectsResponse) error {
+	select {
+	case s.responses <- resp:
+		return nil
+	case <-s.ctx.Done():
+		return status.FromContextError(s.ctx.Err()).Err()
+	}
+}
+
+// SendMsg is called by the stream handed to the server by the interceptors.
+func (s *watchStream) SendMsg(m any) error {
+	return s.Send(m.(*tasksv1.WatchProjectsResponse))
+}
+
+func (s *watchStream) SetHeader(metadata.MD) error  { return nil }
+func (s *watchStream) SendHeader(metadata.MD) error { return nil }
+func (s *watchStream) SetTrailer(metadata.MD)       {}
+
+// withStreaming lifts the write timeout of the server for streaming routes,
+// whose responses are meant to stay open. Heartbeats keep them from idling.
+func withStreaming(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == watchPath {
+			_ = http.NewResponseController(w).SetWriteDeadline(time.Time{})
+		}
+		next.ServeHTTP(w, r)
+	})
+}
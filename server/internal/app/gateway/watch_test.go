@@ -0,0 +1,95 @@
+package gatewayapp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	tasksv1 "github.com/10Narratives/ready-to-do/contracts/gen/go/proto/tasks/v1"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// watchProjectServer sends a heartbeat and a change after the requested token
+// and then fails with err.
+type watchProjectServer struct {
+	tasksv1.UnimplementedProjectServiceServer
+	err error
+}
+
+func (s *watchProjectServer) WatchProjects(req *tasksv1.WatchProjectsRequest, stream grpc.ServerStreamingServer[tasksv1.WatchProjectsResponse]) error {
+	responses := []*tasksv1.WatchProjectsResponse{
+		{ChangeType: tasksv1.WatchProjectsResponse_HEARTBEAT, ChangeToken: req.GetChangeToken()},
+		{ChangeType: tasksv1.WatchProjectsResponse_CREATED, Name: "projects/a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11", ChangeToken: "next"},
+	}
+	for _, resp := range responses {
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+	return s.err
+}
+
+func TestWatchProjects(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		err       error
+		wantLines []string
+	}{
+		{
+			name:      "stream ends",
+			wantLines: []string{"result", "result"},
+		},
+		{
+			name:      "stream fails",
+			err:       status.Error(codes.FailedPrecondition, "change token expired"),
+			wantLines: []string{"result", "result", "error"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mux := runtime.NewServeMux()
+			server := &watchProjectServer{err: tt.err}
+			require.NoError(t, tasksv1.RegisterProjectServiceHandlerServer(context.Background(), mux, server))
+			require.NoError(t, registerWatchProjects(mux, server))
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/projects:watch?change_token=token", nil)
+			rec := httptest.NewRecorder()
+			withStreaming(mux).ServeHTTP(rec, req)
+
+			require.Equal(t, http.StatusOK, rec.Code)
+
+			var (
+				lines  []string
+				tokens []string
+			)
+			scanner := bufio.NewScanner(rec.Body)
+			for scanner.Scan() {
+				var line map[string]struct {
+					ChangeToken string `json:"changeToken"`
+				}
+				require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+				for key, value := range line {
+					lines = append(lines, key)
+					if key == "result" {
+						tokens = append(tokens, value.ChangeToken)
+					}
+				}
+			}
+			assert.Equal(t, tt.wantLines, lines)
+			assert.Equal(t, []string{"token", "next"}, tokens)
+		})
+	}
+}
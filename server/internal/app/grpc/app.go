@@ -2,20 +2,162 @@ package grpcapp
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"strconv"
 
+	"github.com/10Narratives/ready-to-do/common/pkg/logging/sl"
 	transportcfg "github.com/10Narratives/ready-to-do/server/internal/config/transport"
+	"github.com/10Narratives/ready-to-do/server/internal/transport/grpc/interceptors"
+	labelapi "github.com/10Narratives/ready-to-do/server/internal/transport/grpc/tasks/label"
+	projectapi "github.com/10Narratives/ready-to-do/server/internal/transport/grpc/tasks/project"
+	taskapi "github.com/10Narratives/ready-to-do/server/internal/transport/grpc/tasks/task"
+	userapi "github.com/10Narratives/ready-to-do/server/internal/transport/grpc/tasks/user"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 )
 
-type App struct{}
+type App struct {
+	server  *grpc.Server
+	health  *health.Server
+	address string
+	logger  *slog.Logger
+	unary   grpc.UnaryServerInterceptor
+	stream  grpc.StreamServerInterceptor
+}
+
+// New builds the gRPC server. Calls are authenticated by authenticator unless
+// it is nil.
+func New(cfg *transportcfg.GRPC, authenticator interceptors.Authenticator, projectService projectapi.ProjectService, taskService taskapi.TaskService, labelService labelapi.LabelService, userService userapi.UserService) (*App, error) {
+	logger, err := sl.New(
+		sl.WithLevel(cfg.Logging.Level),
+		sl.WithFormat(cfg.Logging.Format),
+		sl.WithOutput(cfg.Logging.Output),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot initialize gRPC logger: %v", err)
+	}
+
+	unary, stream := chain(cfg, authenticator, logger)
+	opts := []grpc.ServerOption{
+		grpc.MaxRecvMsgSize(cfg.MaxRecvMsgSize),
+		grpc.MaxSendMsgSize(cfg.MaxSendMsgSize),
+		grpc.UnaryInterceptor(unary),
+		grpc.StreamInterceptor(stream),
+	}
+	if cfg.TLS.Enabled {
+		creds, err := credentials.NewServerTLSFromFile(cfg.TLS.CertFile, cfg.TLS.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("cannot load TLS credentials: %v", err)
+		}
+		opts = append(opts, grpc.Creds(creds))
+	}
+
+	server := grpc.NewServer(opts...)
+	projectapi.Register(server, projectService)
+	taskapi.Register(server, taskService)
+	labelapi.Register(server, labelService)
+	userapi.Register(server, userService)
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
+	if cfg.Reflection {
+		reflection.Register(server)
+	}
+
+	return &App{
+		server:  server,
+		health:  healthServer,
+		address: net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port)),
+		logger:  logger,
+		unary:   unary,
+		stream:  stream,
+	}, nil
+}
+
+// chain assembles the interceptors enabled in cfg. Request IDs come first so
+// that every later interceptor can log them, and recovery sits inside the
+// access log so that panics are logged as Internal calls. Authentication
+// precedes validation, so that anonymous callers learn nothing about requests.
+func chain(cfg *transportcfg.GRPC, authenticator interceptors.Authenticator, logger *slog.Logger) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	var (
+		unary  []grpc.UnaryServerInterceptor
+		stream []grpc.StreamServerInterceptor
+	)
+	if cfg.Interceptors.RequestID {
+		unary = append(unary, interceptors.UnaryRequestID())
+		stream = append(stream, interceptors.StreamRequestID())
+	}
+	if cfg.Interceptors.AccessLog {
+		unary = append(unary, interceptors.UnaryAccessLog(logger))
+		stream = append(stream, interceptors.StreamAccessLog(logger))
+	}
+	if cfg.Interceptors.Recovery {
+		unary = append(unary, interceptors.UnaryRecovery(logger))
+		stream = append(stream, interceptors.StreamRecovery(logger))
+	}
+	if authenticator != nil {
+		unary = append(unary, interceptors.UnaryAuth(authenticator, cfg.PublicMethods))
+		stream = append(stream, interceptors.StreamAuth(authenticator, cfg.PublicMethods))
+	}
+	if cfg.Interceptors.Deadline {
+		unary = append(unary, interceptors.UnaryDeadline(cfg.Timeout))
+	}
+	if cfg.Interceptors.Validate {
+		unary = append(unary, interceptors.UnaryValidate())
+		stream = append(stream, interceptors.StreamValidate())
+	}
+	return interceptors.ChainUnary(unary...), interceptors.ChainStream(stream...)
+}
 
-func New(cfg *transportcfg.GRPC) (*App, error) {
-	return &App{}, nil
+// UnaryInterceptor returns the interceptor chain of unary calls, for callers
+// that bypass the gRPC server such as the in-process gateway.
+func (a *App) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return a.unary
 }
 
+// StreamInterceptor returns the interceptor chain of streaming calls.
+func (a *App) StreamInterceptor() grpc.StreamServerInterceptor {
+	return a.stream
+}
+
+// Run listens on the configured address and serves until Stop is called.
 func (a *App) Run() error {
+	listener, err := net.Listen("tcp", a.address)
+	if err != nil {
+		return fmt.Errorf("cannot listen on %s: %v", a.address, err)
+	}
+
+	a.logger.Info("gRPC server is running", slog.String("address", listener.Addr().String()))
+
+	if err := a.server.Serve(listener); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+		return fmt.Errorf("cannot serve gRPC: %v", err)
+	}
 	return nil
 }
 
+// Stop reports the server as not serving to health checks and waits for
+// in-flight RPCs to finish. Once ctx is done, the remaining RPCs are cancelled
+// and the server is stopped immediately.
 func (a *App) Stop(ctx context.Context) error {
-	return nil
+	a.health.Shutdown()
+
+	stopped := make(chan struct{})
+	go func() {
+		a.server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		a.logger.Info("gRPC server stopped")
+		return nil
+	case <-ctx.Done():
+		a.server.Stop()
+		return fmt.Errorf("cannot stop gRPC server gracefully: %v", ctx.Err())
+	}
 }
@@ -0,0 +1,188 @@
+package notifierapp
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+)
+
+// Reconnect delays after the listening connection fails.
+const (
+	minBackoff = 100 * time.Millisecond
+	maxBackoff = 10 * time.Second
+)
+
+// App listens for PostgreSQL notifications on a channel over one dedicated
+// connection and wakes up every subscriber when one arrives. Payloads are
+// ignored: subscribers are expected to read what changed from the database.
+type App struct {
+	db      *sql.DB
+	channel string
+	logger  *slog.Logger
+
+	mu          sync.Mutex
+	subscribers map[chan struct{}]struct{}
+	stopped     bool
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+func New(db *sql.DB, channel string, logger *slog.Logger) *App {
+	return &App{
+		db:          db,
+		channel:     channel,
+		logger:      logger,
+		subscribers: make(map[chan struct{}]struct{}),
+		stop:        make(chan struct{}),
+		done:        make(chan struct{}),
+	}
+}
+
+// Subscribe returns a channel that receives a value after notifications.
+// Notifications arriving while the previous one is unread are coalesced.
+// Subscribers are also woken after the connection was re-established, since
+// notifications may have been missed in the meantime. The channel is closed
+// when the App is stopped, so that long-lived subscribers can wind down. The
+// returned function ends the subscription.
+func (a *App) Subscribe() (<-chan struct{}, func()) {
+	wake := make(chan struct{}, 1)
+
+	a.mu.Lock()
+	if a.stopped {
+		close(wake)
+	} else {
+		a.subscribers[wake] = struct{}{}
+	}
+	a.mu.Unlock()
+
+	return wake, func() {
+		a.mu.Lock()
+		delete(a.subscribers, wake)
+		a.mu.Unlock()
+	}
+}
+
+// Run listens until Stop is called, reconnecting with exponential backoff
+// whenever the connection fails.
+func (a *App) Run() error {
+	defer close(a.done)
+
+	backoff := minBackoff
+	for {
+		start := time.Now()
+		err := a.listen()
+
+		select {
+		case <-a.stop:
+			return nil
+		default:
+		}
+
+		a.logger.Error("cannot listen for notifications", slog.String("channel", a.channel), slog.String("error", err.Error()))
+		if time.Since(start) > maxBackoff {
+			backoff = minBackoff
+		}
+
+		select {
+		case <-a.stop:
+			return nil
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, maxBackoff)
+	}
+}
+
+// listen subscribes a dedicated connection to the channel and broadcasts its
+// notifications until the connection fails or Stop is called.
+func (a *App) listen() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-a.stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	conn, err := a.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot get connection: %v", err)
+	}
+	defer conn.Close()
+
+	return conn.Raw(func(driverConn any) error {
+		pgConn := driverConn.(*stdlib.Conn).Conn()
+
+		if _, err := pgConn.Exec(ctx, "LISTEN "+pgx.Identifier{a.channel}.Sanitize()); err != nil {
+			return discard(fmt.Errorf("cannot listen: %v", err))
+		}
+		a.broadcast()
+
+		for {
+			if _, err := pgConn.WaitForNotification(ctx); err != nil {
+				return discard(fmt.Errorf("cannot wait for notification: %v", err))
+			}
+			a.broadcast()
+		}
+	})
+}
+
+func (a *App) broadcast() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for wake := range a.subscribers {
+		select {
+		case wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Stop closes the channels of all subscribers, stops listening and waits for
+// the connection to be released.
+func (a *App) Stop(ctx context.Context) error {
+	a.mu.Lock()
+	a.stopped = true
+	for wake := range a.subscribers {
+		close(wake)
+		delete(a.subscribers, wake)
+	}
+	a.mu.Unlock()
+
+	close(a.stop)
+
+	select {
+	case <-a.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// discard marks err as driver.ErrBadConn, so that the pool closes the
+// connection instead of reusing a connection that is still listening.
+func discard(err error) error {
+	return badConnError{err}
+}
+
+type badConnError struct {
+	err error
+}
+
+func (e badConnError) Error() string {
+	return e.err.Error()
+}
+
+func (e badConnError) Unwrap() []error {
+	return []error{e.err, driver.ErrBadConn}
+}
@@ -2,21 +2,189 @@ package pgapp
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
 
+	"github.com/10Narratives/ready-to-do/common/pkg/logging/sl"
 	databasecfg "github.com/10Narratives/ready-to-do/server/internal/config/database"
+	"github.com/10Narratives/ready-to-do/server/internal/migrate"
+	"github.com/10Narratives/ready-to-do/server/migrations"
+	_ "github.com/jackc/pgx/v5/stdlib"
 )
 
 type App struct {
+	db     *sql.DB
+	cfg    *databasecfg.Database
+	logger *slog.Logger
+
+	stop     chan struct{}
+	stopOnce sync.Once
+	done     sync.WaitGroup
 }
 
 func New(cfg *databasecfg.Database) (*App, error) {
-	return &App{}, nil
+	logger, err := sl.New(
+		sl.WithLevel(cfg.Logging.Level),
+		sl.WithFormat(cfg.Logging.Format),
+		sl.WithOutput(cfg.Logging.Output),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot initialize database logger: %v", err)
+	}
+
+	db, err := sql.Open("pgx", DSN(cfg))
+	if err != nil {
+		return nil, fmt.Errorf("cannot open database: %v", err)
+	}
+	db.SetMaxOpenConns(cfg.Pool.MaxOpenConns)
+	db.SetMaxIdleConns(cfg.Pool.MaxIdleConns)
+	db.SetConnMaxLifetime(cfg.Pool.ConnMaxLifetime)
+	db.SetConnMaxIdleTime(cfg.Pool.ConnMaxIdleTime)
+
+	return &App{
+		db:     db,
+		cfg:    cfg,
+		logger: logger,
+		stop:   make(chan struct{}),
+	}, nil
 }
 
+// DSN builds a PostgreSQL connection URL from the database configuration.
+func DSN(cfg *databasecfg.Database) string {
+	query := url.Values{}
+	query.Set("sslmode", cfg.SSLMode)
+	if cfg.Pool.ConnectTimeout > 0 {
+		query.Set("connect_timeout", strconv.Itoa(int(cfg.Pool.ConnectTimeout.Seconds())))
+	}
+
+	dsn := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(cfg.User, cfg.Password),
+		Host:     net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port)),
+		Path:     "/" + cfg.DBName,
+		RawQuery: query.Encode(),
+	}
+	return dsn.String()
+}
+
+// DB returns the connection pool shared by the storages.
+func (a *App) DB() *sql.DB {
+	return a.db
+}
+
+// Run checks that the database is reachable, retrying with exponential
+// backoff, applies pending migrations if configured to, and then starts
+// logging pool statistics in the background.
 func (a *App) Run() error {
+	if err := a.ping(); err != nil {
+		return err
+	}
+
+	if a.cfg.AutoMigrate {
+		migrator, err := a.Migrator()
+		if err != nil {
+			return err
+		}
+		if _, err := migrator.Up(context.Background()); err != nil {
+			return fmt.Errorf("cannot apply migrations: %v", err)
+		}
+	}
+
+	if a.cfg.Pool.StatsInterval > 0 {
+		a.done.Add(1)
+		go a.logStats()
+	}
 	return nil
 }
 
+// Migrator returns a migrator for the embedded schema migrations.
+func (a *App) Migrator() (*migrate.Migrator, error) {
+	return migrate.New(a.db, migrations.FS, a.logger)
+}
+
+func (a *App) ping() error {
+	attempts := max(a.cfg.Ping.Attempts, 1)
+	backoff := a.cfg.Ping.Backoff
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = a.pingOnce()
+		if err == nil {
+			a.logger.Info("connected to database", slog.String("host", a.cfg.Host), slog.String("dbname", a.cfg.DBName))
+			return nil
+		}
+		if attempt == attempts {
+			break
+		}
+
+		a.logger.Warn("cannot ping database, retrying",
+			slog.Int("attempt", attempt),
+			slog.Duration("backoff", backoff),
+			slog.String("error", err.Error()),
+		)
+
+		select {
+		case <-a.stop:
+			return fmt.Errorf("cannot ping database: stopped")
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, a.cfg.Ping.MaxBackoff)
+	}
+
+	return fmt.Errorf("cannot ping database after %d attempts: %v", attempts, err)
+}
+
+func (a *App) pingOnce() error {
+	ctx := context.Background()
+	if a.cfg.Pool.ConnectTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, a.cfg.Pool.ConnectTimeout)
+		defer cancel()
+	}
+	return a.db.PingContext(ctx)
+}
+
+func (a *App) logStats() {
+	defer a.done.Done()
+
+	ticker := time.NewTicker(a.cfg.Pool.StatsInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-a.stop:
+			return
+		case <-ticker.C:
+		}
+
+		stats := a.db.Stats()
+		a.logger.Info("database pool stats",
+			slog.Int("open", stats.OpenConnections),
+			slog.Int("in_use", stats.InUse),
+			slog.Int("idle", stats.Idle),
+			slog.Int64("wait_count", stats.WaitCount),
+			slog.Duration("wait_duration", stats.WaitDuration),
+			slog.Int64("max_idle_closed", stats.MaxIdleClosed),
+			slog.Int64("max_idle_time_closed", stats.MaxIdleTimeClosed),
+			slog.Int64("max_lifetime_closed", stats.MaxLifetimeClosed),
+		)
+	}
+}
+
+// Stop stops logging statistics and closes the pool once in-flight queries
+// have released their connections.
 func (a *App) Stop(ctx context.Context) error {
+	a.stopOnce.Do(func() { close(a.stop) })
+	a.done.Wait()
+
+	if err := a.db.Close(); err != nil {
+		return fmt.Errorf("cannot close database: %v", err)
+	}
 	return nil
 }
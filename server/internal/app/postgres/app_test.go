@@ -0,0 +1,36 @@
+package pgapp_test
+
+import (
+	"testing"
+	"time"
+
+	pgapp "github.com/10Narratives/ready-to-do/server/internal/app/postgres"
+	databasecfg "github.com/10Narratives/ready-to-do/server/internal/config/database"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDSN(t *testing.T) {
+	t.Parallel()
+
+	cfg := &databasecfg.Database{
+		Host:     "db.internal",
+		Port:     6432,
+		User:     "tasks",
+		Password: "p@ss word/:",
+		DBName:   "ready_to_do",
+		SSLMode:  "verify-full",
+		Pool:     databasecfg.Pool{ConnectTimeout: 3 * time.Second},
+	}
+
+	parsed, err := pgx.ParseConfig(pgapp.DSN(cfg))
+	require.NoError(t, err)
+
+	assert.Equal(t, "db.internal", parsed.Host)
+	assert.Equal(t, uint16(6432), parsed.Port)
+	assert.Equal(t, "tasks", parsed.User)
+	assert.Equal(t, "p@ss word/:", parsed.Password)
+	assert.Equal(t, "ready_to_do", parsed.Database)
+	assert.Equal(t, 3*time.Second, parsed.ConnectTimeout)
+}
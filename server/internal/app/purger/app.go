@@ -0,0 +1,78 @@
+package purgerapp
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/status"
+)
+
+// Purger permanently removes resources whose retention window has passed.
+type Purger interface {
+	Purge(ctx context.Context) (int64, *status.Status)
+}
+
+// App runs a Purger periodically in the background.
+type App struct {
+	purger   Purger
+	interval time.Duration
+	logger   *slog.Logger
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+func New(purger Purger, interval time.Duration, logger *slog.Logger) *App {
+	return &App{
+		purger:   purger,
+		interval: interval,
+		logger:   logger,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// Run purges once immediately and then every interval until Stop is called.
+func (a *App) Run() error {
+	defer close(a.done)
+
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+
+	for {
+		a.purge()
+
+		select {
+		case <-a.stop:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (a *App) purge() {
+	ctx, cancel := context.WithTimeout(context.Background(), a.interval)
+	defer cancel()
+
+	purged, stat := a.purger.Purge(ctx)
+	if stat != nil {
+		a.logger.Error("cannot purge deleted resources", slog.String("error", stat.Message()))
+		return
+	}
+	if purged > 0 {
+		a.logger.Info("purged deleted resources", slog.Int64("count", purged))
+	}
+}
+
+// Stop stops the purge loop and waits for a running purge to finish.
+func (a *App) Stop(ctx context.Context) error {
+	close(a.stop)
+
+	select {
+	case <-a.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
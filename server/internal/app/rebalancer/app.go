@@ -0,0 +1,78 @@
+package rebalancerapp
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/status"
+)
+
+// Rebalancer respaces resource positions that have grown too long.
+type Rebalancer interface {
+	Rebalance(ctx context.Context) (int64, *status.Status)
+}
+
+// App runs a Rebalancer periodically in the background.
+type App struct {
+	rebalancer Rebalancer
+	interval   time.Duration
+	logger     *slog.Logger
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+func New(rebalancer Rebalancer, interval time.Duration, logger *slog.Logger) *App {
+	return &App{
+		rebalancer: rebalancer,
+		interval:   interval,
+		logger:     logger,
+		stop:       make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+}
+
+// Run rebalances once immediately and then every interval until Stop is called.
+func (a *App) Run() error {
+	defer close(a.done)
+
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+
+	for {
+		a.rebalance()
+
+		select {
+		case <-a.stop:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (a *App) rebalance() {
+	ctx, cancel := context.WithTimeout(context.Background(), a.interval)
+	defer cancel()
+
+	rebalanced, stat := a.rebalancer.Rebalance(ctx)
+	if stat != nil {
+		a.logger.Error("cannot rebalance positions", slog.String("error", stat.Message()))
+		return
+	}
+	if rebalanced > 0 {
+		a.logger.Info("rebalanced positions", slog.Int64("count", rebalanced))
+	}
+}
+
+// Stop stops the rebalance loop and waits for a running rebalance to finish.
+func (a *App) Stop(ctx context.Context) error {
+	close(a.stop)
+
+	select {
+	case <-a.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
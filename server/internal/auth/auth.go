@@ -0,0 +1,102 @@
+// Package auth authenticates callers by their bearer JWTs.
+//
+// Tokens are signed with HS256 by a shared secret or with RS256 by a key of a
+// JSON Web Key Set read from a file or URL. The caller of a valid token is
+// resolved to a user, which is passed down the call as the Principal of its
+// context.
+package auth
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/10Narratives/ready-to-do/server/internal/apierror"
+	authcfg "github.com/10Narratives/ready-to-do/server/internal/config/auth"
+	usermodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/user"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Principal is the authenticated caller of a call.
+type Principal struct {
+	// User is the resource name of the caller, e.g. "users/{user}".
+	User    string
+	Issuer  string
+	Subject string
+}
+
+type principalKey struct{}
+
+// NewContext returns a copy of ctx carrying principal.
+func NewContext(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// FromContext returns the principal of ctx, if the call was authenticated.
+func FromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	return principal, ok && principal != nil
+}
+
+// Users resolves the claims of verified tokens to users, creating the users of
+// new subjects.
+//
+//go:generate mockery --name Users --output ./mocks/
+type Users interface {
+	Resolve(ctx context.Context, claims *Claims) (*usermodels.User, *status.Status)
+}
+
+type Authenticator struct {
+	verifier *verifier
+	users    Users
+}
+
+// New builds an authenticator from cfg. Key sets are loaded right away, so
+// that misconfigurations are reported at startup.
+func New(ctx context.Context, cfg *authcfg.Auth, users Users) (*Authenticator, error) {
+	keys, err := newKeySet(ctx, &cfg.JWKS)
+	if err != nil {
+		return nil, err
+	}
+
+	var secret []byte
+	if cfg.HS256Secret != "" {
+		secret = []byte(cfg.HS256Secret)
+	}
+	if secret == nil && keys == nil {
+		return nil, errors.New("neither an HS256 secret nor a JWKS is configured")
+	}
+
+	return &Authenticator{
+		verifier: &verifier{
+			secret:   secret,
+			keys:     keys,
+			issuer:   cfg.Issuer,
+			audience: cfg.Audience,
+			leeway:   cfg.Leeway,
+			now:      time.Now,
+		},
+		users: users,
+	}, nil
+}
+
+// Authenticate verifies token and returns ctx with the principal of its user.
+// Invalid tokens fail with Unauthenticated.
+func (a *Authenticator) Authenticate(ctx context.Context, token string) (context.Context, error) {
+	claims, err := a.verifier.verify(ctx, token)
+	if err != nil {
+		return nil, apierror.New(codes.Unauthenticated, apierror.ReasonInvalidCredentials, nil, "invalid bearer token: "+err.Error()).Err()
+	}
+
+	user, stat := a.users.Resolve(ctx, claims)
+	if stat != nil {
+		return nil, stat.Err()
+	}
+
+	return NewContext(ctx, &Principal{
+		User:    user.Name,
+		Issuer:  user.Issuer,
+		Subject: user.Subject,
+	}), nil
+}
@@ -0,0 +1,450 @@
+package auth_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/10Narratives/ready-to-do/server/internal/auth"
+	"github.com/10Narratives/ready-to-do/server/internal/auth/mocks"
+	authcfg "github.com/10Narratives/ready-to-do/server/internal/config/auth"
+	usermodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	secret   = "top-secret"
+	issuer   = "https://issuer.example.com"
+	audience = "ready-to-do"
+	userName = "users/a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"
+)
+
+// rsaKeys generates the RSA keys of the tests once; generating them is slow.
+var rsaKeys = sync.OnceValue(func() []*rsa.PrivateKey {
+	keys := make([]*rsa.PrivateKey, 2)
+	for i := range keys {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			panic(err)
+		}
+		keys[i] = key
+	}
+	return keys
+})
+
+// expiresAt is the expiry of valid tokens, fixed so that expected claims
+// match the signed ones.
+var expiresAt = time.Now().Add(time.Hour).Unix()
+
+type claims map[string]any
+
+// validClaims returns claims that pass every check of the test config.
+func validClaims() claims {
+	return claims{
+		"iss":   issuer,
+		"sub":   "alice",
+		"aud":   []string{"other", audience},
+		"exp":   expiresAt,
+		"email": "alice@example.com",
+		"name":  "Alice",
+	}
+}
+
+func (c claims) with(key string, value any) claims {
+	c[key] = value
+	return c
+}
+
+func (c claims) without(key string) claims {
+	delete(c, key)
+	return c
+}
+
+func segment(t *testing.T, v any) string {
+	t.Helper()
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func signHS256(t *testing.T, key string, c claims) string {
+	t.Helper()
+	signed := segment(t, map[string]string{"alg": "HS256", "typ": "JWT"}) + "." + segment(t, c)
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(signed))
+	return signed + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func signRS256(t *testing.T, key *rsa.PrivateKey, kid string, c claims) string {
+	t.Helper()
+	signed := segment(t, map[string]string{"alg": "RS256", "typ": "JWT", "kid": kid}) + "." + segment(t, c)
+	digest := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func unsigned(t *testing.T, c claims) string {
+	t.Helper()
+	return segment(t, map[string]string{"alg": "none"}) + "." + segment(t, c) + "."
+}
+
+// jwks returns a key set with the public keys of keys under the given IDs.
+func jwks(t *testing.T, keys map[string]*rsa.PrivateKey) []byte {
+	t.Helper()
+	set := struct {
+		Keys []map[string]string `json:"keys"`
+	}{}
+	for kid, key := range keys {
+		set.Keys = append(set.Keys, map[string]string{
+			"kty": "RSA",
+			"kid": kid,
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		})
+	}
+	data, err := json.Marshal(set)
+	require.NoError(t, err)
+	return data
+}
+
+func jwksFile(t *testing.T, keys map[string]*rsa.PrivateKey) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, jwks(t, keys), 0o600))
+	return path
+}
+
+func TestAuthenticator_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	key, otherKey := rsaKeys()[0], rsaKeys()[1]
+	cfg := &authcfg.Auth{
+		Issuer:      issuer,
+		Audience:    audience,
+		Leeway:      time.Minute,
+		HS256Secret: secret,
+		JWKS:        authcfg.JWKS{File: jwksFile(t, map[string]*rsa.PrivateKey{"key-1": key})},
+	}
+	user := &usermodels.User{Name: userName, Issuer: issuer, Subject: "alice"}
+
+	tests := []struct {
+		name           string
+		token          string
+		setupUsersMock func(m *mocks.Users)
+		wantCode       codes.Code
+	}{
+		{
+			name:  "valid HS256 token",
+			token: signHS256(t, secret, validClaims()),
+			setupUsersMock: func(m *mocks.Users) {
+				m.On("Resolve", mock.Anything, &auth.Claims{
+					Issuer:    issuer,
+					Subject:   "alice",
+					Audience:  []string{"other", audience},
+					ExpiresAt: time.Unix(expiresAt, 0).UTC(),
+					Email:     "alice@example.com",
+					Name:      "Alice",
+				}).Return(user, nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:  "valid RS256 token",
+			token: signRS256(t, key, "key-1", validClaims().with("aud", audience)),
+			setupUsersMock: func(m *mocks.Users) {
+				m.On("Resolve", mock.Anything, mock.Anything).Return(user, nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:  "expired within leeway",
+			token: signHS256(t, secret, validClaims().with("exp", time.Now().Add(-30*time.Second).Unix())),
+			setupUsersMock: func(m *mocks.Users) {
+				m.On("Resolve", mock.Anything, mock.Anything).Return(user, nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "fractional dates",
+			token: signHS256(t, secret, validClaims().
+				with("exp", float64(expiresAt)+0.5).
+				with("nbf", float64(time.Now().Add(-time.Minute).Unix())+0.25)),
+			setupUsersMock: func(m *mocks.Users) {
+				m.On("Resolve", mock.Anything, mock.MatchedBy(func(claims *auth.Claims) bool {
+					return claims.ExpiresAt.Equal(time.Unix(expiresAt, int64(time.Second/2)))
+				})).Return(user, nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:           "wrong secret",
+			token:          signHS256(t, "guessed", validClaims()),
+			setupUsersMock: func(m *mocks.Users) {},
+			wantCode:       codes.Unauthenticated,
+		},
+		{
+			name:           "unknown key",
+			token:          signRS256(t, otherKey, "key-2", validClaims()),
+			setupUsersMock: func(m *mocks.Users) {},
+			wantCode:       codes.Unauthenticated,
+		},
+		{
+			name:           "forged key id",
+			token:          signRS256(t, otherKey, "key-1", validClaims()),
+			setupUsersMock: func(m *mocks.Users) {},
+			wantCode:       codes.Unauthenticated,
+		},
+		{
+			name:           "unsigned token",
+			token:          unsigned(t, validClaims()),
+			setupUsersMock: func(m *mocks.Users) {},
+			wantCode:       codes.Unauthenticated,
+		},
+		{
+			name:           "malformed token",
+			token:          "not-a-jwt",
+			setupUsersMock: func(m *mocks.Users) {},
+			wantCode:       codes.Unauthenticated,
+		},
+		{
+			name:           "expired",
+			token:          signHS256(t, secret, validClaims().with("exp", time.Now().Add(-time.Hour).Unix())),
+			setupUsersMock: func(m *mocks.Users) {},
+			wantCode:       codes.Unauthenticated,
+		},
+		{
+			name:           "not valid yet",
+			token:          signHS256(t, secret, validClaims().with("nbf", time.Now().Add(time.Hour).Unix())),
+			setupUsersMock: func(m *mocks.Users) {},
+			wantCode:       codes.Unauthenticated,
+		},
+		{
+			name:           "missing exp",
+			token:          signHS256(t, secret, validClaims().without("exp")),
+			setupUsersMock: func(m *mocks.Users) {},
+			wantCode:       codes.Unauthenticated,
+		},
+		{
+			name:           "exp is not a number",
+			token:          signHS256(t, secret, validClaims().with("exp", "tomorrow")),
+			setupUsersMock: func(m *mocks.Users) {},
+			wantCode:       codes.Unauthenticated,
+		},
+		{
+			name:           "missing sub",
+			token:          signHS256(t, secret, validClaims().without("sub")),
+			setupUsersMock: func(m *mocks.Users) {},
+			wantCode:       codes.Unauthenticated,
+		},
+		{
+			name:           "wrong issuer",
+			token:          signHS256(t, secret, validClaims().with("iss", "https://evil.example.com")),
+			setupUsersMock: func(m *mocks.Users) {},
+			wantCode:       codes.Unauthenticated,
+		},
+		{
+			name:           "wrong audience",
+			token:          signHS256(t, secret, validClaims().with("aud", "other")),
+			setupUsersMock: func(m *mocks.Users) {},
+			wantCode:       codes.Unauthenticated,
+		},
+		{
+			name:  "users fail",
+			token: signHS256(t, secret, validClaims()),
+			setupUsersMock: func(m *mocks.Users) {
+				m.On("Resolve", mock.Anything, mock.Anything).Return(nil, status.New(codes.Internal, "database is down"))
+			},
+			wantCode: codes.Internal,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			usersMock := mocks.NewUsers(t)
+			tt.setupUsersMock(usersMock)
+
+			authenticator, err := auth.New(context.Background(), cfg, usersMock)
+			require.NoError(t, err)
+
+			ctx, err := authenticator.Authenticate(context.Background(), tt.token)
+			require.Equal(t, tt.wantCode, status.Code(err), "%v", err)
+			if tt.wantCode != codes.OK {
+				return
+			}
+			principal, ok := auth.FromContext(ctx)
+			require.True(t, ok)
+			assert.Equal(t, &auth.Principal{User: userName, Issuer: issuer, Subject: "alice"}, principal)
+		})
+	}
+}
+
+func TestAuthenticator_AlgorithmNotConfigured(t *testing.T) {
+	t.Parallel()
+
+	key := rsaKeys()[0]
+	authenticator, err := auth.New(context.Background(), &authcfg.Auth{
+		JWKS: authcfg.JWKS{File: jwksFile(t, map[string]*rsa.PrivateKey{"key-1": key})},
+	}, mocks.NewUsers(t))
+	require.NoError(t, err)
+
+	// An HS256 token keyed with the public key must not pass for RS256.
+	_, err = authenticator.Authenticate(context.Background(), signHS256(t, string(key.N.Bytes()), validClaims()))
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	key := rsaKeys()[0]
+	set := jwks(t, map[string]*rsa.PrivateKey{"key-1": key})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/jwks.json" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write(set)
+	}))
+	t.Cleanup(server.Close)
+
+	tests := []struct {
+		name    string
+		cfg     *authcfg.Auth
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name:    "secret",
+			cfg:     &authcfg.Auth{HS256Secret: secret},
+			wantErr: require.NoError,
+		},
+		{
+			name:    "jwks url",
+			cfg:     &authcfg.Auth{JWKS: authcfg.JWKS{URL: server.URL + "/jwks.json", Timeout: time.Second}},
+			wantErr: require.NoError,
+		},
+		{
+			name:    "no keys",
+			cfg:     &authcfg.Auth{},
+			wantErr: require.Error,
+		},
+		{
+			name:    "jwks file and url",
+			cfg:     &authcfg.Auth{JWKS: authcfg.JWKS{File: jwksFile(t, map[string]*rsa.PrivateKey{"key-1": key}), URL: server.URL + "/jwks.json"}},
+			wantErr: require.Error,
+		},
+		{
+			name:    "unreachable jwks",
+			cfg:     &authcfg.Auth{JWKS: authcfg.JWKS{URL: server.URL + "/missing.json", Timeout: time.Second}},
+			wantErr: require.Error,
+		},
+		{
+			name:    "missing jwks file",
+			cfg:     &authcfg.Auth{JWKS: authcfg.JWKS{File: filepath.Join(t.TempDir(), "missing.json")}},
+			wantErr: require.Error,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := auth.New(context.Background(), tt.cfg, mocks.NewUsers(t))
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestAuthenticator_RotatedKeys(t *testing.T) {
+	t.Parallel()
+
+	oldKey, newKey := rsaKeys()[0], rsaKeys()[1]
+	var (
+		set   atomic.Value
+		loads atomic.Int32
+	)
+	set.Store(jwks(t, map[string]*rsa.PrivateKey{"old": oldKey}))
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		loads.Add(1)
+		_, _ = w.Write(set.Load().([]byte))
+	}))
+	t.Cleanup(server.Close)
+
+	usersMock := mocks.NewUsers(t)
+	usersMock.On("Resolve", mock.Anything, mock.Anything).Return(&usermodels.User{Name: userName}, nil)
+	authenticator, err := auth.New(context.Background(), &authcfg.Auth{
+		JWKS: authcfg.JWKS{URL: server.URL, RefreshInterval: time.Hour, Timeout: time.Second},
+	}, usersMock)
+	require.NoError(t, err)
+
+	set.Store(jwks(t, map[string]*rsa.PrivateKey{"old": oldKey, "new": newKey}))
+
+	_, err = authenticator.Authenticate(context.Background(), signRS256(t, oldKey, "old", validClaims()))
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), loads.Load(), "known keys must not reload the set")
+
+	_, err = authenticator.Authenticate(context.Background(), signRS256(t, newKey, "new", validClaims()))
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), loads.Load())
+}
+
+func TestAuthenticator_ReloadDoesNotBlockKnownKeys(t *testing.T) {
+	t.Parallel()
+
+	oldKey, newKey := rsaKeys()[0], rsaKeys()[1]
+	var (
+		loads   atomic.Int32
+		release = make(chan struct{})
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if loads.Add(1) > 1 {
+			<-release
+		}
+		_, _ = w.Write(jwks(t, map[string]*rsa.PrivateKey{"old": oldKey}))
+	}))
+	t.Cleanup(server.Close)
+
+	usersMock := mocks.NewUsers(t)
+	usersMock.On("Resolve", mock.Anything, mock.Anything).Return(&usermodels.User{Name: userName}, nil)
+	authenticator, err := auth.New(context.Background(), &authcfg.Auth{
+		JWKS: authcfg.JWKS{URL: server.URL, RefreshInterval: time.Hour, Timeout: 10 * time.Second},
+	}, usersMock)
+	require.NoError(t, err)
+
+	// A call signed by an unknown key reloads the set, which hangs until
+	// released.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, err := authenticator.Authenticate(context.Background(), signRS256(t, newKey, "new", validClaims()))
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	}()
+	require.Eventually(t, func() bool { return loads.Load() == 2 }, 5*time.Second, 10*time.Millisecond)
+
+	_, err = authenticator.Authenticate(context.Background(), signRS256(t, oldKey, "old", validClaims()))
+	require.NoError(t, err, "known keys must not wait for a reload")
+
+	close(release)
+	<-done
+}
@@ -0,0 +1,217 @@
+package auth
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	authcfg "github.com/10Narratives/ready-to-do/server/internal/config/auth"
+)
+
+// maxJWKSSize bounds the key sets fetched from URLs.
+const maxJWKSSize = 1 << 20
+
+// keySet holds the RSA keys of a JWKS by key ID and reloads them as
+// configured.
+type keySet struct {
+	load               func(ctx context.Context) ([]byte, error)
+	refreshInterval    time.Duration
+	minRefreshInterval time.Duration
+
+	// reloadMu serializes reloads, so that a stale set is fetched once
+	// rather than by every call that finds it stale. mu only guards the
+	// fields below and is never held while fetching.
+	reloadMu sync.Mutex
+
+	mu       sync.Mutex
+	keys     map[string]*rsa.PublicKey
+	loadedAt time.Time
+	// triedAt is the time of the latest reload, successful or not.
+	triedAt time.Time
+}
+
+// newKeySet loads the key set described by cfg. It returns nil if cfg names
+// no key set.
+func newKeySet(ctx context.Context, cfg *authcfg.JWKS) (*keySet, error) {
+	var load func(ctx context.Context) ([]byte, error)
+	switch {
+	case cfg.File != "" && cfg.URL != "":
+		return nil, errors.New("jwks file and url are mutually exclusive")
+	case cfg.File != "":
+		load = func(context.Context) ([]byte, error) {
+			return os.ReadFile(cfg.File)
+		}
+	case cfg.URL != "":
+		client := &http.Client{Timeout: cfg.Timeout}
+		load = func(ctx context.Context) ([]byte, error) {
+			return fetch(ctx, client, cfg.URL)
+		}
+	default:
+		return nil, nil
+	}
+
+	s := &keySet{
+		load:               load,
+		refreshInterval:    cfg.RefreshInterval,
+		minRefreshInterval: cfg.MinRefreshInterval,
+	}
+	if err := s.reload(ctx); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// key returns the key with the ID kid. An empty kid selects the only key of
+// sets with a single key. Stale sets and unknown key IDs reload the set.
+func (s *keySet) key(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	key, ok, triedAt, stale := s.lookup(kid)
+	if stale {
+		s.reloadMu.Lock()
+		// Another call may have reloaded the set while this one waited.
+		s.mu.Lock()
+		reloaded := s.triedAt.After(triedAt)
+		s.mu.Unlock()
+		if !reloaded {
+			// A failed reload keeps the previous keys, so that an
+			// unreachable key set does not lock everybody out.
+			_ = s.reload(ctx)
+		}
+		s.reloadMu.Unlock()
+		key, ok, _, _ = s.lookup(kid)
+	}
+	if !ok {
+		return nil, fmt.Errorf("unknown key %q", kid)
+	}
+	return key, nil
+}
+
+// lookup returns the key with the ID kid, the time of the latest reload and
+// whether the set is due for a reload.
+func (s *keySet) lookup(kid string) (key *rsa.PublicKey, ok bool, triedAt time.Time, stale bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if kid == "" && len(s.keys) == 1 {
+		for _, k := range s.keys {
+			key, ok = k, true
+		}
+	} else {
+		key, ok = s.keys[kid]
+	}
+	expired := s.refreshInterval > 0 && time.Since(s.loadedAt) > s.refreshInterval
+	stale = (expired || !ok) && time.Since(s.triedAt) > s.minRefreshInterval
+	return key, ok, s.triedAt, stale
+}
+
+// reload fetches the key set and swaps its keys in. The set is only locked
+// for the swap.
+func (s *keySet) reload(ctx context.Context) error {
+	keys, err := s.fetchKeys(ctx)
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.triedAt = now
+	if err != nil {
+		return err
+	}
+	s.keys = keys
+	s.loadedAt = now
+	return nil
+}
+
+func (s *keySet) fetchKeys(ctx context.Context) (map[string]*rsa.PublicKey, error) {
+	data, err := s.load(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load jwks: %v", err)
+	}
+	keys, err := parseJWKS(data)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse jwks: %v", err)
+	}
+	return keys, nil
+}
+
+func fetch(ctx context.Context, client *http.Client, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxJWKSSize))
+}
+
+// jwk is a JSON Web Key as defined by RFC 7517. Only RSA keys are used.
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// parseJWKS returns the RSA signing keys of a key set by key ID. Keys of
+// other types or uses are skipped.
+func parseJWKS(data []byte) (map[string]*rsa.PublicKey, error) {
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" || (k.Use != "" && k.Use != "sig") || (k.Alg != "" && k.Alg != algRS256) {
+			continue
+		}
+		key, err := k.rsaPublicKey()
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %v", k.Kid, err)
+		}
+		keys[k.Kid] = key
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("no RSA signing keys")
+	}
+	return keys, nil
+}
+
+func (k *jwk) rsaPublicKey() (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil || len(n) == 0 {
+		return nil, errors.New("invalid modulus")
+	}
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil || len(e) == 0 || len(e) > 4 {
+		return nil, errors.New("invalid exponent")
+	}
+
+	exponent := 0
+	for _, b := range e {
+		exponent = exponent<<8 | int(b)
+	}
+	key := &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: exponent}
+	if key.N.BitLen() < 2048 {
+		return nil, fmt.Errorf("%d-bit modulus is too short", key.N.BitLen())
+	}
+	return key, nil
+}
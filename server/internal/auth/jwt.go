@@ -0,0 +1,183 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Signing algorithms of accepted tokens.
+const (
+	algHS256 = "HS256"
+	algRS256 = "RS256"
+)
+
+// Claims are the claims of a verified token.
+type Claims struct {
+	Issuer    string
+	Subject   string
+	Audience  []string
+	ExpiresAt time.Time
+	// Email and Name are the standard OpenID Connect profile claims. They are
+	// empty if the token lacks them.
+	Email string
+	Name  string
+}
+
+// verifier checks the signature and the registered claims of JWTs.
+type verifier struct {
+	secret   []byte
+	keys     *keySet
+	issuer   string
+	audience string
+	leeway   time.Duration
+	now      func() time.Time
+}
+
+type header struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+type payload struct {
+	Issuer    string       `json:"iss"`
+	Subject   string       `json:"sub"`
+	Audience  audience     `json:"aud"`
+	ExpiresAt *numericDate `json:"exp"`
+	NotBefore *numericDate `json:"nbf"`
+	Email     string       `json:"email"`
+	Name      string       `json:"name"`
+}
+
+// audience accepts both forms of the "aud" claim: a string or an array.
+type audience []string
+
+func (a *audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = audience{single}
+		return nil
+	}
+	var many []string
+	if err := json.Unmarshal(data, &many); err != nil {
+		return errors.New("aud is neither a string nor an array of strings")
+	}
+	*a = many
+	return nil
+}
+
+// numericDate is a NumericDate as defined by RFC 7519: seconds since the
+// epoch, possibly with a fractional part.
+type numericDate struct {
+	time.Time
+}
+
+func (d *numericDate) UnmarshalJSON(data []byte) error {
+	var seconds float64
+	if err := json.Unmarshal(data, &seconds); err != nil {
+		return errors.New("date is not a number of seconds")
+	}
+	whole, fraction := math.Modf(seconds)
+	d.Time = time.Unix(int64(whole), int64(fraction*float64(time.Second)))
+	return nil
+}
+
+// verify returns the claims of token, a JWS in compact serialization, if its
+// signature is valid and it is currently valid for the configured issuer and
+// audience. Tokens must have "sub" and "exp" claims.
+func (v *verifier) verify(ctx context.Context, token string) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed token")
+	}
+
+	var h header
+	if err := decodeSegment(parts[0], &h); err != nil {
+		return nil, fmt.Errorf("malformed header: %v", err)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errors.New("malformed signature")
+	}
+	if err := v.checkSignature(ctx, h, parts[0]+"."+parts[1], signature); err != nil {
+		return nil, err
+	}
+
+	var p payload
+	if err := decodeSegment(parts[1], &p); err != nil {
+		return nil, fmt.Errorf("malformed claims: %v", err)
+	}
+	if err := v.checkClaims(&p); err != nil {
+		return nil, err
+	}
+
+	return &Claims{
+		Issuer:    p.Issuer,
+		Subject:   p.Subject,
+		Audience:  p.Audience,
+		ExpiresAt: p.ExpiresAt.UTC(),
+		Email:     p.Email,
+		Name:      p.Name,
+	}, nil
+}
+
+func (v *verifier) checkSignature(ctx context.Context, h header, signed string, signature []byte) error {
+	switch {
+	case h.Alg == algHS256 && v.secret != nil:
+		mac := hmac.New(sha256.New, v.secret)
+		mac.Write([]byte(signed))
+		if !hmac.Equal(mac.Sum(nil), signature) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	case h.Alg == algRS256 && v.keys != nil:
+		key, err := v.keys.key(ctx, h.Kid)
+		if err != nil {
+			return err
+		}
+		digest := sha256.Sum256([]byte(signed))
+		if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+			return errors.New("invalid signature")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported signing algorithm %q", h.Alg)
+	}
+}
+
+func (v *verifier) checkClaims(p *payload) error {
+	now := v.now()
+	switch {
+	case p.Subject == "":
+		return errors.New("missing sub claim")
+	case p.ExpiresAt == nil:
+		return errors.New("missing exp claim")
+	case now.After(p.ExpiresAt.Add(v.leeway)):
+		return errors.New("token expired")
+	case p.NotBefore != nil && now.Add(v.leeway).Before(p.NotBefore.Time):
+		return errors.New("token not valid yet")
+	case v.issuer != "" && p.Issuer != v.issuer:
+		return fmt.Errorf("unexpected issuer %q", p.Issuer)
+	case v.audience != "" && !slices.Contains(p.Audience, v.audience):
+		return errors.New("token not issued for this audience")
+	}
+	return nil
+}
+
+func decodeSegment(segment string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
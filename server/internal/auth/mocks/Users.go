@@ -0,0 +1,66 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	auth "github.com/10Narratives/ready-to-do/server/internal/auth"
+
+	mock "github.com/stretchr/testify/mock"
+
+	status "google.golang.org/grpc/status"
+
+	usermodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/user"
+)
+
+// Users is an autogenerated mock type for the Users type
+type Users struct {
+	mock.Mock
+}
+
+// Resolve provides a mock function with given fields: ctx, claims
+func (_m *Users) Resolve(ctx context.Context, claims *auth.Claims) (*usermodels.User, *status.Status) {
+	ret := _m.Called(ctx, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Resolve")
+	}
+
+	var r0 *usermodels.User
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *auth.Claims) (*usermodels.User, *status.Status)); ok {
+		return rf(ctx, claims)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *auth.Claims) *usermodels.User); ok {
+		r0 = rf(ctx, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usermodels.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *auth.Claims) *status.Status); ok {
+		r1 = rf(ctx, claims)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// NewUsers creates a new instance of Users. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUsers(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Users {
+	mock := &Users{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
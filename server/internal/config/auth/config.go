@@ -0,0 +1,33 @@
+package authcfg
+
+import "time"
+
+// Auth holds authentication settings. Callers authenticate with bearer JWTs
+// signed with HS256 by a shared secret or with RS256 by a key of a JWKS.
+type Auth struct {
+	// Enabled rejects calls without a valid token, except for the public
+	// methods of the gRPC server.
+	Enabled bool `yaml:"enabled" env-default:"true"`
+	// Issuer is the required "iss" claim. Empty accepts any issuer.
+	Issuer string `yaml:"issuer"`
+	// Audience must be one of the "aud" claims. Empty accepts any audience.
+	Audience string `yaml:"audience"`
+	// Leeway tolerates clock skew when checking "exp" and "nbf".
+	Leeway time.Duration `yaml:"leeway" env-default:"1m"`
+	// HS256Secret verifies HS256 tokens. Empty rejects them.
+	HS256Secret string `yaml:"hs256_secret"`
+	JWKS        JWKS   `yaml:"jwks"`
+}
+
+// JWKS locates the JSON Web Key Set that verifies RS256 tokens. Setting
+// neither File nor URL rejects them.
+type JWKS struct {
+	File string `yaml:"file"`
+	URL  string `yaml:"url"`
+	// RefreshInterval is how often the key set is reloaded. Tokens signed by
+	// an unknown key reload it early, at most once per MinRefreshInterval.
+	RefreshInterval    time.Duration `yaml:"refresh_interval" env-default:"1h"`
+	MinRefreshInterval time.Duration `yaml:"min_refresh_interval" env-default:"1m"`
+	// Timeout bounds fetching the key set from URL.
+	Timeout time.Duration `yaml:"timeout" env-default:"5s"`
+}
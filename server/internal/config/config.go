@@ -3,13 +3,17 @@ package config
 import (
 	"github.com/10Narratives/ready-to-do/common/pkg/config/loader"
 	"github.com/10Narratives/ready-to-do/common/pkg/config/logging"
+	authcfg "github.com/10Narratives/ready-to-do/server/internal/config/auth"
 	databasecfg "github.com/10Narratives/ready-to-do/server/internal/config/database"
+	servicecfg "github.com/10Narratives/ready-to-do/server/internal/config/service"
 	transportcfg "github.com/10Narratives/ready-to-do/server/internal/config/transport"
 )
 
 type Config struct {
 	Transport transportcfg.Transport `yaml:"transport"`
 	Database  databasecfg.Database   `yaml:"database"`
+	Service   servicecfg.Service     `yaml:"service"`
+	Auth      authcfg.Auth           `yaml:"auth"`
 	Logging   logging.Logging        `yaml:"logging"`
 }
 
@@ -1,13 +1,41 @@
 package databasecfg
 
-import "github.com/10Narratives/ready-to-do/common/pkg/config/logging"
+import (
+	"time"
+
+	"github.com/10Narratives/ready-to-do/common/pkg/config/logging"
+)
 
 type Database struct {
-	Host     string          `yaml:"host" env-required:"true" env-default:"localhost"`
-	Port     int             `yaml:"port" env-required:"true" env-default:"5432"`
-	User     string          `yaml:"user" env-required:"true" env-default:"postgres"`
-	Password string          `yaml:"password" env-required:"true" env-default:"secret"`
-	DBName   string          `yaml:"dbname" env-required:"true" env-default:"mydb"`
-	SSLMode  string          `yaml:"sslmode" env-default:"disable"`
-	Logging  logging.Logging `yaml:"logging"`
+	Host     string `yaml:"host" env-required:"true" env-default:"localhost"`
+	Port     int    `yaml:"port" env-required:"true" env-default:"5432"`
+	User     string `yaml:"user" env-required:"true" env-default:"postgres"`
+	Password string `yaml:"password" env-required:"true" env-default:"secret"`
+	DBName   string `yaml:"dbname" env-required:"true" env-default:"mydb"`
+	SSLMode  string `yaml:"sslmode" env-default:"disable"`
+	// AutoMigrate applies pending schema migrations at startup.
+	AutoMigrate bool            `yaml:"auto_migrate" env-default:"false"`
+	Pool        Pool            `yaml:"pool"`
+	Ping        Ping            `yaml:"ping"`
+	Logging     logging.Logging `yaml:"logging"`
+}
+
+// Pool holds connection pool settings.
+type Pool struct {
+	MaxOpenConns    int           `yaml:"max_open_conns" env-default:"20"`
+	MaxIdleConns    int           `yaml:"max_idle_conns" env-default:"2"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime" env-default:"30m"`
+	ConnMaxIdleTime time.Duration `yaml:"conn_max_idle_time" env-default:"5m"`
+	ConnectTimeout  time.Duration `yaml:"connect_timeout" env-default:"5s"`
+	// StatsInterval is how often pool statistics are logged; zero disables it.
+	StatsInterval time.Duration `yaml:"stats_interval" env-default:"1m"`
+}
+
+// Ping holds startup connection check settings.
+type Ping struct {
+	Attempts int `yaml:"attempts" env-default:"5"`
+	// Backoff is the delay after the first failed attempt. It doubles after
+	// every further failure up to MaxBackoff.
+	Backoff    time.Duration `yaml:"backoff" env-default:"500ms"`
+	MaxBackoff time.Duration `yaml:"max_backoff" env-default:"10s"`
 }
@@ -0,0 +1,54 @@
+package servicecfg
+
+import "time"
+
+// Service holds business logic configuration.
+type Service struct {
+	Projects    Projects    `yaml:"projects"`
+	Tasks       Tasks       `yaml:"tasks"`
+	Idempotency Idempotency `yaml:"idempotency"`
+}
+
+// Projects holds project service settings.
+type Projects struct {
+	// Retention is how long soft-deleted projects are kept before purging.
+	Retention time.Duration `yaml:"retention" env-default:"720h"`
+	// PurgeInterval is how often expired projects are purged.
+	PurgeInterval time.Duration `yaml:"purge_interval" env-default:"1h"`
+	// RebalanceInterval is how often project positions are checked for
+	// rebalancing.
+	RebalanceInterval time.Duration `yaml:"rebalance_interval" env-default:"10m"`
+	// MaxPositionLength is the position length above which project positions
+	// are rebalanced.
+	MaxPositionLength int `yaml:"max_position_length" env-default:"32"`
+	// MaxBatchSize is how many projects a batch call may address.
+	MaxBatchSize int `yaml:"max_batch_size" env-default:"1000"`
+	// TokenSecret signs page and change tokens, those of tasks and labels
+	// included.
+	// Replicas must share it and tokens survive restarts only if it is set;
+	// empty picks a random key.
+	TokenSecret string `yaml:"token_secret"`
+	// ChangeRetention is how long changes are kept for WatchProjects
+	// streams to resume from.
+	ChangeRetention time.Duration `yaml:"change_retention" env-default:"168h"`
+	// HeartbeatInterval is how often idle WatchProjects streams get a
+	// heartbeat. Keep it below the idle timeouts of proxies in between.
+	HeartbeatInterval time.Duration `yaml:"heartbeat_interval" env-default:"30s"`
+	// PollInterval is how often WatchProjects streams look for changes they
+	// missed notifications of.
+	PollInterval time.Duration `yaml:"poll_interval" env-default:"5s"`
+}
+
+// Tasks holds task service settings.
+type Tasks struct {
+	// MaxDepth is how deep subtasks may be nested. Top-level tasks have depth 0.
+	MaxDepth int `yaml:"max_depth" env-default:"4"`
+}
+
+// Idempotency holds settings of requests deduplicated by request ID.
+type Idempotency struct {
+	// TTL is how long the responses of such requests are kept for retries.
+	TTL time.Duration `yaml:"ttl" env-default:"24h"`
+	// PurgeInterval is how often expired responses are purged.
+	PurgeInterval time.Duration `yaml:"purge_interval" env-default:"1h"`
+}
@@ -1,27 +1,77 @@
 package transportcfg
 
-import "github.com/10Narratives/ready-to-do/common/pkg/config/logging"
+import (
+	"time"
+
+	"github.com/10Narratives/ready-to-do/common/pkg/config/logging"
+)
 
 // Transport holds the transport configuration.
 type Transport struct {
 	GRPC GRPC `yaml:"grpc"`
+	HTTP HTTP `yaml:"http"`
 }
 
 // GRPC holds gRPC server configuration.
 type GRPC struct {
 	Host           string          `yaml:"host" env-required:"true"`
 	Port           int             `yaml:"port" env-required:"true"`
-	Timeout        string          `yaml:"timeout" env-default:"4s"`
+	Timeout        time.Duration   `yaml:"timeout" env-default:"4s"`
 	MaxRecvMsgSize int             `yaml:"max_recv_msg_size" env-default:"4194304"`
 	MaxSendMsgSize int             `yaml:"max_send_msg_size" env-default:"4194304"`
 	Reflection     bool            `yaml:"reflection" env-default:"true"`
+	PublicMethods  []string        `yaml:"public_methods" env-default:"/grpc.health.v1.Health/,/grpc.reflection.v1.ServerReflection/,/grpc.reflection.v1alpha.ServerReflection/"`
 	TLS            TLS             `yaml:"tls"`
+	Interceptors   Interceptors    `yaml:"interceptors"`
 	Logging        logging.Logging `yaml:"logging"`
 }
 
+// Interceptors toggles the interceptors of the gRPC server. The default
+// deadline of calls is GRPC.Timeout.
+type Interceptors struct {
+	RequestID bool `yaml:"request_id" env-default:"true"`
+	Recovery  bool `yaml:"recovery" env-default:"true"`
+	AccessLog bool `yaml:"access_log" env-default:"true"`
+	Deadline  bool `yaml:"deadline" env-default:"true"`
+	Validate  bool `yaml:"validate" env-default:"true"`
+}
+
 // TLS holds TLS settings for gRPC.
 type TLS struct {
 	Enabled  bool   `yaml:"enabled" env-default:"false"`
 	CertFile string `yaml:"cert_file"`
 	KeyFile  string `yaml:"key_file"`
 }
+
+// Gateway modes select how the HTTP gateway reaches the gRPC services.
+const (
+	// GatewayModeInProcess calls the service implementations directly.
+	GatewayModeInProcess = "in-process"
+	// GatewayModeDial connects to the local gRPC server.
+	GatewayModeDial = "dial"
+)
+
+// HTTP holds HTTP/JSON gateway configuration.
+type HTTP struct {
+	Enabled         bool            `yaml:"enabled" env-default:"true"`
+	Host            string          `yaml:"host" env-default:"0.0.0.0"`
+	Port            int             `yaml:"port" env-default:"8080"`
+	Mode            string          `yaml:"mode" env-default:"in-process"`
+	ReadTimeout     time.Duration   `yaml:"read_timeout" env-default:"10s"`
+	WriteTimeout    time.Duration   `yaml:"write_timeout" env-default:"10s"`
+	UseProtoNames   bool            `yaml:"use_proto_names" env-default:"true"`
+	EmitUnpopulated bool            `yaml:"emit_unpopulated" env-default:"false"`
+	CORS            CORS            `yaml:"cors"`
+	Logging         logging.Logging `yaml:"logging"`
+}
+
+// CORS holds cross-origin resource sharing settings for the gateway.
+type CORS struct {
+	Enabled          bool          `yaml:"enabled" env-default:"true"`
+	AllowedOrigins   []string      `yaml:"allowed_origins" env-default:"*"`
+	AllowedMethods   []string      `yaml:"allowed_methods" env-default:"GET,POST,PATCH,PUT,DELETE,OPTIONS"`
+	AllowedHeaders   []string      `yaml:"allowed_headers" env-default:"Authorization,Content-Type,If-Match,X-Request-Id"`
+	ExposedHeaders   []string      `yaml:"exposed_headers" env-default:"ETag,X-Request-Id"`
+	AllowCredentials bool          `yaml:"allow_credentials" env-default:"false"`
+	MaxAge           time.Duration `yaml:"max_age" env-default:"10m"`
+}
@@ -0,0 +1,82 @@
+package filter
+
+import "strings"
+
+// Node is an element of a parsed filter expression.
+type Node interface {
+	// Pos returns the zero-based byte offset of the node in the filter.
+	Pos() int
+	String() string
+}
+
+// And matches when every operand matches.
+type And struct {
+	Position int
+	Operands []Node
+}
+
+// Or matches when at least one operand matches.
+type Or struct {
+	Position int
+	Operands []Node
+}
+
+// Not negates its operand.
+type Not struct {
+	Position int
+	Operand  Node
+}
+
+// Restriction compares a field with a literal value, e.g. `state = ACTIVE`.
+type Restriction struct {
+	Position   int
+	Field      string
+	Comparator Comparator
+	Value      Literal
+}
+
+// Literal is a constant on the right-hand side of a restriction.
+type Literal struct {
+	Position int
+	Text     string
+	// Quoted reports whether the literal was written as a string.
+	Quoted bool
+}
+
+// Comparator is a restriction operator.
+type Comparator string
+
+const (
+	Equals        Comparator = "="
+	NotEquals     Comparator = "!="
+	Less          Comparator = "<"
+	LessEquals    Comparator = "<="
+	Greater       Comparator = ">"
+	GreaterEquals Comparator = ">="
+	Has           Comparator = ":"
+)
+
+func (n *And) Pos() int         { return n.Position }
+func (n *Or) Pos() int          { return n.Position }
+func (n *Not) Pos() int         { return n.Position }
+func (n *Restriction) Pos() int { return n.Position }
+
+func (n *And) String() string { return join(n.Operands, " AND ") }
+func (n *Or) String() string  { return join(n.Operands, " OR ") }
+func (n *Not) String() string { return "NOT " + n.Operand.String() }
+
+func (n *Restriction) String() string {
+	value := n.Value.Text
+	if n.Value.Quoted {
+		value = `"` + strings.ReplaceAll(value, `"`, `\"`) + `"`
+	}
+	return n.Field + " " + string(n.Comparator) + " " + value
+}
+
+func join(nodes []Node, sep string) string {
+	parts := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		parts = append(parts, node.String())
+	}
+	return "(" + strings.Join(parts, sep) + ")"
+}
@@ -0,0 +1,442 @@
+// Package filter implements the AIP-160 filtering language for list methods.
+//
+// A filter is parsed into an AST, type checked against a Schema describing
+// the filterable fields of a resource, and compiled into a Filter that can be
+// rendered as a parameterized SQL condition for PostgreSQL storages or
+// evaluated in memory for other backends.
+package filter
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Type is the type of a filterable field.
+type Type int
+
+const (
+	String Type = iota
+	Int
+	Bool
+	Timestamp
+	Enum
+	// StringList is a repeated string field. It only supports the has
+	// comparator, which matches if any element equals the value, e.g.
+	// `labels:"urgent"`. Values may contain '*' wildcards.
+	StringList
+)
+
+func (t Type) String() string {
+	switch t {
+	case String:
+		return "string"
+	case Int:
+		return "int"
+	case Bool:
+		return "bool"
+	case Timestamp:
+		return "timestamp"
+	case Enum:
+		return "enum"
+	case StringList:
+		return "string list"
+	default:
+		return "unknown"
+	}
+}
+
+// Field describes one filterable field of T.
+type Field[T any] struct {
+	Type Type
+	// Column is the SQL expression the field is stored in. StringList
+	// columns must evaluate to a text array.
+	Column string
+	// Values maps enum value names to their stored numbers. Enum fields only.
+	Values map[string]int
+	// Get extracts the field value from a resource for in-memory evaluation.
+	// It must return a string, int64, bool, time.Time, []string or, for
+	// enums, int.
+	Get func(T) any
+}
+
+// Schema lists the fields of T that filters may refer to, keyed by name.
+type Schema[T any] map[string]Field[T]
+
+// Filter is a type-checked filter expression over resources of type T.
+type Filter[T any] struct {
+	source string
+	root   condition[T]
+}
+
+// Compile parses src and checks it against schema.
+// An empty src yields a nil filter, which matches everything.
+func Compile[T any](src string, schema Schema[T]) (*Filter[T], error) {
+	node, err := Parse(src)
+	if err != nil || node == nil {
+		return nil, err
+	}
+
+	root, err := check(node, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Filter[T]{source: src, root: root}, nil
+}
+
+// String returns the filter source.
+func (f *Filter[T]) String() string {
+	if f == nil {
+		return ""
+	}
+	return f.source
+}
+
+// SQL renders the filter as a SQL boolean expression whose placeholders start
+// at $first. It returns the expression and its arguments.
+func (f *Filter[T]) SQL(first int) (string, []any) {
+	if f == nil {
+		return "TRUE", nil
+	}
+	b := &sqlBuilder{next: first}
+	f.root.sql(b)
+	return b.sb.String(), b.args
+}
+
+// Match reports whether the resource satisfies the filter.
+func (f *Filter[T]) Match(resource T) bool {
+	if f == nil {
+		return true
+	}
+	return f.root.match(resource)
+}
+
+type condition[T any] interface {
+	sql(b *sqlBuilder)
+	match(resource T) bool
+}
+
+type sqlBuilder struct {
+	sb   strings.Builder
+	args []any
+	next int
+}
+
+func (b *sqlBuilder) arg(v any) string {
+	b.args = append(b.args, v)
+	placeholder := fmt.Sprintf("$%d", b.next)
+	b.next++
+	return placeholder
+}
+
+type andCondition[T any] []condition[T]
+
+func (c andCondition[T]) sql(b *sqlBuilder) { joinSQL(b, c, " AND ") }
+
+func (c andCondition[T]) match(resource T) bool {
+	for _, operand := range c {
+		if !operand.match(resource) {
+			return false
+		}
+	}
+	return true
+}
+
+type orCondition[T any] []condition[T]
+
+func (c orCondition[T]) sql(b *sqlBuilder) { joinSQL(b, c, " OR ") }
+
+func (c orCondition[T]) match(resource T) bool {
+	for _, operand := range c {
+		if operand.match(resource) {
+			return true
+		}
+	}
+	return false
+}
+
+func joinSQL[T any](b *sqlBuilder, operands []condition[T], sep string) {
+	b.sb.WriteString("(")
+	for i, operand := range operands {
+		if i > 0 {
+			b.sb.WriteString(sep)
+		}
+		operand.sql(b)
+	}
+	b.sb.WriteString(")")
+}
+
+type notCondition[T any] struct {
+	operand condition[T]
+}
+
+func (c notCondition[T]) sql(b *sqlBuilder) {
+	b.sb.WriteString("NOT ")
+	c.operand.sql(b)
+}
+
+func (c notCondition[T]) match(resource T) bool { return !c.operand.match(resource) }
+
+type restrictionCondition[T any] struct {
+	field      Field[T]
+	comparator Comparator
+	value      any
+	// pattern is the LIKE pattern of string has-restrictions.
+	pattern string
+}
+
+func (c restrictionCondition[T]) sql(b *sqlBuilder) {
+	if c.field.Type == StringList {
+		fmt.Fprintf(&b.sb, `EXISTS (SELECT 1 FROM unnest(%s) AS element WHERE element LIKE %s ESCAPE '\')`,
+			c.field.Column, b.arg(c.pattern))
+		return
+	}
+	if c.comparator == Has {
+		fmt.Fprintf(&b.sb, `%s LIKE %s ESCAPE '\'`, c.field.Column, b.arg(c.pattern))
+		return
+	}
+	fmt.Fprintf(&b.sb, "%s %s %s", c.field.Column, sqlOperator(c.comparator), b.arg(c.value))
+}
+
+func sqlOperator(c Comparator) string {
+	if c == NotEquals {
+		return "<>"
+	}
+	return string(c)
+}
+
+func (c restrictionCondition[T]) match(resource T) bool {
+	actual := c.field.Get(resource)
+	if c.field.Type == StringList {
+		elements, _ := actual.([]string)
+		for _, element := range elements {
+			if matchLike(element, c.pattern) {
+				return true
+			}
+		}
+		return false
+	}
+	if c.comparator == Has {
+		s, _ := actual.(string)
+		return matchLike(s, c.pattern)
+	}
+	return compare(actual, c.value, c.comparator)
+}
+
+func compare(actual, expected any, comparator Comparator) bool {
+	var cmp int
+	switch expected := expected.(type) {
+	case string:
+		cmp = strings.Compare(actual.(string), expected)
+	case int64:
+		cmp = compareOrdered(actual.(int64), expected)
+	case int:
+		cmp = compareOrdered(actual.(int), expected)
+	case bool:
+		cmp = compareOrdered(boolInt(actual.(bool)), boolInt(expected))
+	case time.Time:
+		cmp = actual.(time.Time).Compare(expected)
+	default:
+		return false
+	}
+
+	switch comparator {
+	case Equals:
+		return cmp == 0
+	case NotEquals:
+		return cmp != 0
+	case Less:
+		return cmp < 0
+	case LessEquals:
+		return cmp <= 0
+	case Greater:
+		return cmp > 0
+	case GreaterEquals:
+		return cmp >= 0
+	default:
+		return false
+	}
+}
+
+func compareOrdered[V int | int64](a, b V) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// check type checks node against schema and builds its condition tree.
+func check[T any](node Node, schema Schema[T]) (condition[T], error) {
+	switch node := node.(type) {
+	case *And:
+		operands, err := checkAll(node.Operands, schema)
+		if err != nil {
+			return nil, err
+		}
+		return andCondition[T](operands), nil
+	case *Or:
+		operands, err := checkAll(node.Operands, schema)
+		if err != nil {
+			return nil, err
+		}
+		return orCondition[T](operands), nil
+	case *Not:
+		operand, err := check(node.Operand, schema)
+		if err != nil {
+			return nil, err
+		}
+		return notCondition[T]{operand: operand}, nil
+	case *Restriction:
+		return checkRestriction(node, schema)
+	default:
+		return nil, errorf(node.Pos(), "unsupported expression")
+	}
+}
+
+func checkAll[T any](nodes []Node, schema Schema[T]) ([]condition[T], error) {
+	conditions := make([]condition[T], 0, len(nodes))
+	for _, node := range nodes {
+		c, err := check(node, schema)
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, c)
+	}
+	return conditions, nil
+}
+
+func checkRestriction[T any](node *Restriction, schema Schema[T]) (condition[T], error) {
+	field, ok := schema[node.Field]
+	if !ok {
+		return nil, errorf(node.Position, "unknown field %q, expected one of %s", node.Field, fieldNames(schema))
+	}
+
+	if !allowed(field.Type, node.Comparator) {
+		return nil, errorf(node.Position, "comparator %q is not supported for %s field %q", node.Comparator, field.Type, node.Field)
+	}
+
+	c := restrictionCondition[T]{field: field, comparator: node.Comparator}
+	text := node.Value.Text
+	pos := node.Value.Position
+
+	switch field.Type {
+	case String:
+		if node.Comparator == Has {
+			c.pattern = likePattern(text)
+		}
+		c.value = text
+	case StringList:
+		c.pattern = elementPattern(text)
+	case Int:
+		v, err := strconv.ParseInt(text, 10, 64)
+		if err != nil || node.Value.Quoted {
+			return nil, errorf(pos, "field %q expects an integer but got %q", node.Field, text)
+		}
+		c.value = v
+	case Bool:
+		v, err := strconv.ParseBool(text)
+		if err != nil || node.Value.Quoted {
+			return nil, errorf(pos, "field %q expects true or false but got %q", node.Field, text)
+		}
+		c.value = v
+	case Timestamp:
+		v, err := time.Parse(time.RFC3339Nano, text)
+		if err != nil {
+			return nil, errorf(pos, "field %q expects an RFC 3339 timestamp but got %q", node.Field, text)
+		}
+		c.value = v.UTC()
+	case Enum:
+		v, ok := field.Values[text]
+		if !ok {
+			return nil, errorf(pos, "field %q expects one of %s but got %q", node.Field, enumNames(field.Values), text)
+		}
+		c.value = v
+	}
+
+	return c, nil
+}
+
+func allowed(t Type, c Comparator) bool {
+	switch t {
+	case String:
+		return true
+	case Int, Timestamp:
+		return c != Has
+	case StringList:
+		return c == Has
+	default:
+		return c == Equals || c == NotEquals
+	}
+}
+
+// likePattern turns a has-restriction value into a LIKE pattern. Values with
+// '*' wildcards must match as a whole, other values match as substrings.
+func likePattern(value string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+	if strings.Contains(escaped, "*") {
+		return strings.ReplaceAll(escaped, "*", "%")
+	}
+	return "%" + escaped + "%"
+}
+
+// elementPattern turns a has-restriction value on a StringList field into a
+// LIKE pattern. Unlike likePattern, values match whole elements only.
+func elementPattern(value string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+	return strings.ReplaceAll(escaped, "*", "%")
+}
+
+// matchLike evaluates a LIKE pattern produced by likePattern.
+func matchLike(s, pattern string) bool {
+	if pattern == "" {
+		return s == ""
+	}
+
+	switch pattern[0] {
+	case '%':
+		for i := 0; i <= len(s); i++ {
+			if matchLike(s[i:], pattern[1:]) {
+				return true
+			}
+		}
+		return false
+	case '\\':
+		if len(pattern) > 1 {
+			return s != "" && s[0] == pattern[1] && matchLike(s[1:], pattern[2:])
+		}
+	}
+
+	return s != "" && s[0] == pattern[0] && matchLike(s[1:], pattern[1:])
+}
+
+func fieldNames[T any](schema Schema[T]) string {
+	names := make([]string, 0, len(schema))
+	for name := range schema {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+func enumNames(values map[string]int) string {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
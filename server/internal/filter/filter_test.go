@@ -0,0 +1,255 @@
+package filter_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/10Narratives/ready-to-do/server/internal/filter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type item struct {
+	Name      string
+	Count     int64
+	Pinned    bool
+	CreatedAt time.Time
+	State     int
+	Labels    []string
+}
+
+var schema = filter.Schema[item]{
+	"name": {
+		Type:   filter.String,
+		Column: "name",
+		Get:    func(i item) any { return i.Name },
+	},
+	"count": {
+		Type:   filter.Int,
+		Column: "count",
+		Get:    func(i item) any { return i.Count },
+	},
+	"pinned": {
+		Type:   filter.Bool,
+		Column: "pinned",
+		Get:    func(i item) any { return i.Pinned },
+	},
+	"created_at": {
+		Type:   filter.Timestamp,
+		Column: "created_at",
+		Get:    func(i item) any { return i.CreatedAt },
+	},
+	"state": {
+		Type:   filter.Enum,
+		Column: "state",
+		Values: map[string]int{"ACTIVE": 1, "ARCHIVED": 2},
+		Get:    func(i item) any { return i.State },
+	},
+	"labels": {
+		Type:   filter.StringList,
+		Column: "labels",
+		Get:    func(i item) any { return i.Labels },
+	},
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		src     string
+		want    string
+		wantPos int
+	}{
+		{
+			name: "empty",
+			src:  "  ",
+		},
+		{
+			name: "single restriction",
+			src:  `name = "infra"`,
+			want: `name = "infra"`,
+		},
+		{
+			name: "or binds tighter than and",
+			src:  "a = 1 AND b = 2 OR c = 3",
+			want: "(a = 1 AND (b = 2 OR c = 3))",
+		},
+		{
+			name: "implicit and with negation",
+			src:  "a = 1 -b = 2 NOT (c = 3)",
+			want: "(a = 1 AND NOT b = 2 AND NOT c = 3)",
+		},
+		{
+			name: "member path and negative number",
+			src:  "a.b.c >= -1.5",
+			want: "a.b.c >= -1.5",
+		},
+		{
+			name:    "missing value",
+			src:     "a = ",
+			wantPos: 5,
+		},
+		{
+			name:    "bare value",
+			src:     "a AND b = 1",
+			wantPos: 1,
+		},
+		{
+			name:    "unbalanced parenthesis",
+			src:     "(a = 1",
+			wantPos: 7,
+		},
+		{
+			name:    "unterminated string",
+			src:     `a = "b`,
+			wantPos: 5,
+		},
+		{
+			name:    "lone bang",
+			src:     "a ! 1",
+			wantPos: 3,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			node, err := filter.Parse(tt.src)
+			if tt.wantPos != 0 {
+				var filterErr *filter.Error
+				require.ErrorAs(t, err, &filterErr)
+				assert.Equal(t, tt.wantPos, filterErr.Position)
+				return
+			}
+			require.NoError(t, err)
+			if tt.want == "" {
+				assert.Nil(t, node)
+				return
+			}
+			assert.Equal(t, tt.want, node.String())
+		})
+	}
+}
+
+func TestCompile(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	infra := item{Name: "infra-core", Count: 3, Pinned: true, CreatedAt: created, State: 1, Labels: []string{"urgent", "backend"}}
+
+	tests := []struct {
+		name     string
+		src      string
+		wantSQL  string
+		wantArgs []any
+		want     bool
+		wantPos  int
+	}{
+		{
+			name:     "wildcard string",
+			src:      `name : "infra*"`,
+			wantSQL:  `name LIKE $3 ESCAPE '\'`,
+			wantArgs: []any{"infra%"},
+			want:     true,
+		},
+		{
+			name:     "substring with escaped wildcard characters",
+			src:      `name : "a_%"`,
+			wantSQL:  `name LIKE $3 ESCAPE '\'`,
+			wantArgs: []any{`%a\_\%%`},
+			want:     false,
+		},
+		{
+			name:     "combined restrictions",
+			src:      `state = ACTIVE AND (count > 2 OR pinned = false) AND created_at > "2026-01-01T00:00:00Z"`,
+			wantSQL:  `(state = $3 AND (count > $4 OR pinned = $5) AND created_at > $6)`,
+			wantArgs: []any{1, int64(2), false, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+			want:     true,
+		},
+		{
+			name:     "negation",
+			src:      `NOT state = ARCHIVED name != "other"`,
+			wantSQL:  `(NOT state = $3 AND name <> $4)`,
+			wantArgs: []any{2, "other"},
+			want:     true,
+		},
+		{
+			name:     "list element",
+			src:      `labels:"urgent" AND NOT labels:"front*"`,
+			wantSQL:  `(EXISTS (SELECT 1 FROM unnest(labels) AS element WHERE element LIKE $3 ESCAPE '\') AND NOT EXISTS (SELECT 1 FROM unnest(labels) AS element WHERE element LIKE $4 ESCAPE '\'))`,
+			wantArgs: []any{"urgent", "front%"},
+			want:     true,
+		},
+		{
+			name:     "list element is not a substring",
+			src:      `labels:"urge"`,
+			wantSQL:  `EXISTS (SELECT 1 FROM unnest(labels) AS element WHERE element LIKE $3 ESCAPE '\')`,
+			wantArgs: []any{"urge"},
+			want:     false,
+		},
+		{
+			name:    "comparing list",
+			src:     `labels = "urgent"`,
+			wantPos: 1,
+		},
+		{
+			name:    "unknown field",
+			src:     `state = ACTIVE owner = "me"`,
+			wantPos: 16,
+		},
+		{
+			name:    "unknown enum value",
+			src:     `state = DONE`,
+			wantPos: 9,
+		},
+		{
+			name:    "ordering enum",
+			src:     `state > ACTIVE`,
+			wantPos: 1,
+		},
+		{
+			name:    "bad timestamp",
+			src:     `created_at > "yesterday"`,
+			wantPos: 14,
+		},
+		{
+			name:    "quoted integer",
+			src:     `count = "3"`,
+			wantPos: 9,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			f, err := filter.Compile(tt.src, schema)
+			if tt.wantPos != 0 {
+				var filterErr *filter.Error
+				require.ErrorAs(t, err, &filterErr)
+				assert.Equal(t, tt.wantPos, filterErr.Position)
+				return
+			}
+			require.NoError(t, err)
+
+			sql, args := f.SQL(3)
+			assert.Equal(t, tt.wantSQL, sql)
+			assert.Equal(t, tt.wantArgs, args)
+			assert.Equal(t, tt.want, f.Match(infra))
+		})
+	}
+}
+
+func TestCompile_Empty(t *testing.T) {
+	t.Parallel()
+
+	f, err := filter.Compile("", schema)
+	require.NoError(t, err)
+
+	sql, args := f.SQL(1)
+	assert.Equal(t, "TRUE", sql)
+	assert.Empty(t, args)
+	assert.True(t, f.Match(item{}))
+}
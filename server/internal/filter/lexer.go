@@ -0,0 +1,171 @@
+package filter
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenText
+	tokenString
+	tokenAnd
+	tokenOr
+	tokenNot
+	tokenMinus
+	tokenLParen
+	tokenRParen
+	tokenDot
+	tokenComparator
+)
+
+func (k tokenKind) String() string {
+	switch k {
+	case tokenEOF:
+		return "end of filter"
+	case tokenText:
+		return "text"
+	case tokenString:
+		return "string"
+	case tokenAnd:
+		return "AND"
+	case tokenOr:
+		return "OR"
+	case tokenNot:
+		return "NOT"
+	case tokenMinus:
+		return "'-'"
+	case tokenLParen:
+		return "'('"
+	case tokenRParen:
+		return "')'"
+	case tokenDot:
+		return "'.'"
+	case tokenComparator:
+		return "comparator"
+	default:
+		return "unknown token"
+	}
+}
+
+type token struct {
+	kind tokenKind
+	// text holds the unquoted value of strings and the source of other tokens.
+	text string
+	// pos is the zero-based byte offset of the token in the filter.
+	pos int
+}
+
+// lex splits a filter into tokens.
+func lex(src string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(src); {
+		r, size := utf8.DecodeRuneInString(src[i:])
+		switch {
+		case unicode.IsSpace(r):
+			i += size
+		case r == '(':
+			tokens = append(tokens, token{kind: tokenLParen, text: "(", pos: i})
+			i++
+		case r == ')':
+			tokens = append(tokens, token{kind: tokenRParen, text: ")", pos: i})
+			i++
+		case r == '.':
+			tokens = append(tokens, token{kind: tokenDot, text: ".", pos: i})
+			i++
+		case r == '-':
+			tokens = append(tokens, token{kind: tokenMinus, text: "-", pos: i})
+			i++
+		case r == '=' || r == ':':
+			tokens = append(tokens, token{kind: tokenComparator, text: string(r), pos: i})
+			i++
+		case r == '<' || r == '>' || r == '!':
+			op := string(r)
+			if i+1 < len(src) && src[i+1] == '=' {
+				op += "="
+			}
+			if op == "!" {
+				return nil, errorf(i, "unexpected '!', did you mean '!='?")
+			}
+			tokens = append(tokens, token{kind: tokenComparator, text: op, pos: i})
+			i += len(op)
+		case r == '"' || r == '\'':
+			text, n, err := lexString(src, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{kind: tokenString, text: text, pos: i})
+			i += n
+		default:
+			start := i
+			for i < len(src) {
+				r, size := utf8.DecodeRuneInString(src[i:])
+				if unicode.IsSpace(r) || strings.ContainsRune(`()<>=!:."'`, r) {
+					// Decimal points belong to numbers.
+					if r != '.' || !isNumber(src[start:i]) {
+						break
+					}
+				}
+				i += size
+			}
+			text := src[start:i]
+			kind := tokenText
+			switch text {
+			case "AND":
+				kind = tokenAnd
+			case "OR":
+				kind = tokenOr
+			case "NOT":
+				kind = tokenNot
+			}
+			tokens = append(tokens, token{kind: kind, text: text, pos: start})
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, pos: len(src)}), nil
+}
+
+// lexString reads a quoted string starting at src[start] and returns its
+// unescaped value together with the number of bytes consumed.
+func lexString(src string, start int) (string, int, error) {
+	quote := src[start]
+
+	var b strings.Builder
+	for i := start + 1; i < len(src); i++ {
+		c := src[i]
+		switch {
+		case c == quote:
+			return b.String(), i - start + 1, nil
+		case c == '\\':
+			if i+1 == len(src) {
+				return "", 0, errorf(i, "unterminated escape sequence")
+			}
+			i++
+			switch src[i] {
+			case 'n':
+				b.WriteByte('\n')
+			case 't':
+				b.WriteByte('\t')
+			default:
+				b.WriteByte(src[i])
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", 0, errorf(start, "unterminated string")
+}
+
+func isNumber(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
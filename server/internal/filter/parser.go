@@ -0,0 +1,264 @@
+package filter
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// MaxLength is the longest filter accepted by Parse.
+	MaxLength = 2048
+	// MaxDepth limits how deeply expressions may be nested.
+	MaxDepth = 32
+)
+
+// Error reports a problem at a position in the filter.
+type Error struct {
+	// Position is the one-based byte offset of the offending token.
+	Position int
+	Message  string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("position %d: %s", e.Position, e.Message)
+}
+
+func errorf(offset int, format string, args ...any) *Error {
+	return &Error{Position: offset + 1, Message: fmt.Sprintf(format, args...)}
+}
+
+// Parse parses an AIP-160 filter expression. An empty filter yields a nil node.
+//
+// The supported grammar is the AIP-160 subset of restrictions on (possibly
+// dotted) fields combined with AND, OR, NOT, '-' and parentheses. As in
+// AIP-160, OR binds tighter than AND and adjacent terms are implicitly joined
+// with AND.
+func Parse(src string) (Node, error) {
+	if len(src) > MaxLength {
+		return nil, errorf(MaxLength, "filter is longer than %d bytes", MaxLength)
+	}
+	if strings.TrimSpace(src) == "" {
+		return nil, nil
+	}
+
+	tokens, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	node, err := p.expression(0)
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, errorf(tok.pos, "unexpected %s", describe(tok))
+	}
+
+	return node, nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+// expression: sequence {AND sequence}
+func (p *parser) expression(depth int) (Node, error) {
+	if depth > MaxDepth {
+		return nil, errorf(p.peek().pos, "filter is nested deeper than %d levels", MaxDepth)
+	}
+
+	start := p.peek().pos
+	var operands []Node
+	for {
+		node, err := p.sequence(depth)
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, node)
+
+		if p.peek().kind != tokenAnd {
+			break
+		}
+		p.next()
+	}
+
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+	return &And{Position: start, Operands: operands}, nil
+}
+
+// sequence: factor {factor}
+func (p *parser) sequence(depth int) (Node, error) {
+	start := p.peek().pos
+	var operands []Node
+	for {
+		node, err := p.factor(depth)
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, node)
+
+		if !startsTerm(p.peek().kind) {
+			break
+		}
+	}
+
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+	return &And{Position: start, Operands: operands}, nil
+}
+
+func startsTerm(kind tokenKind) bool {
+	switch kind {
+	case tokenText, tokenNot, tokenMinus, tokenLParen:
+		return true
+	default:
+		return false
+	}
+}
+
+// factor: term {OR term}
+func (p *parser) factor(depth int) (Node, error) {
+	start := p.peek().pos
+	var operands []Node
+	for {
+		node, err := p.term(depth)
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, node)
+
+		if p.peek().kind != tokenOr {
+			break
+		}
+		p.next()
+	}
+
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+	return &Or{Position: start, Operands: operands}, nil
+}
+
+// term: [NOT | '-'] simple
+func (p *parser) term(depth int) (Node, error) {
+	if tok := p.peek(); tok.kind == tokenNot || tok.kind == tokenMinus {
+		p.next()
+		operand, err := p.simple(depth)
+		if err != nil {
+			return nil, err
+		}
+		return &Not{Position: tok.pos, Operand: operand}, nil
+	}
+	return p.simple(depth)
+}
+
+// simple: restriction | '(' expression ')'
+func (p *parser) simple(depth int) (Node, error) {
+	tok := p.peek()
+	if tok.kind == tokenLParen {
+		p.next()
+		node, err := p.expression(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != tokenRParen {
+			return nil, errorf(closing.pos, "expected ')' but found %s", describe(closing))
+		}
+		return node, nil
+	}
+	return p.restriction()
+}
+
+// restriction: member comparator arg
+func (p *parser) restriction() (Node, error) {
+	start := p.peek()
+	field, err := p.member()
+	if err != nil {
+		return nil, err
+	}
+
+	op := p.peek()
+	if op.kind != tokenComparator {
+		return nil, errorf(start.pos, "expected comparator after %q, bare values are not supported", field)
+	}
+	p.next()
+
+	value, err := p.arg()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Restriction{
+		Position:   start.pos,
+		Field:      field,
+		Comparator: Comparator(op.text),
+		Value:      value,
+	}, nil
+}
+
+// member: TEXT {'.' TEXT}
+func (p *parser) member() (string, error) {
+	tok := p.next()
+	if tok.kind != tokenText {
+		return "", errorf(tok.pos, "expected field name but found %s", describe(tok))
+	}
+
+	parts := []string{tok.text}
+	for p.peek().kind == tokenDot {
+		p.next()
+		tok := p.next()
+		if tok.kind != tokenText {
+			return "", errorf(tok.pos, "expected field name after '.' but found %s", describe(tok))
+		}
+		parts = append(parts, tok.text)
+	}
+
+	return strings.Join(parts, "."), nil
+}
+
+// arg: STRING | ['-'] TEXT
+func (p *parser) arg() (Literal, error) {
+	tok := p.next()
+	switch tok.kind {
+	case tokenString:
+		return Literal{Position: tok.pos, Text: tok.text, Quoted: true}, nil
+	case tokenText:
+		return Literal{Position: tok.pos, Text: tok.text}, nil
+	case tokenMinus:
+		value := p.next()
+		if value.kind != tokenText || value.pos != tok.pos+1 {
+			return Literal{}, errorf(tok.pos, "expected number after '-'")
+		}
+		return Literal{Position: tok.pos, Text: "-" + value.text}, nil
+	default:
+		return Literal{}, errorf(tok.pos, "expected value but found %s", describe(tok))
+	}
+}
+
+func describe(tok token) string {
+	switch tok.kind {
+	case tokenEOF:
+		return tok.kind.String()
+	case tokenString:
+		return fmt.Sprintf("string %q", tok.text)
+	default:
+		return fmt.Sprintf("%q", tok.text)
+	}
+}
@@ -0,0 +1,91 @@
+package migrate_test
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/10Narratives/ready-to-do/server/internal/migrate"
+	"github.com/10Narratives/ready-to-do/server/migrations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		fsys    fstest.MapFS
+		want    []migrate.Migration
+		wantErr bool
+	}{
+		{
+			name: "sorted by version",
+			fsys: fstest.MapFS{
+				"0010_add_tasks.up.sql":         {Data: []byte("CREATE TABLE tasks ();")},
+				"0002_add_index.up.sql":         {Data: []byte("CREATE INDEX i ON t (c);")},
+				"0002_add_index.down.sql":       {Data: []byte("DROP INDEX i;")},
+				"0001_create_projects.up.sql":   {Data: []byte("CREATE TABLE projects ();")},
+				"0001_create_projects.down.sql": {Data: []byte("DROP TABLE projects;")},
+				"migrations.go":                 {Data: []byte("package migrations")},
+				"0010_add_tasks.down.sql":       {Data: []byte("DROP TABLE tasks;")},
+			},
+			want: []migrate.Migration{
+				{Version: 1, Name: "create_projects", Up: "CREATE TABLE projects ();", Down: "DROP TABLE projects;"},
+				{Version: 2, Name: "add_index", Up: "CREATE INDEX i ON t (c);", Down: "DROP INDEX i;"},
+				{Version: 10, Name: "add_tasks", Up: "CREATE TABLE tasks ();", Down: "DROP TABLE tasks;"},
+			},
+		},
+		{
+			name: "missing up script",
+			fsys: fstest.MapFS{
+				"0001_create_projects.down.sql": {Data: []byte("DROP TABLE projects;")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate version",
+			fsys: fstest.MapFS{
+				"0001_create_projects.up.sql": {Data: []byte("SELECT 1;")},
+				"0001_create_tasks.up.sql":    {Data: []byte("SELECT 1;")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "malformed name",
+			fsys: fstest.MapFS{
+				"create_projects.sql": {Data: []byte("SELECT 1;")},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := migrate.Load(tt.fsys)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLoad_Embedded(t *testing.T) {
+	t.Parallel()
+
+	got, err := migrate.Load(migrations.FS)
+	require.NoError(t, err)
+	require.NotEmpty(t, got)
+
+	for i, migration := range got {
+		assert.NotEmpty(t, migration.Down, "migration %d_%s has no down script", migration.Version, migration.Name)
+		if i > 0 {
+			assert.Greater(t, migration.Version, got[i-1].Version)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package idempotencymodels
+
+import "time"
+
+// Record is a request that carried a request ID.
+type Record struct {
+	// Key identifies the request, e.g. the request ID scoped by the method.
+	Key string `json:"key"`
+	// RequestHash fingerprints the payload of the request, so that a reused
+	// request ID with another payload can be told apart from a retry.
+	RequestHash string `json:"request_hash"`
+	// Response is the JSON encoded result of the request. It is nil while
+	// the request is still running.
+	Response []byte `json:"response"`
+	// ExpireTime is when the record is forgotten or, while the request is
+	// running, when another request may take the key over.
+	ExpireTime time.Time `json:"expire_time"`
+}
+
+// Completed reports whether the response of the request has been stored.
+func (r *Record) Completed() bool {
+	return r.Response != nil
+}
@@ -0,0 +1,67 @@
+package labelmodels
+
+import (
+	"strings"
+	"time"
+
+	tasksv1 "github.com/10Narratives/ready-to-do/contracts/gen/go/proto/tasks/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Label fields that can be changed by an update.
+const (
+	DisplayNameField = "display_name"
+	ColorField       = "color"
+)
+
+// UpdatableLabelFields lists the fields an update mask of "*" expands to.
+var UpdatableLabelFields = []string{DisplayNameField, ColorField}
+
+type Label struct {
+	Name        string    `json:"name"`
+	DisplayName string    `json:"display_name"`
+	Color       string    `json:"color"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	// Owner is the name of the user the label belongs to. Labels without an
+	// owner are shared with every user.
+	Owner string `json:"owner"`
+}
+
+// LabelFromGRPC converts the client-settable fields of a label. Output only
+// fields are left zero.
+func LabelFromGRPC(src *tasksv1.Label) *Label {
+	if src == nil {
+		return nil
+	}
+
+	return &Label{
+		Name:        src.GetName(),
+		DisplayName: src.GetDisplayName(),
+		Color:       src.GetColor(),
+	}
+}
+
+func LabelToGRPC(src *Label) *tasksv1.Label {
+	if src == nil {
+		return nil
+	}
+
+	return &tasksv1.Label{
+		Name:        src.Name,
+		DisplayName: src.DisplayName,
+		Color:       src.Color,
+		CreatedAt:   timestamppb.New(src.CreatedAt),
+		UpdatedAt:   timestamppb.New(src.UpdatedAt),
+	}
+}
+
+// LabelIDs returns the label IDs of labels/{label} resource names. Filters
+// refer to assigned labels by their IDs.
+func LabelIDs(names []string) []string {
+	ids := make([]string, 0, len(names))
+	for _, name := range names {
+		ids = append(ids, strings.TrimPrefix(name, "labels/"))
+	}
+	return ids
+}
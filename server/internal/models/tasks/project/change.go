@@ -0,0 +1,65 @@
+package projectmodels
+
+import (
+	"time"
+
+	tasksv1 "github.com/10Narratives/ready-to-do/contracts/gen/go/proto/tasks/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ChangeType tells what happened to a project. The values are stored in the
+// kind column of the project_changes table.
+type ChangeType int
+
+const (
+	UnspecifiedChangeType ChangeType = iota
+	CreatedChangeType
+	UpdatedChangeType
+	DeletedChangeType
+	// HeartbeatChangeType marks changes that only carry a change token.
+	HeartbeatChangeType
+)
+
+func ChangeTypeToGRPC(src ChangeType) tasksv1.WatchProjectsResponse_ChangeType {
+	switch src {
+	case CreatedChangeType:
+		return tasksv1.WatchProjectsResponse_CREATED
+	case UpdatedChangeType:
+		return tasksv1.WatchProjectsResponse_UPDATED
+	case DeletedChangeType:
+		return tasksv1.WatchProjectsResponse_DELETED
+	case HeartbeatChangeType:
+		return tasksv1.WatchProjectsResponse_HEARTBEAT
+	default:
+		return tasksv1.WatchProjectsResponse_CHANGE_TYPE_UNSPECIFIED
+	}
+}
+
+// ProjectChange is an entry of the project change log.
+type ProjectChange struct {
+	// Seq orders the changes by commit. Sequence numbers have no gaps.
+	Seq  int64      `json:"seq"`
+	Type ChangeType `json:"type"`
+	// Name is the name of the changed project.
+	Name string `json:"name"`
+	// Project is the project as the change committed it, nil if it was
+	// purged.
+	Project *Project  `json:"project"`
+	Time    time.Time `json:"time"`
+	// Token resumes watching after this change.
+	Token string `json:"token"`
+}
+
+func ProjectChangeToGRPC(src *ProjectChange) *tasksv1.WatchProjectsResponse {
+	if src == nil {
+		return nil
+	}
+
+	return &tasksv1.WatchProjectsResponse{
+		ChangeType:  ChangeTypeToGRPC(src.Type),
+		Name:        src.Name,
+		Project:     ProjectToGRPC(src.Project),
+		ChangeToken: src.Token,
+		ChangeTime:  timestamppb.New(src.Time),
+	}
+}
@@ -0,0 +1,73 @@
+package projectmodels
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ColorPalette maps the named colors a color tag may be given as to their
+// canonical form. The color_tag pattern in project_service.proto and the
+// 0006_normalize_project_color_tag migration list the same names.
+var ColorPalette = map[string]string{
+	"red":    "#ef4444",
+	"orange": "#f97316",
+	"yellow": "#eab308",
+	"green":  "#22c55e",
+	"teal":   "#14b8a6",
+	"blue":   "#3b82f6",
+	"purple": "#a855f7",
+	"pink":   "#ec4899",
+	"brown":  "#92400e",
+	"gray":   "#6b7280",
+}
+
+// NormalizeColor converts a color tag to the canonical lower-case #rrggbb
+// form. It accepts #RGB, #RRGGBB and #RRGGBBAA hex colors, whose alpha is
+// dropped, and the names of ColorPalette, in any case. An empty tag means no
+// color and is kept empty.
+func NormalizeColor(tag string) (string, error) {
+	if tag == "" {
+		return "", nil
+	}
+
+	lower := strings.ToLower(tag)
+	if hex, ok := ColorPalette[lower]; ok {
+		return hex, nil
+	}
+
+	digits, ok := strings.CutPrefix(lower, "#")
+	if !ok || !isHex(digits) {
+		return "", fmt.Errorf("color %q is neither a hex color nor one of %s", tag, paletteNames())
+	}
+
+	switch len(digits) {
+	case 3:
+		return "#" + strings.Repeat(digits[0:1], 2) + strings.Repeat(digits[1:2], 2) + strings.Repeat(digits[2:3], 2), nil
+	case 6:
+		return "#" + digits, nil
+	case 8:
+		return "#" + digits[:6], nil
+	default:
+		return "", fmt.Errorf("color %q must have 3, 6 or 8 hex digits", tag)
+	}
+}
+
+func isHex(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+func paletteNames() string {
+	names := make([]string, 0, len(ColorPalette))
+	for name := range ColorPalette {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,45 @@
+package projectmodels_test
+
+import (
+	"testing"
+
+	projectmodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/project"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeColor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		tag     string
+		want    string
+		wantErr bool
+	}{
+		{name: "empty", tag: "", want: ""},
+		{name: "short hex", tag: "#F00", want: "#ff0000"},
+		{name: "hex", tag: "#3B82F6", want: "#3b82f6"},
+		{name: "hex with alpha", tag: "#ff0000ff", want: "#ff0000"},
+		{name: "named", tag: "red", want: "#ef4444"},
+		{name: "named in upper case", tag: "Blue", want: "#3b82f6"},
+		{name: "unknown name", tag: "crimson", wantErr: true},
+		{name: "missing hash", tag: "ff0000", wantErr: true},
+		{name: "wrong length", tag: "#ff00", wantErr: true},
+		{name: "invalid digit", tag: "#gg0000", wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := projectmodels.NormalizeColor(tt.tag)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
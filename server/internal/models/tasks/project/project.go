@@ -3,9 +3,13 @@ package projectmodels
 import (
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	tasksv1 "github.com/10Narratives/ready-to-do/contracts/gen/go/proto/tasks/v1"
+	"github.com/10Narratives/ready-to-do/server/internal/filter"
+	labelmodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/label"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -44,6 +48,35 @@ func ProjectStateToGRPC(src ProjectState) tasksv1.Project_State {
 	}
 }
 
+// CanTransitionTo reports whether a project in state s may be moved to state
+// next by an update. Deleting and undeleting have dedicated methods, so
+// DELETED can be neither entered nor left here.
+func (s ProjectState) CanTransitionTo(next ProjectState) bool {
+	if s == next {
+		return true
+	}
+	switch s {
+	case ActiveProjectState:
+		return next == ArchivedProjectState
+	case ArchivedProjectState:
+		return next == ActiveProjectState
+	default:
+		return false
+	}
+}
+
+// Project fields that can be changed by an update.
+const (
+	DisplayNameField = "display_name"
+	DescriptionField = "description"
+	ColorTagField    = "color_tag"
+	StateField       = "state"
+	LabelsField      = "labels"
+)
+
+// UpdatableProjectFields lists the fields an update mask of "*" expands to.
+var UpdatableProjectFields = []string{DisplayNameField, DescriptionField, ColorTagField, StateField, LabelsField}
+
 type Project struct {
 	Name        string       `json:"name"`
 	DisplayName string       `json:"display_name"`
@@ -52,6 +85,40 @@ type Project struct {
 	CreatedAt   time.Time    `json:"created_at"`
 	UpdatedAt   time.Time    `json:"updated_at"`
 	State       ProjectState `json:"state"`
+	// DeleteTime and PurgeTime are zero unless the project is DELETED.
+	DeleteTime time.Time `json:"delete_time"`
+	PurgeTime  time.Time `json:"purge_time"`
+	// Position is the rank of the project in the manual order, see package rank.
+	Position string `json:"position"`
+	// Labels are the names of the labels assigned to the project.
+	Labels []string `json:"labels"`
+	// Version is incremented by every change to the stored project. Zero
+	// means the project has not been stored yet.
+	Version int64 `json:"version"`
+	// Owner is the name of the user the project belongs to. Projects without
+	// an owner are shared with every user.
+	Owner string `json:"owner"`
+}
+
+// Etag returns the entity tag of the project: its version as a strong RFC
+// 7232 validator, e.g. "3" in quotes. Projects that were not stored yet have
+// no etag.
+func (p *Project) Etag() string {
+	if p.Version == 0 {
+		return ""
+	}
+	return strconv.Quote(strconv.FormatInt(p.Version, 10))
+}
+
+// MatchesEtag reports whether etag identifies the current version of the
+// project. Weak validators and unquoted tags are accepted as well, so values
+// copied from HTTP headers compare as expected.
+func (p *Project) MatchesEtag(etag string) bool {
+	etag = strings.TrimPrefix(etag, "W/")
+	if unquoted, err := strconv.Unquote(etag); err == nil {
+		etag = unquoted
+	}
+	return p.Version != 0 && etag == strconv.FormatInt(p.Version, 10)
 }
 
 func ProjectFromGRPC(src *tasksv1.Project) (*Project, error) {
@@ -72,6 +139,10 @@ func ProjectFromGRPC(src *tasksv1.Project) (*Project, error) {
 		CreatedAt:   src.GetCreatedAt().AsTime(),
 		UpdatedAt:   src.GetUpdatedAt().AsTime(),
 		State:       state,
+		DeleteTime:  timeFromGRPC(src.GetDeleteTime()),
+		PurgeTime:   timeFromGRPC(src.GetPurgeTime()),
+		Position:    src.GetPosition(),
+		Labels:      src.GetLabels(),
 	}, nil
 }
 
@@ -88,5 +159,131 @@ func ProjectToGRPC(src *Project) *tasksv1.Project {
 		CreatedAt:   timestamppb.New(src.CreatedAt),
 		UpdatedAt:   timestamppb.New(src.UpdatedAt),
 		State:       ProjectStateToGRPC(src.State),
+		DeleteTime:  timeToGRPC(src.DeleteTime),
+		PurgeTime:   timeToGRPC(src.PurgeTime),
+		Position:    src.Position,
+		Labels:      src.Labels,
+		Etag:        src.Etag(),
+		Owner:       src.Owner,
+	}
+}
+
+// timeFromGRPC and timeToGRPC map unset timestamps to zero times and back.
+func timeFromGRPC(src *timestamppb.Timestamp) time.Time {
+	if src == nil {
+		return time.Time{}
+	}
+	return src.AsTime()
+}
+
+func timeToGRPC(src time.Time) *timestamppb.Timestamp {
+	if src.IsZero() {
+		return nil
+	}
+	return timestamppb.New(src)
+}
+
+// ProjectOrderField is a column projects can be listed by.
+type ProjectOrderField string
+
+const (
+	OrderByCreatedAt   ProjectOrderField = "created_at"
+	OrderByUpdatedAt   ProjectOrderField = "updated_at"
+	OrderByDisplayName ProjectOrderField = "display_name"
+	OrderByState       ProjectOrderField = "state"
+	OrderByPosition    ProjectOrderField = "position"
+)
+
+// ProjectOrder describes how a project listing is sorted.
+type ProjectOrder struct {
+	Field ProjectOrderField
+	Desc  bool
+}
+
+// ParseProjectOrder parses an order_by value such as "display_name desc".
+// An empty value selects ascending creation time.
+func ParseProjectOrder(src string) (ProjectOrder, error) {
+	fields := strings.Fields(src)
+	if len(fields) == 0 {
+		return ProjectOrder{Field: OrderByCreatedAt}, nil
+	}
+	if len(fields) > 2 {
+		return ProjectOrder{}, fmt.Errorf("cannot parse order %q: too many terms", src)
 	}
+
+	order := ProjectOrder{Field: ProjectOrderField(fields[0])}
+	switch order.Field {
+	case OrderByCreatedAt, OrderByUpdatedAt, OrderByDisplayName, OrderByState, OrderByPosition:
+	default:
+		return ProjectOrder{}, fmt.Errorf("cannot parse order %q: unsupported field %q", src, fields[0])
+	}
+
+	if len(fields) == 2 {
+		switch fields[1] {
+		case "asc":
+		case "desc":
+			order.Desc = true
+		default:
+			return ProjectOrder{}, fmt.Errorf("cannot parse order %q: unsupported direction %q", src, fields[1])
+		}
+	}
+
+	return order, nil
+}
+
+func (o ProjectOrder) String() string {
+	if o.Desc {
+		return string(o.Field) + " desc"
+	}
+	return string(o.Field)
+}
+
+// ProjectFilterSchema lists the project fields ListProjects filters may use.
+// Columns match the projects table.
+var ProjectFilterSchema = filter.Schema[*Project]{
+	"name": {
+		Type:   filter.String,
+		Column: "name",
+		Get:    func(p *Project) any { return p.Name },
+	},
+	"display_name": {
+		Type:   filter.String,
+		Column: "COALESCE(display_name, '')",
+		Get:    func(p *Project) any { return p.DisplayName },
+	},
+	"description": {
+		Type:   filter.String,
+		Column: "COALESCE(description, '')",
+		Get:    func(p *Project) any { return p.Description },
+	},
+	"color_tag": {
+		Type:   filter.String,
+		Column: "COALESCE(color_tag, '')",
+		Get:    func(p *Project) any { return p.ColorTag },
+	},
+	"created_at": {
+		Type:   filter.Timestamp,
+		Column: "created_at",
+		Get:    func(p *Project) any { return p.CreatedAt },
+	},
+	"updated_at": {
+		Type:   filter.Timestamp,
+		Column: "updated_at",
+		Get:    func(p *Project) any { return p.UpdatedAt },
+	},
+	"state": {
+		Type:   filter.Enum,
+		Column: "COALESCE(state, 0)",
+		Values: map[string]int{
+			"ACTIVE":   int(ActiveProjectState),
+			"ARCHIVED": int(ArchivedProjectState),
+			"DELETED":  int(DeletedprojectState),
+		},
+		Get: func(p *Project) any { return int(p.State) },
+	},
+	"labels": {
+		Type:   filter.StringList,
+		Column: "ARRAY(SELECT split_part(label, '/', 2) FROM project_labels WHERE project_labels.project = projects.name)",
+		Get:    func(p *Project) any { return labelmodels.LabelIDs(p.Labels) },
+	},
 }
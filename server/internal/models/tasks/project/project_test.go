@@ -0,0 +1,42 @@
+package projectmodels_test
+
+import (
+	"testing"
+
+	projectmodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/project"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProject_Etag(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, `"3"`, (&projectmodels.Project{Version: 3}).Etag())
+	assert.Empty(t, (&projectmodels.Project{}).Etag())
+}
+
+func TestProject_MatchesEtag(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		version int64
+		etag    string
+		want    bool
+	}{
+		{name: "strong", version: 3, etag: `"3"`, want: true},
+		{name: "weak", version: 3, etag: `W/"3"`, want: true},
+		{name: "unquoted", version: 3, etag: "3", want: true},
+		{name: "other version", version: 4, etag: `"3"`},
+		{name: "garbage", version: 3, etag: `"3`},
+		{name: "unstored project", etag: `"0"`},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			project := &projectmodels.Project{Version: tt.version}
+			assert.Equal(t, tt.want, project.MatchesEtag(tt.etag))
+		})
+	}
+}
@@ -0,0 +1,304 @@
+package taskmodels
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	tasksv1 "github.com/10Narratives/ready-to-do/contracts/gen/go/proto/tasks/v1"
+	"github.com/10Narratives/ready-to-do/server/internal/filter"
+	labelmodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/label"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type TaskPriority int
+
+const (
+	UnspecifiedTaskPriority TaskPriority = iota
+	LowTaskPriority
+	MediumTaskPriority
+	HighTaskPriority
+	UrgentTaskPriority
+)
+
+func TaskPriorityFromGRPC(src tasksv1.Task_Priority) (TaskPriority, error) {
+	switch src {
+	case tasksv1.Task_PRIORITY_UNSPECIFIED:
+		return UnspecifiedTaskPriority, nil
+	case tasksv1.Task_LOW:
+		return LowTaskPriority, nil
+	case tasksv1.Task_MEDIUM:
+		return MediumTaskPriority, nil
+	case tasksv1.Task_HIGH:
+		return HighTaskPriority, nil
+	case tasksv1.Task_URGENT:
+		return UrgentTaskPriority, nil
+	default:
+		return UnspecifiedTaskPriority, errors.New("unsupported task priority")
+	}
+}
+
+func TaskPriorityToGRPC(src TaskPriority) tasksv1.Task_Priority {
+	switch src {
+	case LowTaskPriority:
+		return tasksv1.Task_LOW
+	case MediumTaskPriority:
+		return tasksv1.Task_MEDIUM
+	case HighTaskPriority:
+		return tasksv1.Task_HIGH
+	case UrgentTaskPriority:
+		return tasksv1.Task_URGENT
+	default:
+		return tasksv1.Task_PRIORITY_UNSPECIFIED
+	}
+}
+
+type TaskState int
+
+const (
+	UnspecifiedTaskState TaskState = iota
+	OpenTaskState
+	CompletedTaskState
+)
+
+func TaskStateToGRPC(src TaskState) tasksv1.Task_State {
+	switch src {
+	case OpenTaskState:
+		return tasksv1.Task_OPEN
+	case CompletedTaskState:
+		return tasksv1.Task_COMPLETED
+	default:
+		return tasksv1.Task_STATE_UNSPECIFIED
+	}
+}
+
+// Task fields that can be changed by an update.
+const (
+	TitleField       = "title"
+	NotesField       = "notes"
+	DueTimeField     = "due_time"
+	DueTimeZoneField = "due_time_zone"
+	PriorityField    = "priority"
+	LabelsField      = "labels"
+)
+
+// UpdatableTaskFields lists the fields an update mask of "*" expands to.
+var UpdatableTaskFields = []string{TitleField, NotesField, DueTimeField, DueTimeZoneField, PriorityField, LabelsField}
+
+type Task struct {
+	Name  string `json:"name"`
+	Title string `json:"title"`
+	Notes string `json:"notes"`
+	// DueTime is zero for tasks without a due time.
+	DueTime     time.Time    `json:"due_time"`
+	DueTimeZone string       `json:"due_time_zone"`
+	Priority    TaskPriority `json:"priority"`
+	State       TaskState    `json:"state"`
+	// CompletedAt is zero unless the task is COMPLETED.
+	CompletedAt time.Time `json:"completed_at"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	// ParentTask is the name of the parent task, empty for top-level tasks.
+	ParentTask string `json:"parent_task"`
+	// Depth is the number of ancestors of the task.
+	Depth int `json:"depth"`
+	// Path is the tree sort key of the task: the keys of its ancestors from
+	// the top level down, followed by its own. Set by storage.
+	Path []string `json:"-"`
+	// Labels are the names of the labels assigned to the task.
+	Labels []string `json:"labels"`
+}
+
+// TaskFromGRPC converts the client-settable fields of a task. Output only
+// fields are left zero.
+func TaskFromGRPC(src *tasksv1.Task) (*Task, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	priority, err := TaskPriorityFromGRPC(src.GetPriority())
+	if err != nil {
+		return nil, fmt.Errorf("cannot convert task model from grpc: %v", err)
+	}
+
+	if zone := src.GetDueTimeZone(); zone != "" {
+		if _, err := time.LoadLocation(zone); err != nil {
+			return nil, fmt.Errorf("cannot convert task model from grpc: unknown time zone %q", zone)
+		}
+	}
+
+	return &Task{
+		Name:        src.GetName(),
+		Title:       src.GetTitle(),
+		Notes:       src.GetNotes(),
+		DueTime:     timeFromGRPC(src.GetDueTime()),
+		DueTimeZone: src.GetDueTimeZone(),
+		Priority:    priority,
+		ParentTask:  src.GetParentTask(),
+		Labels:      src.GetLabels(),
+	}, nil
+}
+
+func TaskToGRPC(src *Task) *tasksv1.Task {
+	if src == nil {
+		return nil
+	}
+
+	return &tasksv1.Task{
+		Name:        src.Name,
+		Title:       src.Title,
+		Notes:       src.Notes,
+		DueTime:     timeToGRPC(src.DueTime),
+		DueTimeZone: src.DueTimeZone,
+		Priority:    TaskPriorityToGRPC(src.Priority),
+		State:       TaskStateToGRPC(src.State),
+		CompletedAt: timeToGRPC(src.CompletedAt),
+		CreatedAt:   timestamppb.New(src.CreatedAt),
+		UpdatedAt:   timestamppb.New(src.UpdatedAt),
+		ParentTask:  src.ParentTask,
+		Depth:       int32(src.Depth),
+		Labels:      src.Labels,
+	}
+}
+
+// timeFromGRPC and timeToGRPC map unset timestamps to zero times and back.
+func timeFromGRPC(src *timestamppb.Timestamp) time.Time {
+	if src == nil {
+		return time.Time{}
+	}
+	return src.AsTime()
+}
+
+func timeToGRPC(src time.Time) *timestamppb.Timestamp {
+	if src.IsZero() {
+		return nil
+	}
+	return timestamppb.New(src)
+}
+
+// TaskOrderField is a column tasks can be listed by.
+type TaskOrderField string
+
+const (
+	OrderByCreatedAt TaskOrderField = "created_at"
+	OrderByUpdatedAt TaskOrderField = "updated_at"
+	OrderByDueTime   TaskOrderField = "due_time"
+	OrderByPriority  TaskOrderField = "priority"
+	OrderByTitle     TaskOrderField = "title"
+)
+
+// TaskOrder describes how a task listing is sorted.
+type TaskOrder struct {
+	Field TaskOrderField
+	Desc  bool
+}
+
+// ParseTaskOrder parses an order_by value such as "due_time desc".
+// An empty value selects ascending creation time.
+func ParseTaskOrder(src string) (TaskOrder, error) {
+	fields := strings.Fields(src)
+	if len(fields) == 0 {
+		return TaskOrder{Field: OrderByCreatedAt}, nil
+	}
+	if len(fields) > 2 {
+		return TaskOrder{}, fmt.Errorf("cannot parse order %q: too many terms", src)
+	}
+
+	order := TaskOrder{Field: TaskOrderField(fields[0])}
+	switch order.Field {
+	case OrderByCreatedAt, OrderByUpdatedAt, OrderByDueTime, OrderByPriority, OrderByTitle:
+	default:
+		return TaskOrder{}, fmt.Errorf("cannot parse order %q: unsupported field %q", src, fields[0])
+	}
+
+	if len(fields) == 2 {
+		switch fields[1] {
+		case "asc":
+		case "desc":
+			order.Desc = true
+		default:
+			return TaskOrder{}, fmt.Errorf("cannot parse order %q: unsupported direction %q", src, fields[1])
+		}
+	}
+
+	return order, nil
+}
+
+func (o TaskOrder) String() string {
+	if o.Desc {
+		return string(o.Field) + " desc"
+	}
+	return string(o.Field)
+}
+
+// TaskFilterSchema lists the task fields ListTasks filters may use.
+// Columns match the tasks table.
+var TaskFilterSchema = filter.Schema[*Task]{
+	"title": {
+		Type:   filter.String,
+		Column: "title",
+		Get:    func(t *Task) any { return t.Title },
+	},
+	"notes": {
+		Type:   filter.String,
+		Column: "notes",
+		Get:    func(t *Task) any { return t.Notes },
+	},
+	"priority": {
+		Type:   filter.Enum,
+		Column: "priority",
+		Values: map[string]int{
+			"PRIORITY_UNSPECIFIED": int(UnspecifiedTaskPriority),
+			"LOW":                  int(LowTaskPriority),
+			"MEDIUM":               int(MediumTaskPriority),
+			"HIGH":                 int(HighTaskPriority),
+			"URGENT":               int(UrgentTaskPriority),
+		},
+		Get: func(t *Task) any { return int(t.Priority) },
+	},
+	"state": {
+		Type:   filter.Enum,
+		Column: "state",
+		Values: map[string]int{
+			"OPEN":      int(OpenTaskState),
+			"COMPLETED": int(CompletedTaskState),
+		},
+		Get: func(t *Task) any { return int(t.State) },
+	},
+	"due_time": {
+		Type:   filter.Timestamp,
+		Column: "due_time",
+		Get:    func(t *Task) any { return t.DueTime },
+	},
+	"completed_at": {
+		Type:   filter.Timestamp,
+		Column: "completed_at",
+		Get:    func(t *Task) any { return t.CompletedAt },
+	},
+	"created_at": {
+		Type:   filter.Timestamp,
+		Column: "created_at",
+		Get:    func(t *Task) any { return t.CreatedAt },
+	},
+	"updated_at": {
+		Type:   filter.Timestamp,
+		Column: "updated_at",
+		Get:    func(t *Task) any { return t.UpdatedAt },
+	},
+	"parent_task": {
+		Type:   filter.String,
+		Column: "COALESCE(parent, '')",
+		Get:    func(t *Task) any { return t.ParentTask },
+	},
+	"depth": {
+		Type:   filter.Int,
+		Column: "(cardinality(path) - 1)",
+		Get:    func(t *Task) any { return int64(t.Depth) },
+	},
+	"labels": {
+		Type:   filter.StringList,
+		Column: "ARRAY(SELECT split_part(label, '/', 2) FROM task_labels WHERE task_labels.task = tasks.name)",
+		Get:    func(t *Task) any { return labelmodels.LabelIDs(t.Labels) },
+	},
+}
@@ -0,0 +1,36 @@
+package usermodels
+
+import (
+	"time"
+
+	tasksv1 "github.com/10Narratives/ready-to-do/contracts/gen/go/proto/tasks/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// User is an account of callers. Issuer and Subject identify the tokens the
+// user authenticates with; Email and DisplayName follow their latest claims.
+type User struct {
+	Name        string    `json:"name"`
+	Issuer      string    `json:"issuer"`
+	Subject     string    `json:"subject"`
+	Email       string    `json:"email"`
+	DisplayName string    `json:"display_name"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+func UserToGRPC(src *User) *tasksv1.User {
+	if src == nil {
+		return nil
+	}
+
+	return &tasksv1.User{
+		Name:        src.Name,
+		Issuer:      src.Issuer,
+		Subject:     src.Subject,
+		Email:       src.Email,
+		DisplayName: src.DisplayName,
+		CreatedAt:   timestamppb.New(src.CreatedAt),
+		UpdatedAt:   timestamppb.New(src.UpdatedAt),
+	}
+}
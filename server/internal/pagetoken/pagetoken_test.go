@@ -0,0 +1,87 @@
+package pagetoken_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/10Narratives/ready-to-do/server/internal/pagetoken"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type cursor struct {
+	Name string `json:"name"`
+}
+
+func TestCodec(t *testing.T) {
+	t.Parallel()
+
+	codec := pagetoken.New("secret")
+	token, err := codec.Encode("order_by=created_at", cursor{Name: "projects/1"})
+	require.NoError(t, err)
+
+	other, err := codec.Encode("order_by=created_at", cursor{Name: "projects/2"})
+	require.NoError(t, err)
+	otherBody, _, _ := strings.Cut(other, ".")
+	_, signature, _ := strings.Cut(token, ".")
+
+	tests := []struct {
+		name    string
+		codec   *pagetoken.Codec
+		token   string
+		query   string
+		want    cursor
+		wantErr error
+	}{
+		{
+			name:  "round trip",
+			codec: codec,
+			token: token,
+			query: "order_by=created_at",
+			want:  cursor{Name: "projects/1"},
+		},
+		{
+			name:    "different query",
+			codec:   codec,
+			token:   token,
+			query:   "order_by=display_name",
+			wantErr: pagetoken.ErrQueryMismatch,
+		},
+		{
+			name:    "different key",
+			codec:   pagetoken.New("other secret"),
+			token:   token,
+			query:   "order_by=created_at",
+			wantErr: pagetoken.ErrSignature,
+		},
+		{
+			name:    "tampered body",
+			codec:   codec,
+			token:   otherBody + "." + signature,
+			query:   "order_by=created_at",
+			wantErr: pagetoken.ErrSignature,
+		},
+		{
+			name:    "missing signature",
+			codec:   codec,
+			token:   "garbage",
+			query:   "order_by=created_at",
+			wantErr: pagetoken.ErrMalformed,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got cursor
+			err := tt.codec.Decode(tt.token, tt.query, &got)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
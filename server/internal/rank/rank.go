@@ -0,0 +1,183 @@
+// Package rank implements lexicographic fractional indexing for manually
+// ordered resources.
+//
+// A rank is a non-empty string of base-62 digits read as the fraction after
+// the point, so ranks compare like the numbers they encode when compared as
+// byte strings. A rank never ends with the zero digit, which guarantees that
+// there is always another rank between any two ranks. Placing a resource
+// between two others therefore only rewrites the rank of that resource. Ranks
+// grow by about one digit every six insertions at the same spot; Spaced and
+// SpacedBetween produce short, evenly spaced ranks to rebalance them.
+package rank
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Digits are the rank digits in ascending byte order. Databases must compare
+// ranks byte-wise, e.g. with the "C" collation in PostgreSQL.
+const Digits = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+const base = len(Digits)
+
+// ErrOrder is returned by Between when the lower bound is not below the upper bound.
+var ErrOrder = errors.New("lower bound is not below upper bound")
+
+// Between returns a rank strictly between a and b. An empty a means the start
+// of the range and an empty b its end, so Between("", "") yields a first rank.
+func Between(a, b string) (string, error) {
+	if err := Validate(a, true); err != nil {
+		return "", fmt.Errorf("invalid lower bound: %w", err)
+	}
+	if err := Validate(b, true); err != nil {
+		return "", fmt.Errorf("invalid upper bound: %w", err)
+	}
+	if b != "" && a >= b {
+		return "", ErrOrder
+	}
+	return midpoint(a, b), nil
+}
+
+// After returns a rank following a.
+func After(a string) (string, error) {
+	return Between(a, "")
+}
+
+// Before returns a rank preceding b.
+func Before(b string) (string, error) {
+	return Between("", b)
+}
+
+// Validate reports whether rank consists of rank digits and does not end with
+// the zero digit. An empty rank is valid only if allowEmpty is set.
+func Validate(rank string, allowEmpty bool) error {
+	if rank == "" {
+		if allowEmpty {
+			return nil
+		}
+		return errors.New("rank is empty")
+	}
+	for i := 0; i < len(rank); i++ {
+		if strings.IndexByte(Digits, rank[i]) < 0 {
+			return fmt.Errorf("rank %q contains invalid digit %q", rank, rank[i])
+		}
+	}
+	if rank[len(rank)-1] == Digits[0] {
+		return fmt.Errorf("rank %q ends with the zero digit", rank)
+	}
+	return nil
+}
+
+// midpoint returns a rank between a and b, where an empty b is the end of
+// the range. Both must be valid and a must be below b.
+func midpoint(a, b string) string {
+	if b != "" {
+		// Skip the common prefix, reading missing digits of a as zeros.
+		n := 0
+		for n < len(b) && digitAt(a, n) == b[n] {
+			n++
+		}
+		if n > 0 {
+			return b[:n] + midpoint(suffix(a, n), b[n:])
+		}
+	}
+
+	digitA := 0
+	if a != "" {
+		digitA = strings.IndexByte(Digits, a[0])
+	}
+	digitB := base
+	if b != "" {
+		digitB = strings.IndexByte(Digits, b[0])
+	}
+
+	if digitB-digitA > 1 {
+		return string(Digits[(digitA+digitB+1)/2])
+	}
+	// The first digits are adjacent: keep the first digit of b if b goes on,
+	// otherwise keep the first digit of a and find a rank after the rest of a.
+	if len(b) > 1 {
+		return b[:1]
+	}
+	return string(Digits[digitA]) + midpoint(suffix(a, 1), "")
+}
+
+func digitAt(s string, i int) byte {
+	if i < len(s) {
+		return s[i]
+	}
+	return Digits[0]
+}
+
+func suffix(s string, i int) string {
+	if i < len(s) {
+		return s[i:]
+	}
+	return ""
+}
+
+// Spaced returns n ascending ranks spread evenly over the whole range, using
+// as few digits as possible while leaving room for insertions between them.
+func Spaced(n int) []string {
+	if n <= 0 {
+		return nil
+	}
+
+	// One extra digit leaves about base ranks of room around every rank.
+	length := 1
+	for math.Pow(float64(base), float64(length)) <= float64(n+1) {
+		length++
+	}
+	length++
+
+	ranks := make([]string, n)
+	digits := make([]byte, length)
+	step := math.Pow(float64(base), float64(length)) / float64(n+1)
+	for i := range ranks {
+		value := uint64(step * float64(i+1))
+		for j := length - 1; j >= 0; j-- {
+			digits[j] = Digits[value%uint64(base)]
+			value /= uint64(base)
+		}
+		ranks[i] = strings.TrimRight(string(digits), Digits[:1])
+	}
+	return ranks
+}
+
+// SpacedBetween returns n ascending ranks strictly between a and b, spread
+// evenly by halving the range repeatedly. Empty bounds are the ends of the
+// range like in Between; without bounds it is Spaced.
+func SpacedBetween(a, b string, n int) ([]string, error) {
+	if a == "" && b == "" {
+		return Spaced(n), nil
+	}
+	if err := Validate(a, true); err != nil {
+		return nil, fmt.Errorf("invalid lower bound: %w", err)
+	}
+	if err := Validate(b, true); err != nil {
+		return nil, fmt.Errorf("invalid upper bound: %w", err)
+	}
+	if b != "" && a >= b {
+		return nil, ErrOrder
+	}
+	if n <= 0 {
+		return nil, nil
+	}
+	return halve(make([]string, 0, n), a, b, n), nil
+}
+
+// halve appends n ranks between a and b to ranks: the midpoint with half of
+// the others on either side.
+func halve(ranks []string, a, b string, n int) []string {
+	if n == 0 {
+		return ranks
+	}
+	mid := midpoint(a, b)
+	below := (n - 1) / 2
+	ranks = halve(ranks, a, mid, below)
+	ranks = append(ranks, mid)
+	return halve(ranks, mid, b, n-1-below)
+}
@@ -0,0 +1,150 @@
+package rank_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/10Narratives/ready-to-do/server/internal/rank"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBetween(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		a, b    string
+		want    string
+		wantErr bool
+	}{
+		{name: "empty range", want: "V"},
+		{name: "after", a: "V", want: "l"},
+		{name: "before", b: "V", want: "G"},
+		{name: "adjacent digits", a: "V", b: "W", want: "VV"},
+		{name: "common prefix", a: "Va", b: "Vc", want: "Vb"},
+		{name: "longer upper bound", a: "V", b: "W1", want: "W"},
+		{name: "shorter lower bound", a: "V", b: "V1", want: "V0V"},
+		{name: "after the last digit", a: "z", want: "zV"},
+		{name: "equal bounds", a: "V", b: "V", wantErr: true},
+		{name: "reversed bounds", a: "W", b: "V", wantErr: true},
+		{name: "trailing zero", a: "V0", wantErr: true},
+		{name: "invalid digit", a: "V-", wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := rank.Between(tt.a, tt.b)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Greater(t, got, tt.a)
+			if tt.b != "" {
+				assert.Less(t, got, tt.b)
+			}
+		})
+	}
+}
+
+func TestBetween_Repeated(t *testing.T) {
+	t.Parallel()
+
+	// Inserting at the same spot over and over must keep producing valid,
+	// strictly ordered ranks.
+	lower, upper := "V", "W"
+	for i := 0; i < 200; i++ {
+		mid, err := rank.Between(lower, upper)
+		require.NoError(t, err)
+		require.NoError(t, rank.Validate(mid, false))
+		require.Less(t, lower, mid)
+		require.Less(t, mid, upper)
+		if i%2 == 0 {
+			upper = mid
+		} else {
+			lower = mid
+		}
+	}
+
+	first := "V"
+	for i := 0; i < 200; i++ {
+		before, err := rank.Before(first)
+		require.NoError(t, err)
+		require.Less(t, before, first)
+		first = before
+	}
+}
+
+func TestSpaced(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{1, 2, 61, 62, 1000, 5000} {
+		ranks := rank.Spaced(n)
+		require.Len(t, ranks, n)
+		assert.True(t, sort.StringsAreSorted(ranks))
+		for i, r := range ranks {
+			require.NoError(t, rank.Validate(r, false))
+			if i > 0 {
+				require.NotEqual(t, ranks[i-1], r)
+			}
+		}
+	}
+
+	assert.Nil(t, rank.Spaced(0))
+}
+
+func TestSpacedBetween(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		a, b    string
+		n       int
+		wantErr bool
+	}{
+		{name: "whole range", n: 100},
+		{name: "after", a: "zzzzzz", n: 100},
+		{name: "before", b: "0001", n: 100},
+		{name: "between", a: "V", b: "W", n: 1000},
+		{name: "long bounds", a: "VVVVVVVVVVVVVVVVVVVV", b: "VVVVVVVVVVVVVVVVVVVW", n: 10},
+		{name: "none", a: "V", b: "W"},
+		{name: "equal bounds", a: "V", b: "V", n: 1, wantErr: true},
+		{name: "invalid bound", a: "V0", n: 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ranks, err := rank.SpacedBetween(tt.a, tt.b, tt.n)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, ranks, tt.n)
+			for i, r := range ranks {
+				require.NoError(t, rank.Validate(r, false))
+				require.Greater(t, r, tt.a)
+				if tt.b != "" {
+					require.Less(t, r, tt.b)
+				}
+				if i > 0 {
+					require.Less(t, ranks[i-1], r)
+				}
+			}
+		})
+	}
+
+	// Halving keeps ranks short: 1000 ranks between neighbouring digits need
+	// no more than a few extra digits.
+	ranks, err := rank.SpacedBetween("V", "W", 1000)
+	require.NoError(t, err)
+	for _, r := range ranks {
+		assert.LessOrEqual(t, len(r), 4)
+	}
+}
@@ -0,0 +1,251 @@
+// Package resourcename formats, parses and validates resource names.
+//
+// Patterns are taken from the google.api.resource options of the API
+// messages, e.g. "projects/{project}/tasks/{task}", so names handled here
+// cannot drift from the contract. Every variable of a pattern comes with a
+// rule its IDs must satisfy.
+package resourcename
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	tasksv1 "github.com/10Narratives/ready-to-do/contracts/gen/go/proto/tasks/v1"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+)
+
+// IDRule restricts the IDs a pattern variable accepts.
+type IDRule struct {
+	re *regexp.Regexp
+	// description completes "project id "x" is not ...".
+	description string
+	// canonical maps an ID to its canonical form, nil if IDs are canonical
+	// as they are.
+	canonical func(string) string
+}
+
+func (r IDRule) canonicalize(id string) string {
+	if r.canonical == nil {
+		return id
+	}
+	return r.canonical(id)
+}
+
+var (
+	// UUID accepts UUIDs in any case; their canonical form is lower-case.
+	UUID = IDRule{
+		re:          regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`),
+		description: "a valid UUID",
+		canonical:   strings.ToLower,
+	}
+	// Slug accepts RFC 1034 labels: lower-case letters, digits and hyphens,
+	// starting with a letter, at most 63 characters.
+	Slug = IDRule{
+		re:          regexp.MustCompile(`^[a-z]([a-z0-9-]{0,61}[a-z0-9])?$`),
+		description: "a valid ID: lower-case letters, digits and hyphens, starting with a letter",
+	}
+)
+
+// Resource patterns of the API.
+var (
+	Project = MustForMessage(&tasksv1.Project{}, UUID)
+	Task    = MustForMessage(&tasksv1.Task{}, UUID, UUID)
+	Label   = MustForMessage(&tasksv1.Label{}, Slug)
+	User    = MustForMessage(&tasksv1.User{}, UUID)
+)
+
+// Pattern is a compiled resource name pattern.
+type Pattern struct {
+	pattern string
+	// resourceType is the google.api.resource type, empty for patterns that
+	// were not read from a message.
+	resourceType string
+	// segments holds the literal segments of the pattern with the variables
+	// left empty.
+	segments  []string
+	variables []variable
+}
+
+type variable struct {
+	name string
+	// segment is the index of the variable in segments.
+	segment int
+	rule    IDRule
+}
+
+// Compile compiles a pattern such as "projects/{project}/tasks/{task}". Rules
+// apply to the variables in order; there must be one per variable.
+func Compile(pattern string, rules ...IDRule) (*Pattern, error) {
+	p := &Pattern{
+		pattern:  pattern,
+		segments: strings.Split(pattern, "/"),
+	}
+	for i, segment := range p.segments {
+		if name, ok := strings.CutPrefix(segment, "{"); ok {
+			name, ok = strings.CutSuffix(name, "}")
+			if !ok || name == "" {
+				return nil, fmt.Errorf("cannot compile pattern %q: malformed variable %q", pattern, segment)
+			}
+			if len(p.variables) == len(rules) {
+				return nil, fmt.Errorf("cannot compile pattern %q: no rule for variable %q", pattern, name)
+			}
+			p.variables = append(p.variables, variable{name: name, segment: i, rule: rules[len(p.variables)]})
+			p.segments[i] = ""
+			continue
+		}
+		if segment == "" || strings.ContainsAny(segment, "{}") {
+			return nil, fmt.Errorf("cannot compile pattern %q: malformed segment %q", pattern, segment)
+		}
+	}
+	if len(rules) != len(p.variables) {
+		return nil, fmt.Errorf("cannot compile pattern %q: %d rules for %d variables", pattern, len(rules), len(p.variables))
+	}
+	return p, nil
+}
+
+// ForMessage compiles the google.api.resource pattern of message.
+func ForMessage(message proto.Message, rules ...IDRule) (*Pattern, error) {
+	descriptor := message.ProtoReflect().Descriptor()
+	resource, ok := proto.GetExtension(descriptor.Options(), annotations.E_Resource).(*annotations.ResourceDescriptor)
+	if !ok || resource == nil || len(resource.GetPattern()) == 0 {
+		return nil, fmt.Errorf("message %s has no resource pattern", descriptor.FullName())
+	}
+	p, err := Compile(resource.GetPattern()[0], rules...)
+	if err != nil {
+		return nil, err
+	}
+	p.resourceType = resource.GetType()
+	return p, nil
+}
+
+// MustForMessage is like ForMessage but panics on errors.
+func MustForMessage(message proto.Message, rules ...IDRule) *Pattern {
+	p, err := ForMessage(message, rules...)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// String returns the pattern in its source form.
+func (p *Pattern) String() string {
+	return p.pattern
+}
+
+// Type returns the resource type, e.g. "tasks.readytogo.com/Project", or an
+// empty string if the pattern was not read from a message.
+func (p *Pattern) Type() string {
+	return p.resourceType
+}
+
+// Format builds the name with the given IDs, one per variable. IDs are put in
+// their canonical form but not validated.
+func (p *Pattern) Format(ids ...string) string {
+	if len(ids) != len(p.variables) {
+		panic(fmt.Sprintf("pattern %s needs %d ids, got %d", p.pattern, len(p.variables), len(ids)))
+	}
+
+	segments := make([]string, len(p.segments))
+	copy(segments, p.segments)
+	for i, v := range p.variables {
+		segments[v.segment] = v.rule.canonicalize(ids[i])
+	}
+	return strings.Join(segments, "/")
+}
+
+// Parse returns the IDs of name in their canonical form, one per variable,
+// and an error if name does not match the pattern or any ID breaks the rule
+// of its variable. Names that differ only in the case of their UUIDs thus
+// parse to the same IDs.
+func (p *Pattern) Parse(name string) ([]string, error) {
+	segments := strings.Split(name, "/")
+	if len(segments) != len(p.segments) {
+		return nil, p.mismatch(name)
+	}
+	for i, literal := range p.segments {
+		if literal != "" && segments[i] != literal {
+			return nil, p.mismatch(name)
+		}
+	}
+
+	ids := make([]string, 0, len(p.variables))
+	for _, v := range p.variables {
+		id := segments[v.segment]
+		if id == "" {
+			return nil, p.mismatch(name)
+		}
+		if !v.rule.re.MatchString(id) {
+			return nil, fmt.Errorf("%s id %q in resource name %q is not %s", v.name, id, name, v.rule.description)
+		}
+		ids = append(ids, v.rule.canonicalize(id))
+	}
+	return ids, nil
+}
+
+// Validate returns the error Parse would return for name.
+func (p *Pattern) Validate(name string) error {
+	_, err := p.Parse(name)
+	return err
+}
+
+func (p *Pattern) mismatch(name string) error {
+	return fmt.Errorf("resource name %q does not match pattern %s", name, p.pattern)
+}
+
+// ProjectName returns the name of a project.
+func ProjectName(projectID string) string {
+	return Project.Format(projectID)
+}
+
+// ParseProjectName returns the project ID of a project name.
+func ParseProjectName(name string) (string, error) {
+	ids, err := Project.Parse(name)
+	if err != nil {
+		return "", err
+	}
+	return ids[0], nil
+}
+
+// TaskName returns the name of a task.
+func TaskName(projectID, taskID string) string {
+	return Task.Format(projectID, taskID)
+}
+
+// ParseTaskName returns the project and task IDs of a task name.
+func ParseTaskName(name string) (string, string, error) {
+	ids, err := Task.Parse(name)
+	if err != nil {
+		return "", "", err
+	}
+	return ids[0], ids[1], nil
+}
+
+// LabelName returns the name of a label.
+func LabelName(labelID string) string {
+	return Label.Format(labelID)
+}
+
+// ParseLabelName returns the label ID of a label name.
+func ParseLabelName(name string) (string, error) {
+	ids, err := Label.Parse(name)
+	if err != nil {
+		return "", err
+	}
+	return ids[0], nil
+}
+
+// UserName returns the name of a user.
+func UserName(userID string) string {
+	return User.Format(userID)
+}
+
+// ParseUserName returns the user ID of a user name.
+func ParseUserName(name string) (string, error) {
+	ids, err := User.Parse(name)
+	if err != nil {
+		return "", err
+	}
+	return ids[0], nil
+}
@@ -0,0 +1,154 @@
+package resourcename_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/10Narratives/ready-to-do/server/internal/resourcename"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	projectID = "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"
+	taskID    = "b1ffcd88-8d1a-4ef8-bb6d-6bb9bd380a22"
+)
+
+func TestCompile(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		pattern string
+		rules   []resourcename.IDRule
+		wantErr bool
+	}{
+		{name: "nested", pattern: "projects/{project}/tasks/{task}", rules: []resourcename.IDRule{resourcename.UUID, resourcename.UUID}},
+		{name: "singleton", pattern: "settings", rules: nil},
+		{name: "missing rule", pattern: "projects/{project}", rules: nil, wantErr: true},
+		{name: "extra rule", pattern: "projects/{project}", rules: []resourcename.IDRule{resourcename.UUID, resourcename.UUID}, wantErr: true},
+		{name: "unclosed variable", pattern: "projects/{project", rules: []resourcename.IDRule{resourcename.UUID}, wantErr: true},
+		{name: "empty segment", pattern: "projects//{project}", rules: []resourcename.IDRule{resourcename.UUID}, wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := resourcename.Compile(tt.pattern, tt.rules...)
+			assert.Equal(t, tt.wantErr, err != nil, err)
+		})
+	}
+}
+
+func TestPattern_Parse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		pattern *resourcename.Pattern
+		input   string
+		want    []string
+		wantErr string
+	}{
+		{
+			name:    "project",
+			pattern: resourcename.Project,
+			input:   "projects/" + projectID,
+			want:    []string{projectID},
+		},
+		{
+			name:    "task",
+			pattern: resourcename.Task,
+			input:   "projects/" + projectID + "/tasks/" + taskID,
+			want:    []string{projectID, taskID},
+		},
+		{
+			name:    "label",
+			pattern: resourcename.Label,
+			input:   "labels/urgent",
+			want:    []string{"urgent"},
+		},
+		{
+			name:    "user",
+			pattern: resourcename.User,
+			input:   "users/" + projectID,
+			want:    []string{projectID},
+		},
+		{
+			name:    "wrong collection",
+			pattern: resourcename.Project,
+			input:   "Projects/" + projectID,
+			wantErr: `resource name "Projects/` + projectID + `" does not match pattern projects/{project}`,
+		},
+		{
+			name:    "too many segments",
+			pattern: resourcename.Project,
+			input:   "projects/" + projectID + "/tasks/" + taskID,
+			wantErr: `resource name "projects/` + projectID + `/tasks/` + taskID + `" does not match pattern projects/{project}`,
+		},
+		{
+			name:    "empty id",
+			pattern: resourcename.Task,
+			input:   "projects//tasks/" + taskID,
+			wantErr: `resource name "projects//tasks/` + taskID + `" does not match pattern projects/{project}/tasks/{task}`,
+		},
+		{
+			name:    "invalid nested id",
+			pattern: resourcename.Task,
+			input:   "projects/" + projectID + "/tasks/1",
+			wantErr: `task id "1" in resource name "projects/` + projectID + `/tasks/1" is not a valid UUID`,
+		},
+		{
+			name:    "invalid slug",
+			pattern: resourcename.Label,
+			input:   "labels/Urgent",
+			wantErr: `label id "Urgent" in resource name "labels/Urgent" is not a valid ID: lower-case letters, digits and hyphens, starting with a letter`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.pattern.Parse(tt.input)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.input, tt.pattern.Format(got...))
+		})
+	}
+}
+
+func TestNames(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "projects/"+projectID, resourcename.ProjectName(projectID))
+	assert.Equal(t, "projects/"+projectID+"/tasks/"+taskID, resourcename.TaskName(projectID, taskID))
+	assert.Equal(t, "labels/urgent", resourcename.LabelName("urgent"))
+	assert.Equal(t, "users/"+projectID, resourcename.UserName(projectID))
+	assert.Equal(t, "tasks.readytogo.com/Project", resourcename.Project.Type())
+
+	gotProjectID, gotTaskID, err := resourcename.ParseTaskName(resourcename.TaskName(projectID, taskID))
+	require.NoError(t, err)
+	assert.Equal(t, projectID, gotProjectID)
+	assert.Equal(t, taskID, gotTaskID)
+}
+
+func TestPattern_CanonicalUUIDs(t *testing.T) {
+	t.Parallel()
+
+	upperProjectID, upperTaskID := strings.ToUpper(projectID), strings.ToUpper(taskID)
+
+	gotProjectID, gotTaskID, err := resourcename.ParseTaskName("projects/" + upperProjectID + "/tasks/" + upperTaskID)
+	require.NoError(t, err)
+	assert.Equal(t, projectID, gotProjectID)
+	assert.Equal(t, taskID, gotTaskID)
+
+	assert.Equal(t, "projects/"+projectID, resourcename.ProjectName(upperProjectID))
+	assert.Equal(t, "projects/"+projectID+"/tasks/"+taskID, resourcename.TaskName(upperProjectID, upperTaskID))
+	assert.Equal(t, "users/"+projectID, resourcename.UserName(upperProjectID))
+}
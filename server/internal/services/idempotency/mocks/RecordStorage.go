@@ -0,0 +1,136 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	idempotencymodels "github.com/10Narratives/ready-to-do/server/internal/models/idempotency"
+
+	mock "github.com/stretchr/testify/mock"
+
+	status "google.golang.org/grpc/status"
+
+	time "time"
+)
+
+// RecordStorage is an autogenerated mock type for the RecordStorage type
+type RecordStorage struct {
+	mock.Mock
+}
+
+// Complete provides a mock function with given fields: ctx, key, response, expireTime
+func (_m *RecordStorage) Complete(ctx context.Context, key string, response []byte, expireTime time.Time) *status.Status {
+	ret := _m.Called(ctx, key, response, expireTime)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Complete")
+	}
+
+	var r0 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte, time.Time) *status.Status); ok {
+		r0 = rf(ctx, key, response, expireTime)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*status.Status)
+		}
+	}
+
+	return r0
+}
+
+// Purge provides a mock function with given fields: ctx, before
+func (_m *RecordStorage) Purge(ctx context.Context, before time.Time) (int64, *status.Status) {
+	ret := _m.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Purge")
+	}
+
+	var r0 int64
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int64, *status.Status)); ok {
+		return rf(ctx, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(ctx, before)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) *status.Status); ok {
+		r1 = rf(ctx, before)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// Release provides a mock function with given fields: ctx, key
+func (_m *RecordStorage) Release(ctx context.Context, key string) *status.Status {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Release")
+	}
+
+	var r0 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, string) *status.Status); ok {
+		r0 = rf(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*status.Status)
+		}
+	}
+
+	return r0
+}
+
+// Reserve provides a mock function with given fields: ctx, record, now
+func (_m *RecordStorage) Reserve(ctx context.Context, record *idempotencymodels.Record, now time.Time) (*idempotencymodels.Record, *status.Status) {
+	ret := _m.Called(ctx, record, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reserve")
+	}
+
+	var r0 *idempotencymodels.Record
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *idempotencymodels.Record, time.Time) (*idempotencymodels.Record, *status.Status)); ok {
+		return rf(ctx, record, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *idempotencymodels.Record, time.Time) *idempotencymodels.Record); ok {
+		r0 = rf(ctx, record, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*idempotencymodels.Record)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *idempotencymodels.Record, time.Time) *status.Status); ok {
+		r1 = rf(ctx, record, now)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// NewRecordStorage creates a new instance of RecordStorage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRecordStorage(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RecordStorage {
+	mock := &RecordStorage{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,155 @@
+package idempotencysrv
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/10Narratives/ready-to-do/server/internal/apierror"
+	"github.com/10Narratives/ready-to-do/server/internal/auth"
+	idempotencymodels "github.com/10Narratives/ready-to-do/server/internal/models/idempotency"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// DefaultTTL is how long the response of a request is kept for retries.
+	DefaultTTL = 24 * time.Hour
+	// DefaultLease is how long a running request holds its key. A request
+	// that has not finished by then, e.g. because its server crashed, no
+	// longer blocks retries.
+	DefaultLease = time.Minute
+)
+
+//go:generate mockery --name RecordStorage --output ./mocks/
+type RecordStorage interface {
+	// Reserve stores record unless its key is held by an unexpired record,
+	// which is returned instead. A nil record means the key was reserved.
+	Reserve(ctx context.Context, record *idempotencymodels.Record, now time.Time) (*idempotencymodels.Record, *status.Status)
+	// Complete stores the response of a reserved key and keeps it until
+	// expireTime.
+	Complete(ctx context.Context, key string, response []byte, expireTime time.Time) *status.Status
+	// Release drops the reservation of a key whose request failed.
+	Release(ctx context.Context, key string) *status.Status
+	// Purge removes records that expired before the given time.
+	Purge(ctx context.Context, before time.Time) (int64, *status.Status)
+}
+
+// Service makes requests that carry a request ID idempotent across
+// replicas, remembering their responses in a shared RecordStorage.
+type Service struct {
+	storage RecordStorage
+	ttl     time.Duration
+	lease   time.Duration
+}
+
+type Option func(*Service)
+
+// WithTTL sets how long responses are kept. Non-positive values are ignored.
+func WithTTL(ttl time.Duration) Option {
+	return func(s *Service) {
+		if ttl > 0 {
+			s.ttl = ttl
+		}
+	}
+}
+
+// WithLease sets how long a running request holds its key. Non-positive
+// values are ignored.
+func WithLease(lease time.Duration) Option {
+	return func(s *Service) {
+		if lease > 0 {
+			s.lease = lease
+		}
+	}
+}
+
+func New(storage RecordStorage, opts ...Option) *Service {
+	s := &Service{
+		storage: storage,
+		ttl:     DefaultTTL,
+		lease:   DefaultLease,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Run calls call once per key and stores response, which call fills in, as
+// the result of the key. Retries of the key with an equal request get the
+// stored response decoded into response without calling call again.
+// Returns FailedPrecondition if the key was used for a different request and
+// Aborted while the first request with the key is still running. A failed
+// call releases the key, so that it can be retried. Keys of authenticated
+// calls are scoped to their user, so that users never see the responses of
+// each other.
+func (s *Service) Run(ctx context.Context, key string, request, response any, call func() *status.Status) *status.Status {
+	if principal, ok := auth.FromContext(ctx); ok {
+		key = principal.User + "/" + key
+	}
+
+	hash, err := requestHash(request)
+	if err != nil {
+		return status.Newf(codes.Internal, "cannot fingerprint request: %v", err)
+	}
+
+	now := time.Now().UTC()
+	stored, stat := s.storage.Reserve(ctx, &idempotencymodels.Record{
+		Key:         key,
+		RequestHash: hash,
+		ExpireTime:  now.Add(s.lease),
+	}, now)
+	if stat != nil {
+		return stat
+	}
+	if stored != nil {
+		return replay(stored, hash, response)
+	}
+
+	if stat := call(); stat != nil {
+		// An unreleased key is taken over once its lease ends.
+		_ = s.storage.Release(ctx, key)
+		return stat
+	}
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		return status.Newf(codes.Internal, "cannot encode response: %v", err)
+	}
+	return s.storage.Complete(ctx, key, data, time.Now().UTC().Add(s.ttl))
+}
+
+// Purge removes expired records.
+func (s *Service) Purge(ctx context.Context) (int64, *status.Status) {
+	return s.storage.Purge(ctx, time.Now().UTC())
+}
+
+// replay decodes the stored response of a retried request into response.
+func replay(stored *idempotencymodels.Record, hash string, response any) *status.Status {
+	if stored.RequestHash != hash {
+		return apierror.New(codes.FailedPrecondition, apierror.ReasonRequestIDReused, apierror.Metadata{"key": stored.Key},
+			fmt.Sprintf("idempotency key %q was already used for a different request", stored.Key))
+	}
+	if !stored.Completed() {
+		return apierror.Aborted(apierror.ReasonRequestInProgress, apierror.Metadata{"key": stored.Key},
+			fmt.Sprintf("request with idempotency key %q is still running", stored.Key))
+	}
+	if err := json.Unmarshal(stored.Response, response); err != nil {
+		return status.Newf(codes.Internal, "cannot decode stored response: %v", err)
+	}
+	return nil
+}
+
+// requestHash fingerprints the JSON encoding of request.
+func requestHash(request any) (string, error) {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
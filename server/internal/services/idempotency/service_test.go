@@ -0,0 +1,166 @@
+package idempotencysrv_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/10Narratives/ready-to-do/server/internal/auth"
+	idempotencymodels "github.com/10Narratives/ready-to-do/server/internal/models/idempotency"
+	idempotencysrv "github.com/10Narratives/ready-to-do/server/internal/services/idempotency"
+	"github.com/10Narratives/ready-to-do/server/internal/services/idempotency/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type request struct {
+	Title string `json:"title"`
+}
+
+type response struct {
+	ID string `json:"id"`
+}
+
+func TestService_Run(t *testing.T) {
+	t.Parallel()
+
+	const key = "CreateThing/b1ffcd88-8d1a-4ef8-bb6d-6bb9bd380a22"
+
+	// stored returns the record a previous call with req left behind.
+	stored := func(t *testing.T, req request, resp []byte) *idempotencymodels.Record {
+		storageMock := mocks.NewRecordStorage(t)
+		var record *idempotencymodels.Record
+		storageMock.On("Reserve", mock.Anything, mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				record = args.Get(1).(*idempotencymodels.Record)
+			}).Return(nil, nil).Once()
+		storageMock.On("Complete", mock.Anything, key, mock.Anything, mock.Anything).Return(nil).Once()
+
+		var got response
+		stat := idempotencysrv.New(storageMock).Run(context.Background(), key, req, &got, func() *status.Status {
+			got.ID = "thing"
+			return nil
+		})
+		require.Nil(t, stat)
+
+		record.Response = resp
+		return record
+	}
+
+	tests := []struct {
+		name       string
+		stored     func(t *testing.T) *idempotencymodels.Record
+		callStat   *status.Status
+		wantCalled bool
+		wantID     string
+		wantCode   codes.Code
+	}{
+		{
+			name:       "first call",
+			stored:     func(*testing.T) *idempotencymodels.Record { return nil },
+			wantCalled: true,
+			wantID:     "created",
+			wantCode:   codes.OK,
+		},
+		{
+			name: "retry",
+			stored: func(t *testing.T) *idempotencymodels.Record {
+				return stored(t, request{Title: "title"}, []byte(`{"id":"replayed"}`))
+			},
+			wantID:   "replayed",
+			wantCode: codes.OK,
+		},
+		{
+			name: "different request",
+			stored: func(t *testing.T) *idempotencymodels.Record {
+				return stored(t, request{Title: "other"}, []byte(`{"id":"replayed"}`))
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name: "first call still running",
+			stored: func(t *testing.T) *idempotencymodels.Record {
+				return stored(t, request{Title: "title"}, nil)
+			},
+			wantCode: codes.Aborted,
+		},
+		{
+			name:       "failed call",
+			stored:     func(*testing.T) *idempotencymodels.Record { return nil },
+			callStat:   status.New(codes.AlreadyExists, "thing already exists"),
+			wantCalled: true,
+			wantCode:   codes.AlreadyExists,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			storageMock := mocks.NewRecordStorage(t)
+			storageMock.On("Reserve", mock.Anything, mock.MatchedBy(func(record *idempotencymodels.Record) bool {
+				return record.Key == key && record.Response == nil && time.Until(record.ExpireTime) > 0
+			}), mock.Anything).Return(tt.stored(t), nil).Once()
+			if tt.wantCalled && tt.callStat == nil {
+				storageMock.On("Complete", mock.Anything, key, []byte(`{"id":"created"}`), mock.MatchedBy(func(expireTime time.Time) bool {
+					return time.Until(expireTime) > time.Hour
+				})).Return(nil).Once()
+			}
+			if tt.callStat != nil {
+				storageMock.On("Release", mock.Anything, key).Return(nil).Once()
+			}
+
+			called := false
+			var got response
+			stat := idempotencysrv.New(storageMock, idempotencysrv.WithTTL(2*time.Hour)).Run(context.Background(), key, request{Title: "title"}, &got, func() *status.Status {
+				called = true
+				if tt.callStat != nil {
+					return tt.callStat
+				}
+				got.ID = "created"
+				return nil
+			})
+
+			require.Equal(t, tt.wantCode, stat.Code())
+			assert.Equal(t, tt.wantCalled, called)
+			assert.Equal(t, tt.wantID, got.ID)
+		})
+	}
+}
+
+func TestService_RunScopesKeysToUser(t *testing.T) {
+	t.Parallel()
+
+	const userKey = "users/a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11/CreateThing/b1ffcd88-8d1a-4ef8-bb6d-6bb9bd380a22"
+
+	storageMock := mocks.NewRecordStorage(t)
+	storageMock.On("Reserve", mock.Anything, mock.MatchedBy(func(record *idempotencymodels.Record) bool {
+		return record.Key == userKey
+	}), mock.Anything).Return(nil, nil).Once()
+	storageMock.On("Complete", mock.Anything, userKey, mock.Anything, mock.Anything).Return(nil).Once()
+
+	ctx := auth.NewContext(context.Background(), &auth.Principal{User: "users/a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"})
+	var got response
+	stat := idempotencysrv.New(storageMock).Run(ctx, "CreateThing/b1ffcd88-8d1a-4ef8-bb6d-6bb9bd380a22", request{Title: "title"}, &got, func() *status.Status {
+		got.ID = "created"
+		return nil
+	})
+	require.Nil(t, stat)
+	assert.Equal(t, "created", got.ID)
+}
+
+func TestService_Purge(t *testing.T) {
+	t.Parallel()
+
+	storageMock := mocks.NewRecordStorage(t)
+	storageMock.On("Purge", mock.Anything, mock.MatchedBy(func(before time.Time) bool {
+		return time.Since(before) < time.Minute
+	})).Return(int64(3), nil)
+
+	purged, stat := idempotencysrv.New(storageMock).Purge(context.Background())
+	require.Nil(t, stat)
+	assert.Equal(t, int64(3), purged)
+}
@@ -0,0 +1,157 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	labelmodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/label"
+	labelsrv "github.com/10Narratives/ready-to-do/server/internal/services/tasks/label"
+
+	mock "github.com/stretchr/testify/mock"
+
+	status "google.golang.org/grpc/status"
+)
+
+// LabelStorage is an autogenerated mock type for the LabelStorage type
+type LabelStorage struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, label
+func (_m *LabelStorage) Create(ctx context.Context, label *labelmodels.Label) *status.Status {
+	ret := _m.Called(ctx, label)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *labelmodels.Label) *status.Status); ok {
+		r0 = rf(ctx, label)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*status.Status)
+		}
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: ctx, name
+func (_m *LabelStorage) Delete(ctx context.Context, name string) *status.Status {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, string) *status.Status); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*status.Status)
+		}
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, name
+func (_m *LabelStorage) Get(ctx context.Context, name string) (*labelmodels.Label, *status.Status) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *labelmodels.Label
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*labelmodels.Label, *status.Status)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *labelmodels.Label); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*labelmodels.Label)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) *status.Status); ok {
+		r1 = rf(ctx, name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, query
+func (_m *LabelStorage) List(ctx context.Context, query labelsrv.ListLabelsQuery) ([]*labelmodels.Label, *status.Status) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*labelmodels.Label
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, labelsrv.ListLabelsQuery) ([]*labelmodels.Label, *status.Status)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, labelsrv.ListLabelsQuery) []*labelmodels.Label); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*labelmodels.Label)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, labelsrv.ListLabelsQuery) *status.Status); ok {
+		r1 = rf(ctx, query)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, label
+func (_m *LabelStorage) Update(ctx context.Context, label *labelmodels.Label) *status.Status {
+	ret := _m.Called(ctx, label)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *labelmodels.Label) *status.Status); ok {
+		r0 = rf(ctx, label)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*status.Status)
+		}
+	}
+
+	return r0
+}
+
+// NewLabelStorage creates a new instance of LabelStorage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLabelStorage(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LabelStorage {
+	mock := &LabelStorage{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,188 @@
+package labelsrv
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/10Narratives/ready-to-do/server/internal/apierror"
+	labelmodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/label"
+	"github.com/10Narratives/ready-to-do/server/internal/pagetoken"
+	"github.com/10Narratives/ready-to-do/server/internal/resourcename"
+	labelapi "github.com/10Narratives/ready-to-do/server/internal/transport/grpc/tasks/label"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 1000
+)
+
+// LabelStorage stores labels. Calls only see the labels visible to the user
+// of their context, its own and the shared ones, and only change its own,
+// returning PermissionDenied for shared ones.
+//
+//go:generate mockery --name LabelStorage --output ./mocks/
+type LabelStorage interface {
+	// Create stores a new label owned by the user of ctx.
+	Create(ctx context.Context, label *labelmodels.Label) *status.Status
+	Get(ctx context.Context, name string) (*labelmodels.Label, *status.Status)
+	// List returns up to query.PageSize+1 labels ordered by name; the extra
+	// label tells the caller that another page exists.
+	List(ctx context.Context, query ListLabelsQuery) ([]*labelmodels.Label, *status.Status)
+	Update(ctx context.Context, label *labelmodels.Label) *status.Status
+	// Delete removes a label together with its assignments.
+	Delete(ctx context.Context, name string) *status.Status
+}
+
+// ListLabelsQuery selects one keyset page of labels.
+type ListLabelsQuery struct {
+	PageSize int
+	// AfterName is the name of the last label on the previous page.
+	AfterName string
+}
+
+// LabelCursor is the sort key of the last label on a page.
+type LabelCursor struct {
+	Name string `json:"n"`
+}
+
+type Service struct {
+	storage LabelStorage
+
+	pageTokens      *pagetoken.Codec
+	defaultPageSize int
+	maxPageSize     int
+}
+
+var _ labelapi.LabelService = &Service{}
+
+type Option func(*Service)
+
+// WithPageTokenSecret sets the key used to sign page tokens. Replicas serving
+// the same clients must share it.
+func WithPageTokenSecret(secret string) Option {
+	return func(s *Service) {
+		s.pageTokens = pagetoken.New(secret)
+	}
+}
+
+// WithPageSize overrides the default and maximum list page sizes.
+func WithPageSize(defaultSize, maxSize int) Option {
+	return func(s *Service) {
+		if defaultSize > 0 {
+			s.defaultPageSize = defaultSize
+		}
+		if maxSize > 0 {
+			s.maxPageSize = maxSize
+		}
+	}
+}
+
+func New(storage LabelStorage, opts ...Option) *Service {
+	s := &Service{
+		storage:         storage,
+		defaultPageSize: DefaultPageSize,
+		maxPageSize:     MaxPageSize,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.pageTokens == nil {
+		s.pageTokens = pagetoken.New("")
+	}
+	return s
+}
+
+func (s *Service) Create(ctx context.Context, args labelapi.CreateLabelArgs) (*labelmodels.Label, *status.Status) {
+	now := time.Now().UTC()
+	label := *args.Label
+	label.Name = resourcename.LabelName(args.LabelID)
+	label.CreatedAt = now
+	label.UpdatedAt = now
+
+	if stat := s.storage.Create(ctx, &label); stat != nil {
+		return nil, stat
+	}
+
+	return &label, nil
+}
+
+func (s *Service) Get(ctx context.Context, args labelapi.GetLabelArgs) (*labelmodels.Label, *status.Status) {
+	return s.storage.Get(ctx, resourcename.LabelName(args.LabelID))
+}
+
+func (s *Service) List(ctx context.Context, args labelapi.ListLabelsArgs) ([]*labelmodels.Label, string, *status.Status) {
+	query := ListLabelsQuery{
+		PageSize: s.pageSize(args.PageSize),
+	}
+
+	if args.PageToken != "" {
+		var cursor LabelCursor
+		if err := s.pageTokens.Decode(args.PageToken, "", &cursor); err != nil {
+			return nil, "", apierror.BadRequest("invalid page token", apierror.Violation("page_token", err.Error()))
+		}
+		query.AfterName = cursor.Name
+	}
+
+	labels, stat := s.storage.List(ctx, query)
+	if stat != nil {
+		return nil, "", stat
+	}
+
+	if len(labels) <= query.PageSize {
+		return labels, "", nil
+	}
+
+	labels = labels[:query.PageSize]
+	nextPageToken, err := s.pageTokens.Encode("", LabelCursor{Name: labels[len(labels)-1].Name})
+	if err != nil {
+		return nil, "", status.Newf(codes.Internal, "cannot issue page token: %v", err)
+	}
+
+	return labels, nextPageToken, nil
+}
+
+// Update changes the masked fields of a label. Assignments refer to labels
+// by name, so they are unaffected.
+func (s *Service) Update(ctx context.Context, args labelapi.UpdateLabelArgs) (*labelmodels.Label, *status.Status) {
+	label, stat := s.storage.Get(ctx, resourcename.LabelName(args.LabelID))
+	if stat != nil {
+		return nil, stat
+	}
+
+	for _, field := range args.Fields {
+		switch field {
+		case labelmodels.DisplayNameField:
+			label.DisplayName = args.Label.DisplayName
+		case labelmodels.ColorField:
+			label.Color = args.Label.Color
+		default:
+			return nil, apierror.BadRequest("invalid update mask", apierror.Violation("update_mask", fmt.Sprintf("field %q cannot be updated", field)))
+		}
+	}
+	label.UpdatedAt = time.Now().UTC()
+
+	if stat := s.storage.Update(ctx, label); stat != nil {
+		return nil, stat
+	}
+
+	return label, nil
+}
+
+// Delete permanently removes a label and unassigns it everywhere.
+func (s *Service) Delete(ctx context.Context, args labelapi.DeleteLabelArgs) *status.Status {
+	return s.storage.Delete(ctx, resourcename.LabelName(args.LabelID))
+}
+
+func (s *Service) pageSize(requested int32) int {
+	switch {
+	case requested <= 0:
+		return s.defaultPageSize
+	case int(requested) > s.maxPageSize:
+		return s.maxPageSize
+	default:
+		return int(requested)
+	}
+}
@@ -0,0 +1,158 @@
+package labelsrv_test
+
+import (
+	"context"
+	"testing"
+
+	labelmodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/label"
+	labelsrv "github.com/10Narratives/ready-to-do/server/internal/services/tasks/label"
+	"github.com/10Narratives/ready-to-do/server/internal/services/tasks/label/mocks"
+	labelapi "github.com/10Narratives/ready-to-do/server/internal/transport/grpc/tasks/label"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestService_Create(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		setupStorageMock func(m *mocks.LabelStorage)
+		wantCode         codes.Code
+	}{
+		{
+			name: "successful execution",
+			setupStorageMock: func(m *mocks.LabelStorage) {
+				m.On("Create", mock.Anything, mock.MatchedBy(func(label *labelmodels.Label) bool {
+					return label.Name == "labels/urgent" &&
+						!label.CreatedAt.IsZero() && label.CreatedAt.Equal(label.UpdatedAt)
+				})).Return(nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "storage status is passed up",
+			setupStorageMock: func(m *mocks.LabelStorage) {
+				m.On("Create", mock.Anything, mock.Anything).Return(status.New(codes.AlreadyExists, "label already exists"))
+			},
+			wantCode: codes.AlreadyExists,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			storageMock := mocks.NewLabelStorage(t)
+			tt.setupStorageMock(storageMock)
+
+			got, stat := labelsrv.New(storageMock).Create(context.Background(), labelapi.CreateLabelArgs{
+				LabelID: "urgent",
+				Label:   &labelmodels.Label{DisplayName: "Urgent", Color: "#ff0000"},
+			})
+
+			require.Equal(t, tt.wantCode, stat.Code())
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, "Urgent", got.DisplayName)
+			}
+		})
+	}
+}
+
+func TestService_List(t *testing.T) {
+	t.Parallel()
+
+	storageMock := mocks.NewLabelStorage(t)
+	storageMock.On("List", mock.Anything, labelsrv.ListLabelsQuery{PageSize: 2}).Return([]*labelmodels.Label{
+		{Name: "labels/a"}, {Name: "labels/b"}, {Name: "labels/c"},
+	}, nil).Once()
+	storageMock.On("List", mock.Anything, labelsrv.ListLabelsQuery{PageSize: 2, AfterName: "labels/b"}).Return([]*labelmodels.Label{
+		{Name: "labels/c"},
+	}, nil).Once()
+
+	service := labelsrv.New(storageMock)
+
+	labels, token, stat := service.List(context.Background(), labelapi.ListLabelsArgs{PageSize: 2})
+	require.Nil(t, stat)
+	require.Len(t, labels, 2)
+	require.NotEmpty(t, token)
+
+	labels, token, stat = service.List(context.Background(), labelapi.ListLabelsArgs{PageSize: 2, PageToken: token})
+	require.Nil(t, stat)
+	assert.Len(t, labels, 1)
+	assert.Empty(t, token)
+
+	_, _, stat = service.List(context.Background(), labelapi.ListLabelsArgs{PageToken: "garbage"})
+	assert.Equal(t, codes.InvalidArgument, stat.Code())
+}
+
+func TestService_Update(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		fields           []string
+		setupStorageMock func(m *mocks.LabelStorage)
+		want             *labelmodels.Label
+		wantCode         codes.Code
+	}{
+		{
+			name:   "rename keeps color",
+			fields: []string{labelmodels.DisplayNameField},
+			setupStorageMock: func(m *mocks.LabelStorage) {
+				m.On("Get", mock.Anything, "labels/urgent").Return(&labelmodels.Label{
+					Name:        "labels/urgent",
+					DisplayName: "Urgent",
+					Color:       "#ff0000",
+				}, nil)
+				m.On("Update", mock.Anything, mock.Anything).Return(nil)
+			},
+			want:     &labelmodels.Label{Name: "labels/urgent", DisplayName: "Critical", Color: "#ff0000"},
+			wantCode: codes.OK,
+		},
+		{
+			name:   "missing label",
+			fields: []string{labelmodels.ColorField},
+			setupStorageMock: func(m *mocks.LabelStorage) {
+				m.On("Get", mock.Anything, "labels/urgent").Return(nil, status.New(codes.NotFound, "label not found"))
+			},
+			wantCode: codes.NotFound,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			storageMock := mocks.NewLabelStorage(t)
+			tt.setupStorageMock(storageMock)
+
+			got, stat := labelsrv.New(storageMock).Update(context.Background(), labelapi.UpdateLabelArgs{
+				LabelID: "urgent",
+				Label:   &labelmodels.Label{DisplayName: "Critical", Color: "#00ff00"},
+				Fields:  tt.fields,
+			})
+
+			require.Equal(t, tt.wantCode, stat.Code())
+			if tt.wantCode != codes.OK {
+				return
+			}
+			assert.Equal(t, tt.want.DisplayName, got.DisplayName)
+			assert.Equal(t, tt.want.Color, got.Color)
+			assert.False(t, got.UpdatedAt.IsZero())
+		})
+	}
+}
+
+func TestService_Delete(t *testing.T) {
+	t.Parallel()
+
+	storageMock := mocks.NewLabelStorage(t)
+	storageMock.On("Delete", mock.Anything, "labels/urgent").Return(nil)
+
+	stat := labelsrv.New(storageMock).Delete(context.Background(), labelapi.DeleteLabelArgs{LabelID: "urgent"})
+	assert.Nil(t, stat)
+}
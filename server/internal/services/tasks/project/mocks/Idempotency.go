@@ -0,0 +1,50 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	status "google.golang.org/grpc/status"
+)
+
+// Idempotency is an autogenerated mock type for the Idempotency type
+type Idempotency struct {
+	mock.Mock
+}
+
+// Run provides a mock function with given fields: ctx, key, request, response, call
+func (_m *Idempotency) Run(ctx context.Context, key string, request any, response any, call func() *status.Status) *status.Status {
+	ret := _m.Called(ctx, key, request, response, call)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Run")
+	}
+
+	var r0 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, string, any, any, func() *status.Status) *status.Status); ok {
+		r0 = rf(ctx, key, request, response, call)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*status.Status)
+		}
+	}
+
+	return r0
+}
+
+// NewIdempotency creates a new instance of Idempotency. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIdempotency(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Idempotency {
+	mock := &Idempotency{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -6,9 +6,12 @@ import (
 	context "context"
 
 	projectmodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/project"
+	projectsrv "github.com/10Narratives/ready-to-do/server/internal/services/tasks/project"
 	mock "github.com/stretchr/testify/mock"
 
 	status "google.golang.org/grpc/status"
+
+	time "time"
 )
 
 // ProjectStorage is an autogenerated mock type for the ProjectStorage type
@@ -16,6 +19,78 @@ type ProjectStorage struct {
 	mock.Mock
 }
 
+// BatchCreate provides a mock function with given fields: ctx, projects
+func (_m *ProjectStorage) BatchCreate(ctx context.Context, projects []*projectmodels.Project) *status.Status {
+	ret := _m.Called(ctx, projects)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchCreate")
+	}
+
+	var r0 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, []*projectmodels.Project) *status.Status); ok {
+		r0 = rf(ctx, projects)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*status.Status)
+		}
+	}
+
+	return r0
+}
+
+// BatchGet provides a mock function with given fields: ctx, names
+func (_m *ProjectStorage) BatchGet(ctx context.Context, names []string) ([]*projectmodels.Project, *status.Status) {
+	ret := _m.Called(ctx, names)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchGet")
+	}
+
+	var r0 []*projectmodels.Project
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]*projectmodels.Project, *status.Status)); ok {
+		return rf(ctx, names)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*projectmodels.Project); ok {
+		r0 = rf(ctx, names)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*projectmodels.Project)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) *status.Status); ok {
+		r1 = rf(ctx, names)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// BatchUpdate provides a mock function with given fields: ctx, projects
+func (_m *ProjectStorage) BatchUpdate(ctx context.Context, projects []*projectmodels.Project) *status.Status {
+	ret := _m.Called(ctx, projects)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchUpdate")
+	}
+
+	var r0 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, []*projectmodels.Project) *status.Status); ok {
+		r0 = rf(ctx, projects)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*status.Status)
+		}
+	}
+
+	return r0
+}
+
 // Create provides a mock function with given fields: ctx, project
 func (_m *ProjectStorage) Create(ctx context.Context, project *projectmodels.Project) *status.Status {
 	ret := _m.Called(ctx, project)
@@ -36,6 +111,306 @@ func (_m *ProjectStorage) Create(ctx context.Context, project *projectmodels.Pro
 	return r0
 }
 
+// Get provides a mock function with given fields: ctx, name
+func (_m *ProjectStorage) Get(ctx context.Context, name string) (*projectmodels.Project, *status.Status) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *projectmodels.Project
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*projectmodels.Project, *status.Status)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *projectmodels.Project); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*projectmodels.Project)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) *status.Status); ok {
+		r1 = rf(ctx, name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// LastChange provides a mock function with given fields: ctx
+func (_m *ProjectStorage) LastChange(ctx context.Context) (int64, *status.Status) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LastChange")
+	}
+
+	var r0 int64
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, *status.Status)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) *status.Status); ok {
+		r1 = rf(ctx)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, query
+func (_m *ProjectStorage) List(ctx context.Context, query projectsrv.ListProjectsQuery) ([]*projectmodels.Project, *status.Status) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*projectmodels.Project
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, projectsrv.ListProjectsQuery) ([]*projectmodels.Project, *status.Status)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, projectsrv.ListProjectsQuery) []*projectmodels.Project); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*projectmodels.Project)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, projectsrv.ListProjectsQuery) *status.Status); ok {
+		r1 = rf(ctx, query)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListChanges provides a mock function with given fields: ctx, after, limit
+func (_m *ProjectStorage) ListChanges(ctx context.Context, after int64, limit int) ([]*projectmodels.ProjectChange, *status.Status) {
+	ret := _m.Called(ctx, after, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListChanges")
+	}
+
+	var r0 []*projectmodels.ProjectChange
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int) ([]*projectmodels.ProjectChange, *status.Status)); ok {
+		return rf(ctx, after, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int) []*projectmodels.ProjectChange); ok {
+		r0 = rf(ctx, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*projectmodels.ProjectChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int) *status.Status); ok {
+		r1 = rf(ctx, after, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// Move provides a mock function with given fields: ctx, name, anchor, after, updateTime
+func (_m *ProjectStorage) Move(ctx context.Context, name string, anchor string, after bool, updateTime time.Time) (*projectmodels.Project, *status.Status) {
+	ret := _m.Called(ctx, name, anchor, after, updateTime)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Move")
+	}
+
+	var r0 *projectmodels.Project
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, bool, time.Time) (*projectmodels.Project, *status.Status)); ok {
+		return rf(ctx, name, anchor, after, updateTime)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, bool, time.Time) *projectmodels.Project); ok {
+		r0 = rf(ctx, name, anchor, after, updateTime)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*projectmodels.Project)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, bool, time.Time) *status.Status); ok {
+		r1 = rf(ctx, name, anchor, after, updateTime)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// Purge provides a mock function with given fields: ctx, before
+func (_m *ProjectStorage) Purge(ctx context.Context, before time.Time) (int64, *status.Status) {
+	ret := _m.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Purge")
+	}
+
+	var r0 int64
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int64, *status.Status)); ok {
+		return rf(ctx, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(ctx, before)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) *status.Status); ok {
+		r1 = rf(ctx, before)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// PurgeChanges provides a mock function with given fields: ctx, before
+func (_m *ProjectStorage) PurgeChanges(ctx context.Context, before time.Time) (int64, *status.Status) {
+	ret := _m.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeChanges")
+	}
+
+	var r0 int64
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int64, *status.Status)); ok {
+		return rf(ctx, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(ctx, before)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) *status.Status); ok {
+		r1 = rf(ctx, before)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// Rebalance provides a mock function with given fields: ctx, maxLength
+func (_m *ProjectStorage) Rebalance(ctx context.Context, maxLength int) (int64, *status.Status) {
+	ret := _m.Called(ctx, maxLength)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rebalance")
+	}
+
+	var r0 int64
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, int) (int64, *status.Status)); ok {
+		return rf(ctx, maxLength)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) int64); ok {
+		r0 = rf(ctx, maxLength)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) *status.Status); ok {
+		r1 = rf(ctx, maxLength)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// Transition provides a mock function with given fields: ctx, name, from, to, updateTime
+func (_m *ProjectStorage) Transition(ctx context.Context, name string, from projectmodels.ProjectState, to projectmodels.ProjectState, updateTime time.Time) (*projectmodels.Project, *status.Status) {
+	ret := _m.Called(ctx, name, from, to, updateTime)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Transition")
+	}
+
+	var r0 *projectmodels.Project
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, string, projectmodels.ProjectState, projectmodels.ProjectState, time.Time) (*projectmodels.Project, *status.Status)); ok {
+		return rf(ctx, name, from, to, updateTime)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, projectmodels.ProjectState, projectmodels.ProjectState, time.Time) *projectmodels.Project); ok {
+		r0 = rf(ctx, name, from, to, updateTime)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*projectmodels.Project)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, projectmodels.ProjectState, projectmodels.ProjectState, time.Time) *status.Status); ok {
+		r1 = rf(ctx, name, from, to, updateTime)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, project
+func (_m *ProjectStorage) Update(ctx context.Context, project *projectmodels.Project) *status.Status {
+	ret := _m.Called(ctx, project)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *projectmodels.Project) *status.Status); ok {
+		r0 = rf(ctx, project)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*status.Status)
+		}
+	}
+
+	return r0
+}
+
 // NewProjectStorage creates a new instance of ProjectStorage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewProjectStorage(t interface {
@@ -3,34 +3,735 @@ package projectsrv
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/10Narratives/ready-to-do/server/internal/apierror"
+	"github.com/10Narratives/ready-to-do/server/internal/filter"
 	projectmodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/project"
+	"github.com/10Narratives/ready-to-do/server/internal/pagetoken"
+	"github.com/10Narratives/ready-to-do/server/internal/resourcename"
 	projectapi "github.com/10Narratives/ready-to-do/server/internal/transport/grpc/tasks/project"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 1000
+	// DefaultRetention is how long soft-deleted projects are kept.
+	DefaultRetention = 30 * 24 * time.Hour
+	// DefaultMaxPositionLength is the position length above which positions
+	// are rebalanced.
+	DefaultMaxPositionLength = 32
+	// DefaultMaxBatchSize is how many projects a batch call may address.
+	DefaultMaxBatchSize = 1000
+	// DefaultChangeRetention is how long changes are kept for watchers to
+	// resume from.
+	DefaultChangeRetention = 7 * 24 * time.Hour
+	// DefaultHeartbeatInterval is how often idle watch streams get a
+	// heartbeat.
+	DefaultHeartbeatInterval = 30 * time.Second
+	// DefaultPollInterval is how often watchers look for changes they were
+	// not notified about.
+	DefaultPollInterval = 5 * time.Second
+)
+
+// changeBatchSize is how many changes a watcher reads at once.
+const changeBatchSize = 100
+
+// ProjectStorage stores projects. Calls only see the projects visible to the
+// user of their context, its own and the shared ones, and only change its own,
+// returning PermissionDenied for shared ones. The maintenance calls Purge,
+// Rebalance and PurgeChanges work on all projects.
+//
 //go:generate mockery --name ProjectStorage --output ./mocks/
 type ProjectStorage interface {
+	// Create stores a new project owned by the user of ctx and places it
+	// after all other projects.
 	Create(ctx context.Context, project *projectmodels.Project) *status.Status
+	// BatchCreate stores all projects like Create or, on any error, none.
+	BatchCreate(ctx context.Context, projects []*projectmodels.Project) *status.Status
+	Get(ctx context.Context, name string) (*projectmodels.Project, *status.Status)
+	// BatchGet returns the projects with the given names in their order and
+	// NotFound if any of them does not exist.
+	BatchGet(ctx context.Context, names []string) ([]*projectmodels.Project, *status.Status)
+	// List returns up to query.PageSize+1 projects; the extra project tells
+	// the caller that another page exists.
+	List(ctx context.Context, query ListProjectsQuery) ([]*projectmodels.Project, *status.Status)
+	// Update stores project if the stored project is still at
+	// project.Version and returns Aborted otherwise. On success project.Version
+	// is the new version.
+	Update(ctx context.Context, project *projectmodels.Project) *status.Status
+	// BatchUpdate stores all projects like Update or, on any error, none.
+	BatchUpdate(ctx context.Context, projects []*projectmodels.Project) *status.Status
+	// Transition moves a project from state from to state to if it is still
+	// in state from, and returns FailedPrecondition otherwise.
+	Transition(ctx context.Context, name string, from, to projectmodels.ProjectState, updateTime time.Time) (*projectmodels.Project, *status.Status)
+	// Purge hard-deletes DELETED projects whose purge time is not after before.
+	Purge(ctx context.Context, before time.Time) (int64, *status.Status)
+	// Move gives a project a position right after or, if after is false,
+	// right before the anchor project.
+	Move(ctx context.Context, name, anchor string, after bool, updateTime time.Time) (*projectmodels.Project, *status.Status)
+	// Rebalance respaces the positions of the tenants with a position longer
+	// than maxLength and returns how many projects were repositioned.
+	Rebalance(ctx context.Context, maxLength int) (int64, *status.Status)
+	// LastChange returns the sequence number of the latest change visible to
+	// the user of ctx, zero if there is none.
+	LastChange(ctx context.Context) (int64, *status.Status)
+	// ListChanges returns up to limit changes after the change after in
+	// commit order, with their projects as they were committed. Returns
+	// FailedPrecondition if those changes are no longer stored.
+	ListChanges(ctx context.Context, after int64, limit int) ([]*projectmodels.ProjectChange, *status.Status)
+	// PurgeChanges removes changes recorded before the given time, except
+	// the latest one.
+	PurgeChanges(ctx context.Context, before time.Time) (int64, *status.Status)
+}
+
+//go:generate mockery --name Idempotency --output ./mocks/
+type Idempotency interface {
+	// Run calls call once per key and replays the stored response, which
+	// call fills in, to retries of the key with an equal request.
+	Run(ctx context.Context, key string, request, response any, call func() *status.Status) *status.Status
+}
+
+//go:generate mockery --name ChangeNotifier --output ./mocks/
+type ChangeNotifier interface {
+	// Subscribe returns a channel that receives a value after projects have
+	// changed and a function that ends the subscription. The channel is
+	// closed when the server shuts down.
+	Subscribe() (<-chan struct{}, func())
+}
+
+// ListProjectsQuery selects one keyset page of projects.
+type ListProjectsQuery struct {
+	PageSize int
+	OrderBy  projectmodels.ProjectOrder
+	After    *ProjectCursor
+	States   []projectmodels.ProjectState
+	// Filter further restricts the listed projects. A nil filter matches all.
+	Filter *filter.Filter[*projectmodels.Project]
+}
+
+// ProjectCursor is the sort key of the last project on a page.
+type ProjectCursor struct {
+	Name        string                     `json:"n"`
+	CreatedAt   time.Time                  `json:"c"`
+	UpdatedAt   time.Time                  `json:"u"`
+	DisplayName string                     `json:"d"`
+	State       projectmodels.ProjectState `json:"s"`
+	Position    string                     `json:"p,omitempty"`
+}
+
+func NewProjectCursor(project *projectmodels.Project) ProjectCursor {
+	return ProjectCursor{
+		Name:        project.Name,
+		CreatedAt:   project.CreatedAt,
+		UpdatedAt:   project.UpdatedAt,
+		DisplayName: project.DisplayName,
+		State:       project.State,
+		Position:    project.Position,
+	}
 }
 
 type Serice struct {
 	storage ProjectStorage
+	// requests deduplicates creations that carry a request ID. Request IDs
+	// are ignored without it.
+	requests Idempotency
+	// changes wakes watchers up as soon as projects change. Without it
+	// watchers only poll.
+	changes ChangeNotifier
+
+	pageTokens      *pagetoken.Codec
+	defaultPageSize int
+	maxPageSize     int
+	retention       time.Duration
+	maxPositionLen  int
+	maxBatchSize    int
+
+	changeRetention   time.Duration
+	heartbeatInterval time.Duration
+	pollInterval      time.Duration
 }
 
 var _ projectapi.ProjectService = &Serice{}
 
-func ProjectName(projectID string) string {
-	return fmt.Sprintf("project/%s", projectID)
+type Option func(*Serice)
+
+// WithPageTokenSecret sets the key used to sign page tokens. Replicas serving
+// the same clients must share it.
+func WithPageTokenSecret(secret string) Option {
+	return func(s *Serice) {
+		s.pageTokens = pagetoken.New(secret)
+	}
 }
 
-func New(storage ProjectStorage) *Serice {
-	return &Serice{
-		storage: storage,
+// WithPageSize overrides the default and maximum list page sizes.
+func WithPageSize(defaultSize, maxSize int) Option {
+	return func(s *Serice) {
+		if defaultSize > 0 {
+			s.defaultPageSize = defaultSize
+		}
+		if maxSize > 0 {
+			s.maxPageSize = maxSize
+		}
+	}
+}
+
+// WithRetention sets how long soft-deleted projects are kept before purging.
+func WithRetention(retention time.Duration) Option {
+	return func(s *Serice) {
+		if retention > 0 {
+			s.retention = retention
+		}
+	}
+}
+
+// WithMaxPositionLength sets the position length above which Rebalance
+// respaces positions.
+func WithMaxPositionLength(length int) Option {
+	return func(s *Serice) {
+		if length > 0 {
+			s.maxPositionLen = length
+		}
+	}
+}
+
+// WithIdempotency makes creations with the same request ID create the project
+// only once.
+func WithIdempotency(requests Idempotency) Option {
+	return func(s *Serice) {
+		s.requests = requests
+	}
+}
+
+// WithMaxBatchSize sets how many projects a batch call may address.
+func WithMaxBatchSize(size int) Option {
+	return func(s *Serice) {
+		if size > 0 {
+			s.maxBatchSize = size
+		}
+	}
+}
+
+// WithChangeNotifier makes watchers see changes as soon as they are
+// committed instead of on their next poll.
+func WithChangeNotifier(changes ChangeNotifier) Option {
+	return func(s *Serice) {
+		s.changes = changes
+	}
+}
+
+// WithChangeRetention sets how long changes are kept for watchers to resume
+// from.
+func WithChangeRetention(retention time.Duration) Option {
+	return func(s *Serice) {
+		if retention > 0 {
+			s.changeRetention = retention
+		}
+	}
+}
+
+// WithWatchIntervals sets how often idle watch streams get a heartbeat and
+// how often watchers poll for changes.
+func WithWatchIntervals(heartbeat, poll time.Duration) Option {
+	return func(s *Serice) {
+		if heartbeat > 0 {
+			s.heartbeatInterval = heartbeat
+		}
+		if poll > 0 {
+			s.pollInterval = poll
+		}
+	}
+}
+
+func New(storage ProjectStorage, opts ...Option) *Serice {
+	s := &Serice{
+		storage:         storage,
+		defaultPageSize: DefaultPageSize,
+		maxPageSize:     MaxPageSize,
+		retention:       DefaultRetention,
+		maxPositionLen:  DefaultMaxPositionLength,
+		maxBatchSize:    DefaultMaxBatchSize,
+
+		changeRetention:   DefaultChangeRetention,
+		heartbeatInterval: DefaultHeartbeatInterval,
+		pollInterval:      DefaultPollInterval,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.pageTokens == nil {
+		s.pageTokens = pagetoken.New("")
 	}
+	return s
 }
 
+// Create stores a new project with its color tag normalized. Retries of a
+// creation with a request ID get the project created by the first call.
 func (s *Serice) Create(ctx context.Context, args projectapi.CreateProjectArgs) *status.Status {
-	args.Project.Name = ProjectName(args.ProjectID)
+	if args.RequestID == "" || s.requests == nil {
+		return s.create(ctx, args)
+	}
+	return s.requests.Run(ctx, "CreateProject/"+args.RequestID, args, args.Project, func() *status.Status {
+		return s.create(ctx, args)
+	})
+}
+
+func (s *Serice) create(ctx context.Context, args projectapi.CreateProjectArgs) *status.Status {
+	if err := prepareCreate(args); err != nil {
+		return apierror.BadRequest("invalid color_tag", apierror.Violation("project.color_tag", err.Error()))
+	}
 	return s.storage.Create(ctx, args.Project)
 }
+
+// prepareCreate names the project of a creation and normalizes its color tag.
+// New projects are ACTIVE and created now, whatever the client sent.
+func prepareCreate(args projectapi.CreateProjectArgs) error {
+	colorTag, err := projectmodels.NormalizeColor(args.Project.ColorTag)
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	args.Project.Name = resourcename.ProjectName(args.ProjectID)
+	args.Project.ColorTag = colorTag
+	args.Project.State = projectmodels.ActiveProjectState
+	args.Project.CreatedAt = now
+	args.Project.UpdatedAt = now
+	return nil
+}
+
+// BatchCreate stores all requested projects in one transaction.
+func (s *Serice) BatchCreate(ctx context.Context, args projectapi.BatchCreateProjectsArgs) ([]*projectmodels.Project, *status.Status) {
+	if stat := s.checkBatchSize("requests", len(args.Requests)); stat != nil {
+		return nil, stat
+	}
+
+	projects := make([]*projectmodels.Project, 0, len(args.Requests))
+	var violations []*errdetails.BadRequest_FieldViolation
+	for i, request := range args.Requests {
+		if err := prepareCreate(request); err != nil {
+			violations = append(violations, apierror.Violation(fmt.Sprintf("requests[%d].project.color_tag", i), err.Error()))
+		}
+		projects = append(projects, request.Project)
+	}
+	if len(violations) > 0 {
+		return nil, apierror.BadRequest("invalid color_tag", violations...)
+	}
+
+	if stat := s.storage.BatchCreate(ctx, projects); stat != nil {
+		return nil, stat
+	}
+	return projects, nil
+}
+
+// BatchGet reads the requested projects. Deleted projects are not found.
+func (s *Serice) BatchGet(ctx context.Context, args projectapi.BatchGetProjectsArgs) ([]*projectmodels.Project, *status.Status) {
+	if stat := s.checkBatchSize("names", len(args.ProjectIDs)); stat != nil {
+		return nil, stat
+	}
+	return s.batchGet(ctx, args.ProjectIDs)
+}
+
+// BatchDelete soft-deletes the requested projects in one transaction.
+func (s *Serice) BatchDelete(ctx context.Context, args projectapi.BatchDeleteProjectsArgs) ([]*projectmodels.Project, *status.Status) {
+	if stat := s.checkBatchSize("names", len(args.ProjectIDs)); stat != nil {
+		return nil, stat
+	}
+
+	projects, stat := s.batchGet(ctx, args.ProjectIDs)
+	if stat != nil {
+		return nil, stat
+	}
+
+	now := time.Now().UTC()
+	for _, project := range projects {
+		project.State = projectmodels.DeletedprojectState
+		project.UpdatedAt = now
+		project.DeleteTime = now
+		project.PurgeTime = now.Add(s.retention)
+	}
+
+	if stat := s.storage.BatchUpdate(ctx, projects); stat != nil {
+		return nil, stat
+	}
+	return projects, nil
+}
+
+func (s *Serice) batchGet(ctx context.Context, projectIDs []string) ([]*projectmodels.Project, *status.Status) {
+	names := make([]string, 0, len(projectIDs))
+	for _, projectID := range projectIDs {
+		names = append(names, resourcename.ProjectName(projectID))
+	}
+
+	projects, stat := s.storage.BatchGet(ctx, names)
+	if stat != nil {
+		return nil, stat
+	}
+
+	for _, project := range projects {
+		if project.State == projectmodels.DeletedprojectState {
+			return nil, apierror.NotFound(resourcename.Project.Type(), project.Name)
+		}
+	}
+	return projects, nil
+}
+
+// checkBatchSize returns InvalidArgument if a batch addresses more projects
+// than allowed.
+func (s *Serice) checkBatchSize(field string, size int) *status.Status {
+	if size <= s.maxBatchSize {
+		return nil
+	}
+	return apierror.BadRequest("batch too large",
+		apierror.Violation(field, fmt.Sprintf("batch of %d projects exceeds the maximum of %d", size, s.maxBatchSize)))
+}
+
+func (s *Serice) Get(ctx context.Context, args projectapi.GetProjectArgs) (*projectmodels.Project, *status.Status) {
+	name := resourcename.ProjectName(args.ProjectID)
+
+	project, stat := s.storage.Get(ctx, name)
+	if stat != nil {
+		return nil, stat
+	}
+
+	if project.State == projectmodels.DeletedprojectState {
+		return nil, apierror.NotFound(resourcename.Project.Type(), name)
+	}
+
+	return project, nil
+}
+
+func (s *Serice) List(ctx context.Context, args projectapi.ListProjectsArgs) ([]*projectmodels.Project, string, *status.Status) {
+	projectFilter, err := filter.Compile(args.Filter, projectmodels.ProjectFilterSchema)
+	if err != nil {
+		return nil, "", apierror.BadRequest("invalid filter", apierror.Violation("filter", err.Error()))
+	}
+
+	query := ListProjectsQuery{
+		PageSize: s.pageSize(args.PageSize),
+		OrderBy:  args.OrderBy,
+		States:   visibleStates(args.ShowArchived, args.ShowDeleted),
+		Filter:   projectFilter,
+	}
+
+	fingerprint := listFingerprint(args)
+	if args.PageToken != "" {
+		var cursor ProjectCursor
+		if err := s.pageTokens.Decode(args.PageToken, fingerprint, &cursor); err != nil {
+			return nil, "", apierror.BadRequest("invalid page token", apierror.Violation("page_token", err.Error()))
+		}
+		query.After = &cursor
+	}
+
+	projects, stat := s.storage.List(ctx, query)
+	if stat != nil {
+		return nil, "", stat
+	}
+
+	if len(projects) <= query.PageSize {
+		return projects, "", nil
+	}
+
+	projects = projects[:query.PageSize]
+	nextPageToken, err := s.pageTokens.Encode(fingerprint, NewProjectCursor(projects[len(projects)-1]))
+	if err != nil {
+		return nil, "", status.Newf(codes.Internal, "cannot issue page token: %v", err)
+	}
+
+	return projects, nextPageToken, nil
+}
+
+func (s *Serice) Update(ctx context.Context, args projectapi.UpdateProjectArgs) (*projectmodels.Project, *status.Status) {
+	project, stat := s.Get(ctx, projectapi.GetProjectArgs{ProjectID: args.ProjectID})
+	if stat != nil {
+		return nil, stat
+	}
+	if stat := checkEtag(project, args.Etag); stat != nil {
+		return nil, stat
+	}
+
+	for _, field := range args.Fields {
+		switch field {
+		case projectmodels.DisplayNameField:
+			project.DisplayName = args.Project.DisplayName
+		case projectmodels.DescriptionField:
+			project.Description = args.Project.Description
+		case projectmodels.ColorTagField:
+			colorTag, err := projectmodels.NormalizeColor(args.Project.ColorTag)
+			if err != nil {
+				return nil, apierror.BadRequest("invalid color_tag", apierror.Violation("project.color_tag", err.Error()))
+			}
+			project.ColorTag = colorTag
+		case projectmodels.StateField:
+			if !project.State.CanTransitionTo(args.Project.State) {
+				return nil, invalidState(project, fmt.Sprintf("project %q cannot move from %s to %s",
+					project.Name, projectmodels.ProjectStateToGRPC(project.State), projectmodels.ProjectStateToGRPC(args.Project.State)))
+			}
+			project.State = args.Project.State
+		case projectmodels.LabelsField:
+			project.Labels = args.Project.Labels
+		default:
+			return nil, apierror.BadRequest("invalid update mask", apierror.Violation("update_mask", fmt.Sprintf("field %q cannot be updated", field)))
+		}
+	}
+	project.UpdatedAt = time.Now().UTC()
+
+	if stat := s.storage.Update(ctx, project); stat != nil {
+		return nil, stat
+	}
+
+	return project, nil
+}
+
+// Delete soft-deletes a project. It stays restorable until its purge time.
+func (s *Serice) Delete(ctx context.Context, args projectapi.DeleteProjectArgs) (*projectmodels.Project, *status.Status) {
+	project, stat := s.Get(ctx, projectapi.GetProjectArgs{ProjectID: args.ProjectID})
+	if stat != nil {
+		return nil, stat
+	}
+	if stat := checkEtag(project, args.Etag); stat != nil {
+		return nil, stat
+	}
+
+	now := time.Now().UTC()
+	project.State = projectmodels.DeletedprojectState
+	project.UpdatedAt = now
+	project.DeleteTime = now
+	project.PurgeTime = now.Add(s.retention)
+
+	if stat := s.storage.Update(ctx, project); stat != nil {
+		return nil, stat
+	}
+
+	return project, nil
+}
+
+// Undelete restores a soft-deleted project to the ACTIVE state.
+func (s *Serice) Undelete(ctx context.Context, args projectapi.UndeleteProjectArgs) (*projectmodels.Project, *status.Status) {
+	name := resourcename.ProjectName(args.ProjectID)
+
+	project, stat := s.storage.Get(ctx, name)
+	if stat != nil {
+		return nil, stat
+	}
+
+	if project.State != projectmodels.DeletedprojectState {
+		return nil, invalidState(project, fmt.Sprintf("project %q is not deleted", name))
+	}
+
+	project.State = projectmodels.ActiveProjectState
+	project.UpdatedAt = time.Now().UTC()
+	project.DeleteTime = time.Time{}
+	project.PurgeTime = time.Time{}
+
+	if stat := s.storage.Update(ctx, project); stat != nil {
+		return nil, stat
+	}
+
+	return project, nil
+}
+
+// Archive moves an ACTIVE project to the ARCHIVED state.
+func (s *Serice) Archive(ctx context.Context, args projectapi.ArchiveProjectArgs) (*projectmodels.Project, *status.Status) {
+	return s.storage.Transition(ctx, resourcename.ProjectName(args.ProjectID),
+		projectmodels.ActiveProjectState, projectmodels.ArchivedProjectState, time.Now().UTC())
+}
+
+// Unarchive moves an ARCHIVED project back to the ACTIVE state.
+func (s *Serice) Unarchive(ctx context.Context, args projectapi.UnarchiveProjectArgs) (*projectmodels.Project, *status.Status) {
+	return s.storage.Transition(ctx, resourcename.ProjectName(args.ProjectID),
+		projectmodels.ArchivedProjectState, projectmodels.ActiveProjectState, time.Now().UTC())
+}
+
+// Purge permanently removes soft-deleted projects past their purge time and
+// changes past their retention. The purges are recorded as changes
+// themselves.
+func (s *Serice) Purge(ctx context.Context) (int64, *status.Status) {
+	now := time.Now().UTC()
+
+	projects, stat := s.storage.Purge(ctx, now)
+	if stat != nil {
+		return 0, stat
+	}
+	changes, stat := s.storage.PurgeChanges(ctx, now.Add(-s.changeRetention))
+	if stat != nil {
+		return projects, stat
+	}
+	return projects + changes, nil
+}
+
+// Move places a project right after or right before another project.
+// Deleted projects can neither be moved nor serve as anchors.
+func (s *Serice) Move(ctx context.Context, args projectapi.MoveProjectArgs) (*projectmodels.Project, *status.Status) {
+	if args.ProjectID == args.AnchorID {
+		return nil, status.New(codes.InvalidArgument, "project cannot be moved next to itself")
+	}
+
+	for _, projectID := range []string{args.ProjectID, args.AnchorID} {
+		if _, stat := s.Get(ctx, projectapi.GetProjectArgs{ProjectID: projectID}); stat != nil {
+			return nil, stat
+		}
+	}
+
+	return s.storage.Move(ctx, resourcename.ProjectName(args.ProjectID), resourcename.ProjectName(args.AnchorID), args.After, time.Now().UTC())
+}
+
+// Rebalance respaces project positions once they grow too long.
+func (s *Serice) Rebalance(ctx context.Context) (int64, *status.Status) {
+	return s.storage.Rebalance(ctx, s.maxPositionLen)
+}
+
+// ChangeCursor is the position of a watcher in the change log.
+type ChangeCursor struct {
+	Seq int64 `json:"s"`
+}
+
+// watchFingerprint binds change tokens to WatchProjects, so that page tokens
+// cannot be passed instead.
+const watchFingerprint = "watch"
+
+// Watch streams the changes after args.ChangeToken or, without a token, after
+// the call started. The first message is a heartbeat carrying the starting
+// token. Changes are read whenever the notifier signals, on every poll
+// interval and before every heartbeat.
+func (s *Serice) Watch(ctx context.Context, args projectapi.WatchProjectsArgs, send func(*projectmodels.ProjectChange) error) *status.Status {
+	var cursor ChangeCursor
+	if args.ChangeToken != "" {
+		if err := s.pageTokens.Decode(args.ChangeToken, watchFingerprint, &cursor); err != nil {
+			return apierror.BadRequest("invalid change token", apierror.Violation("change_token", err.Error()))
+		}
+	} else {
+		seq, stat := s.storage.LastChange(ctx)
+		if stat != nil {
+			return stat
+		}
+		cursor.Seq = seq
+	}
+
+	var wake <-chan struct{}
+	if s.changes != nil {
+		var unsubscribe func()
+		wake, unsubscribe = s.changes.Subscribe()
+		defer unsubscribe()
+	}
+
+	heartbeats := time.NewTicker(s.heartbeatInterval)
+	defer heartbeats.Stop()
+	polls := time.NewTicker(s.pollInterval)
+	defer polls.Stop()
+
+	if stat := s.sendHeartbeat(cursor, send); stat != nil {
+		return stat
+	}
+	for {
+		changes, stat := s.storage.ListChanges(ctx, cursor.Seq, changeBatchSize)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if stat != nil {
+			if stat.Code() == codes.FailedPrecondition {
+				return apierror.New(codes.FailedPrecondition, apierror.ReasonChangeTokenExpired, nil,
+					"change token expired, list the projects again and watch without a token")
+			}
+			return stat
+		}
+
+		for _, change := range changes {
+			cursor.Seq = change.Seq
+			token, err := s.pageTokens.Encode(watchFingerprint, cursor)
+			if err != nil {
+				return status.Newf(codes.Internal, "cannot issue change token: %v", err)
+			}
+			change.Token = token
+			if err := send(change); err != nil {
+				return status.Convert(err)
+			}
+		}
+		if len(changes) == changeBatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case _, ok := <-wake:
+			if !ok {
+				return apierror.Unavailable(apierror.ReasonShuttingDown,
+					"server is shutting down, resume watching with the last change token", apierror.RetryDelay)
+			}
+		case <-polls.C:
+		case <-heartbeats.C:
+			if stat := s.sendHeartbeat(cursor, send); stat != nil {
+				return stat
+			}
+		}
+	}
+}
+
+func (s *Serice) sendHeartbeat(cursor ChangeCursor, send func(*projectmodels.ProjectChange) error) *status.Status {
+	token, err := s.pageTokens.Encode(watchFingerprint, cursor)
+	if err != nil {
+		return status.Newf(codes.Internal, "cannot issue change token: %v", err)
+	}
+
+	err = send(&projectmodels.ProjectChange{
+		Seq:   cursor.Seq,
+		Type:  projectmodels.HeartbeatChangeType,
+		Time:  time.Now().UTC(),
+		Token: token,
+	})
+	if err != nil {
+		return status.Convert(err)
+	}
+	return nil
+}
+
+// checkEtag returns Aborted if an etag was given and it does not match the
+// current version of project.
+func checkEtag(project *projectmodels.Project, etag string) *status.Status {
+	if etag == "" || project.MatchesEtag(etag) {
+		return nil
+	}
+	return apierror.New(codes.Aborted, apierror.ReasonEtagMismatch,
+		apierror.Metadata{"resourceName": project.Name, "etag": project.Etag()},
+		fmt.Sprintf("etag %s does not match the current etag %s of project %q", etag, project.Etag(), project.Name),
+		apierror.Resource(resourcename.Project.Type(), project.Name, ""))
+}
+
+// invalidState returns FailedPrecondition for a request the current state of
+// project does not allow.
+func invalidState(project *projectmodels.Project, message string) *status.Status {
+	return apierror.New(codes.FailedPrecondition, apierror.ReasonInvalidState,
+		apierror.Metadata{"resourceName": project.Name, "state": projectmodels.ProjectStateToGRPC(project.State).String()},
+		message, apierror.Resource(resourcename.Project.Type(), project.Name, ""))
+}
+
+func (s *Serice) pageSize(requested int32) int {
+	switch {
+	case requested <= 0:
+		return s.defaultPageSize
+	case int(requested) > s.maxPageSize:
+		return s.maxPageSize
+	default:
+		return int(requested)
+	}
+}
+
+func visibleStates(showArchived, showDeleted bool) []projectmodels.ProjectState {
+	states := []projectmodels.ProjectState{projectmodels.ActiveProjectState}
+	if showArchived {
+		states = append(states, projectmodels.ArchivedProjectState)
+	}
+	if showDeleted {
+		states = append(states, projectmodels.DeletedprojectState)
+	}
+	return states
+}
+
+// listFingerprint describes every list parameter a page token is bound to.
+func listFingerprint(args projectapi.ListProjectsArgs) string {
+	return fmt.Sprintf("filter=%q;order_by=%s;show_archived=%t;show_deleted=%t",
+		args.Filter, args.OrderBy, args.ShowArchived, args.ShowDeleted)
+}
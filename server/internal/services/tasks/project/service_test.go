@@ -1 +1,1094 @@
 package projectsrv_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	projectmodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/project"
+	"github.com/10Narratives/ready-to-do/server/internal/resourcename"
+	projectsrv "github.com/10Narratives/ready-to-do/server/internal/services/tasks/project"
+	"github.com/10Narratives/ready-to-do/server/internal/services/tasks/project/mocks"
+	projectapi "github.com/10Narratives/ready-to-do/server/internal/transport/grpc/tasks/project"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSerice_Create(t *testing.T) {
+	t.Parallel()
+
+	const projectID = "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"
+
+	tests := []struct {
+		name         string
+		colorTag     string
+		wantColorTag string
+		wantCode     codes.Code
+	}{
+		{
+			name:         "hex color is normalized",
+			colorTag:     "#F00",
+			wantColorTag: "#ff0000",
+			wantCode:     codes.OK,
+		},
+		{
+			name:         "named color is normalized",
+			colorTag:     "green",
+			wantColorTag: "#22c55e",
+			wantCode:     codes.OK,
+		},
+		{
+			name:     "unknown color is rejected",
+			colorTag: "garbage",
+			wantCode: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			storageMock := mocks.NewProjectStorage(t)
+			if tt.wantCode == codes.OK {
+				storageMock.On("Create", mock.Anything, mock.MatchedBy(func(project *projectmodels.Project) bool {
+					return project.Name == resourcename.ProjectName(projectID) && project.ColorTag == tt.wantColorTag
+				})).Return(nil)
+			}
+
+			stat := projectsrv.New(storageMock).Create(context.Background(), projectapi.CreateProjectArgs{
+				ProjectID: projectID,
+				Project:   &projectmodels.Project{ColorTag: tt.colorTag},
+			})
+			require.Equal(t, tt.wantCode, stat.Code())
+		})
+	}
+}
+
+func TestSerice_Create_ServerFields(t *testing.T) {
+	t.Parallel()
+
+	const projectID = "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"
+
+	var stored *projectmodels.Project
+	storageMock := mocks.NewProjectStorage(t)
+	storageMock.On("Create", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			stored = args.Get(1).(*projectmodels.Project)
+		}).Return(nil).Once()
+
+	before := time.Now().UTC()
+	stat := projectsrv.New(storageMock).Create(context.Background(), projectapi.CreateProjectArgs{
+		ProjectID: projectID,
+		Project: &projectmodels.Project{
+			State:     projectmodels.DeletedprojectState,
+			CreatedAt: time.Unix(0, 0).UTC(),
+			UpdatedAt: time.Unix(0, 0).UTC(),
+		},
+	})
+	require.Nil(t, stat)
+
+	assert.Equal(t, projectmodels.ActiveProjectState, stored.State)
+	assert.False(t, stored.CreatedAt.Before(before))
+	assert.Equal(t, stored.CreatedAt, stored.UpdatedAt)
+	assert.True(t, stored.DeleteTime.IsZero())
+}
+
+func TestSerice_Create_RequestID(t *testing.T) {
+	t.Parallel()
+
+	const (
+		projectID = "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"
+		requestID = "b1ffcd88-8d1a-4ef8-bb6d-6bb9bd380a22"
+	)
+	key := "CreateProject/" + requestID
+
+	t.Run("first call creates the project", func(t *testing.T) {
+		t.Parallel()
+
+		storageMock := mocks.NewProjectStorage(t)
+		storageMock.On("Create", mock.Anything, mock.Anything).Return(nil).Once()
+		requestsMock := mocks.NewIdempotency(t)
+		requestsMock.On("Run", mock.Anything, key, mock.Anything, mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				args.Get(4).(func() *status.Status)()
+			}).Return(nil).Once()
+
+		service := projectsrv.New(storageMock, projectsrv.WithIdempotency(requestsMock))
+		args := projectapi.CreateProjectArgs{ProjectID: projectID, Project: &projectmodels.Project{}, RequestID: requestID}
+		require.Nil(t, service.Create(context.Background(), args))
+		assert.Equal(t, resourcename.ProjectName(projectID), args.Project.Name)
+	})
+
+	t.Run("retry replays the stored project", func(t *testing.T) {
+		t.Parallel()
+
+		storageMock := mocks.NewProjectStorage(t)
+		requestsMock := mocks.NewIdempotency(t)
+		requestsMock.On("Run", mock.Anything, key, mock.Anything, mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				*args.Get(3).(*projectmodels.Project) = projectmodels.Project{
+					Name:    resourcename.ProjectName(projectID),
+					Version: 1,
+				}
+			}).Return(nil).Once()
+
+		service := projectsrv.New(storageMock, projectsrv.WithIdempotency(requestsMock))
+		args := projectapi.CreateProjectArgs{ProjectID: projectID, Project: &projectmodels.Project{}, RequestID: requestID}
+		require.Nil(t, service.Create(context.Background(), args))
+		assert.Equal(t, int64(1), args.Project.Version)
+	})
+
+	t.Run("reused request ID", func(t *testing.T) {
+		t.Parallel()
+
+		requestsMock := mocks.NewIdempotency(t)
+		requestsMock.On("Run", mock.Anything, key, mock.Anything, mock.Anything, mock.Anything).
+			Return(status.New(codes.FailedPrecondition, "different request")).Once()
+
+		service := projectsrv.New(mocks.NewProjectStorage(t), projectsrv.WithIdempotency(requestsMock))
+		stat := service.Create(context.Background(), projectapi.CreateProjectArgs{
+			ProjectID: projectID,
+			Project:   &projectmodels.Project{},
+			RequestID: requestID,
+		})
+		assert.Equal(t, codes.FailedPrecondition, stat.Code())
+	})
+}
+
+func TestSerice_Get(t *testing.T) {
+	t.Parallel()
+
+	var (
+		projectID string = "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"
+		project          = &projectmodels.Project{
+			Name:        "projects/" + projectID,
+			DisplayName: "the awesome project",
+			CreatedAt:   time.Now().UTC(),
+			UpdatedAt:   time.Now().UTC(),
+			State:       projectmodels.ActiveProjectState,
+		}
+		deletedProject = &projectmodels.Project{
+			Name:  "projects/" + projectID,
+			State: projectmodels.DeletedprojectState,
+		}
+	)
+
+	tests := []struct {
+		name             string
+		setupStorageMock func(m *mocks.ProjectStorage)
+		want             *projectmodels.Project
+		wantCode         codes.Code
+	}{
+		{
+			name: "successful execution",
+			setupStorageMock: func(m *mocks.ProjectStorage) {
+				m.On("Get", mock.Anything, "projects/"+projectID).Return(project, nil)
+			},
+			want:     project,
+			wantCode: codes.OK,
+		},
+		{
+			name: "soft-deleted project is hidden",
+			setupStorageMock: func(m *mocks.ProjectStorage) {
+				m.On("Get", mock.Anything, "projects/"+projectID).Return(deletedProject, nil)
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "storage status is passed up",
+			setupStorageMock: func(m *mocks.ProjectStorage) {
+				m.On("Get", mock.Anything, "projects/"+projectID).Return(nil, status.New(codes.NotFound, "project not found"))
+			},
+			wantCode: codes.NotFound,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			storageMock := mocks.NewProjectStorage(t)
+			tt.setupStorageMock(storageMock)
+
+			service := projectsrv.New(storageMock)
+			got, stat := service.Get(context.Background(), projectapi.GetProjectArgs{ProjectID: projectID})
+
+			require.Equal(t, tt.wantCode, stat.Code())
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSerice_List(t *testing.T) {
+	t.Parallel()
+
+	projects := make([]*projectmodels.Project, 0, 3)
+	for i := 0; i < 3; i++ {
+		projects = append(projects, &projectmodels.Project{
+			Name:      fmt.Sprintf("projects/a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a1%d", i),
+			CreatedAt: time.Date(2026, 1, i+1, 0, 0, 0, 0, time.UTC),
+			UpdatedAt: time.Date(2026, 1, i+1, 0, 0, 0, 0, time.UTC),
+			State:     projectmodels.ActiveProjectState,
+		})
+	}
+	args := projectapi.ListProjectsArgs{
+		PageSize: 2,
+		OrderBy:  projectmodels.ProjectOrder{Field: projectmodels.OrderByCreatedAt},
+	}
+
+	storageMock := mocks.NewProjectStorage(t)
+	storageMock.On("List", mock.Anything, projectsrv.ListProjectsQuery{
+		PageSize: 2,
+		OrderBy:  args.OrderBy,
+		States:   []projectmodels.ProjectState{projectmodels.ActiveProjectState},
+	}).Return(projects, nil).Once()
+	storageMock.On("List", mock.Anything, mock.MatchedBy(func(query projectsrv.ListProjectsQuery) bool {
+		return query.After != nil && query.After.Name == projects[1].Name
+	})).Return(projects[2:], nil).Once()
+
+	service := projectsrv.New(storageMock, projectsrv.WithPageTokenSecret("secret"))
+
+	firstPage, nextPageToken, stat := service.List(context.Background(), args)
+	require.Nil(t, stat)
+	assert.Equal(t, projects[:2], firstPage)
+	require.NotEmpty(t, nextPageToken)
+
+	args.PageToken = nextPageToken
+	secondPage, nextPageToken, stat := service.List(context.Background(), args)
+	require.Nil(t, stat)
+	assert.Equal(t, projects[2:], secondPage)
+	assert.Empty(t, nextPageToken)
+
+	args.ShowDeleted = true
+	_, _, stat = service.List(context.Background(), args)
+	assert.Equal(t, codes.InvalidArgument, stat.Code())
+}
+
+func TestSerice_List_Visibility(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		args       projectapi.ListProjectsArgs
+		wantStates []projectmodels.ProjectState
+		wantSize   int
+	}{
+		{
+			name:       "active only by default",
+			args:       projectapi.ListProjectsArgs{},
+			wantStates: []projectmodels.ProjectState{projectmodels.ActiveProjectState},
+			wantSize:   projectsrv.DefaultPageSize,
+		},
+		{
+			name: "archived and deleted on request",
+			args: projectapi.ListProjectsArgs{PageSize: 5000, ShowArchived: true, ShowDeleted: true},
+			wantStates: []projectmodels.ProjectState{
+				projectmodels.ActiveProjectState,
+				projectmodels.ArchivedProjectState,
+				projectmodels.DeletedprojectState,
+			},
+			wantSize: projectsrv.MaxPageSize,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			storageMock := mocks.NewProjectStorage(t)
+			storageMock.On("List", mock.Anything, projectsrv.ListProjectsQuery{
+				PageSize: tt.wantSize,
+				States:   tt.wantStates,
+			}).Return([]*projectmodels.Project{}, nil)
+
+			service := projectsrv.New(storageMock)
+			_, _, stat := service.List(context.Background(), tt.args)
+			require.Nil(t, stat)
+		})
+	}
+}
+
+func TestSerice_List_Filter(t *testing.T) {
+	t.Parallel()
+
+	active := &projectmodels.Project{Name: "projects/1", State: projectmodels.ActiveProjectState}
+	archived := &projectmodels.Project{Name: "projects/2", State: projectmodels.ArchivedProjectState}
+
+	storageMock := mocks.NewProjectStorage(t)
+	storageMock.On("List", mock.Anything, mock.MatchedBy(func(query projectsrv.ListProjectsQuery) bool {
+		return query.Filter.Match(active) && !query.Filter.Match(archived)
+	})).Return([]*projectmodels.Project{active}, nil).Once()
+
+	service := projectsrv.New(storageMock)
+
+	projects, _, stat := service.List(context.Background(), projectapi.ListProjectsArgs{
+		Filter:       "state = ACTIVE",
+		ShowArchived: true,
+	})
+	require.Nil(t, stat)
+	assert.Equal(t, []*projectmodels.Project{active}, projects)
+
+	_, _, stat = service.List(context.Background(), projectapi.ListProjectsArgs{Filter: "state = ACTIVE AND colour = red"})
+	require.NotNil(t, stat)
+	assert.Equal(t, codes.InvalidArgument, stat.Code())
+	assert.Contains(t, stat.Message(), "position 20")
+}
+
+func TestSerice_Update(t *testing.T) {
+	t.Parallel()
+
+	const projectID = "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"
+	createdAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	stored := func(state projectmodels.ProjectState) *projectmodels.Project {
+		return &projectmodels.Project{
+			Name:        resourcename.ProjectName(projectID),
+			DisplayName: "old name",
+			Description: "old description",
+			ColorTag:    "#000000",
+			CreatedAt:   createdAt,
+			UpdatedAt:   createdAt,
+			State:       state,
+			Version:     2,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		state       projectmodels.ProjectState
+		target      projectmodels.ProjectState
+		fields      []string
+		etag        string
+		wantUpdated bool
+		want        *projectmodels.Project
+		wantCode    codes.Code
+	}{
+		{
+			name:        "only masked fields change",
+			state:       projectmodels.ActiveProjectState,
+			fields:      []string{projectmodels.DisplayNameField},
+			wantUpdated: true,
+			want: &projectmodels.Project{
+				Name:        resourcename.ProjectName(projectID),
+				DisplayName: "new name",
+				Description: "old description",
+				ColorTag:    "#000000",
+				CreatedAt:   createdAt,
+				Version:     2,
+				State:       projectmodels.ActiveProjectState,
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:        "archiving",
+			state:       projectmodels.ActiveProjectState,
+			fields:      projectmodels.UpdatableProjectFields,
+			wantUpdated: true,
+			want: &projectmodels.Project{
+				Name:        resourcename.ProjectName(projectID),
+				DisplayName: "new name",
+				Description: "new description",
+				ColorTag:    "#ffffff",
+				CreatedAt:   createdAt,
+				Version:     2,
+				State:       projectmodels.ArchivedProjectState,
+				Labels:      []string{"labels/urgent"},
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:        "labels are replaced",
+			state:       projectmodels.ActiveProjectState,
+			fields:      []string{projectmodels.LabelsField},
+			wantUpdated: true,
+			want: &projectmodels.Project{
+				Name:        resourcename.ProjectName(projectID),
+				DisplayName: "old name",
+				Description: "old description",
+				ColorTag:    "#000000",
+				CreatedAt:   createdAt,
+				Version:     2,
+				State:       projectmodels.ActiveProjectState,
+				Labels:      []string{"labels/urgent"},
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:        "matching etag",
+			state:       projectmodels.ActiveProjectState,
+			fields:      []string{projectmodels.DescriptionField},
+			etag:        `"2"`,
+			wantUpdated: true,
+			want: &projectmodels.Project{
+				Name:        resourcename.ProjectName(projectID),
+				DisplayName: "old name",
+				Description: "new description",
+				ColorTag:    "#000000",
+				CreatedAt:   createdAt,
+				Version:     2,
+				State:       projectmodels.ActiveProjectState,
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "stale etag",
+			state:    projectmodels.ActiveProjectState,
+			fields:   []string{projectmodels.DescriptionField},
+			etag:     `"1"`,
+			wantCode: codes.Aborted,
+		},
+		{
+			name:     "deleting through update is rejected",
+			state:    projectmodels.ActiveProjectState,
+			target:   projectmodels.DeletedprojectState,
+			fields:   []string{projectmodels.StateField},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:     "deleted project is not found",
+			state:    projectmodels.DeletedprojectState,
+			fields:   []string{projectmodels.DisplayNameField},
+			wantCode: codes.NotFound,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			storageMock := mocks.NewProjectStorage(t)
+			storageMock.On("Get", mock.Anything, resourcename.ProjectName(projectID)).Return(stored(tt.state), nil)
+			if tt.wantUpdated {
+				storageMock.On("Update", mock.Anything, mock.Anything).Return(nil)
+			}
+
+			update := &projectmodels.Project{
+				Name:        resourcename.ProjectName(projectID),
+				DisplayName: "new name",
+				Description: "new description",
+				ColorTag:    "#ffffff",
+				State:       projectmodels.ArchivedProjectState,
+				Labels:      []string{"labels/urgent"},
+			}
+			if tt.target != projectmodels.UnspecifiedProjectState {
+				update.State = tt.target
+			}
+
+			service := projectsrv.New(storageMock)
+			got, stat := service.Update(context.Background(), projectapi.UpdateProjectArgs{
+				ProjectID: projectID,
+				Project:   update,
+				Fields:    tt.fields,
+				Etag:      tt.etag,
+			})
+
+			require.Equal(t, tt.wantCode, stat.Code())
+			if tt.want == nil {
+				assert.Nil(t, got)
+				return
+			}
+			assert.WithinDuration(t, time.Now(), got.UpdatedAt, time.Minute)
+			got.UpdatedAt = time.Time{}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSerice_Delete(t *testing.T) {
+	t.Parallel()
+
+	const projectID = "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"
+	name := resourcename.ProjectName(projectID)
+
+	tests := []struct {
+		name        string
+		etag        string
+		updateStat  *status.Status
+		wantUpdated bool
+		wantCode    codes.Code
+	}{
+		{
+			name:        "without etag",
+			wantUpdated: true,
+			wantCode:    codes.OK,
+		},
+		{
+			name:        "matching etag",
+			etag:        `"4"`,
+			wantUpdated: true,
+			wantCode:    codes.OK,
+		},
+		{
+			name:     "stale etag",
+			etag:     `"3"`,
+			wantCode: codes.Aborted,
+		},
+		{
+			name:        "concurrent change",
+			updateStat:  status.New(codes.Aborted, "project was changed concurrently"),
+			wantUpdated: true,
+			wantCode:    codes.Aborted,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			storageMock := mocks.NewProjectStorage(t)
+			storageMock.On("Get", mock.Anything, name).Return(&projectmodels.Project{
+				Name:    name,
+				State:   projectmodels.ArchivedProjectState,
+				Version: 4,
+			}, nil).Once()
+			if tt.wantUpdated {
+				storageMock.On("Update", mock.Anything, mock.MatchedBy(func(project *projectmodels.Project) bool {
+					return project.State == projectmodels.DeletedprojectState && project.Version == 4
+				})).Return(tt.updateStat).Once()
+			}
+
+			service := projectsrv.New(storageMock, projectsrv.WithRetention(time.Hour))
+
+			project, stat := service.Delete(context.Background(), projectapi.DeleteProjectArgs{ProjectID: projectID, Etag: tt.etag})
+			require.Equal(t, tt.wantCode, stat.Code())
+			if tt.wantCode != codes.OK {
+				assert.Nil(t, project)
+				return
+			}
+			assert.Equal(t, projectmodels.DeletedprojectState, project.State)
+			assert.WithinDuration(t, time.Now(), project.DeleteTime, time.Minute)
+			assert.Equal(t, time.Hour, project.PurgeTime.Sub(project.DeleteTime))
+		})
+	}
+}
+
+func TestSerice_Undelete(t *testing.T) {
+	t.Parallel()
+
+	const projectID = "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"
+	name := resourcename.ProjectName(projectID)
+	deleteTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		state       projectmodels.ProjectState
+		wantUpdated bool
+		wantCode    codes.Code
+	}{
+		{
+			name:        "deleted project is restored",
+			state:       projectmodels.DeletedprojectState,
+			wantUpdated: true,
+			wantCode:    codes.OK,
+		},
+		{
+			name:     "active project cannot be undeleted",
+			state:    projectmodels.ActiveProjectState,
+			wantCode: codes.FailedPrecondition,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			storageMock := mocks.NewProjectStorage(t)
+			storageMock.On("Get", mock.Anything, name).Return(&projectmodels.Project{
+				Name:       name,
+				State:      tt.state,
+				DeleteTime: deleteTime,
+				PurgeTime:  deleteTime.Add(projectsrv.DefaultRetention),
+			}, nil)
+			if tt.wantUpdated {
+				storageMock.On("Update", mock.Anything, mock.Anything).Return(nil)
+			}
+
+			service := projectsrv.New(storageMock)
+			project, stat := service.Undelete(context.Background(), projectapi.UndeleteProjectArgs{ProjectID: projectID})
+
+			require.Equal(t, tt.wantCode, stat.Code())
+			if tt.wantCode != codes.OK {
+				return
+			}
+			assert.Equal(t, projectmodels.ActiveProjectState, project.State)
+			assert.True(t, project.DeleteTime.IsZero())
+			assert.True(t, project.PurgeTime.IsZero())
+		})
+	}
+}
+
+func TestSerice_Purge(t *testing.T) {
+	t.Parallel()
+
+	storageMock := mocks.NewProjectStorage(t)
+	storageMock.On("Purge", mock.Anything, mock.MatchedBy(func(before time.Time) bool {
+		return time.Since(before) < time.Minute
+	})).Return(int64(2), nil)
+	storageMock.On("PurgeChanges", mock.Anything, mock.MatchedBy(func(before time.Time) bool {
+		return time.Since(before) > 47*time.Hour
+	})).Return(int64(5), nil)
+
+	purged, stat := projectsrv.New(storageMock, projectsrv.WithChangeRetention(48*time.Hour)).Purge(context.Background())
+	require.Nil(t, stat)
+	assert.Equal(t, int64(7), purged)
+}
+
+func TestSerice_Archive(t *testing.T) {
+	t.Parallel()
+
+	const projectID = "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"
+	name := resourcename.ProjectName(projectID)
+	archived := &projectmodels.Project{Name: name, State: projectmodels.ArchivedProjectState}
+
+	storageMock := mocks.NewProjectStorage(t)
+	storageMock.On("Transition", mock.Anything, name,
+		projectmodels.ActiveProjectState, projectmodels.ArchivedProjectState, mock.Anything).Return(archived, nil).Once()
+	storageMock.On("Transition", mock.Anything, name,
+		projectmodels.ArchivedProjectState, projectmodels.ActiveProjectState, mock.Anything).
+		Return(nil, status.New(codes.FailedPrecondition, "project is DELETED, expected ARCHIVED")).Once()
+
+	service := projectsrv.New(storageMock)
+
+	project, stat := service.Archive(context.Background(), projectapi.ArchiveProjectArgs{ProjectID: projectID})
+	require.Nil(t, stat)
+	assert.Equal(t, archived, project)
+
+	_, stat = service.Unarchive(context.Background(), projectapi.UnarchiveProjectArgs{ProjectID: projectID})
+	assert.Equal(t, codes.FailedPrecondition, stat.Code())
+}
+
+func TestSerice_Move(t *testing.T) {
+	t.Parallel()
+
+	const (
+		projectID = "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"
+		anchorID  = "b0eebc99-9c0b-4ef8-bb6d-6bb9bd380a22"
+	)
+	name := resourcename.ProjectName(projectID)
+	anchor := resourcename.ProjectName(anchorID)
+
+	tests := []struct {
+		name             string
+		args             projectapi.MoveProjectArgs
+		setupStorageMock func(m *mocks.ProjectStorage)
+		wantCode         codes.Code
+	}{
+		{
+			name: "moved after anchor",
+			args: projectapi.MoveProjectArgs{ProjectID: projectID, AnchorID: anchorID, After: true},
+			setupStorageMock: func(m *mocks.ProjectStorage) {
+				m.On("Get", mock.Anything, name).Return(&projectmodels.Project{Name: name}, nil)
+				m.On("Get", mock.Anything, anchor).Return(&projectmodels.Project{Name: anchor}, nil)
+				m.On("Move", mock.Anything, name, anchor, true, mock.Anything).
+					Return(&projectmodels.Project{Name: name, Position: "VV"}, nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:             "moved next to itself",
+			args:             projectapi.MoveProjectArgs{ProjectID: projectID, AnchorID: projectID},
+			setupStorageMock: func(m *mocks.ProjectStorage) {},
+			wantCode:         codes.InvalidArgument,
+		},
+		{
+			name: "anchor is deleted",
+			args: projectapi.MoveProjectArgs{ProjectID: projectID, AnchorID: anchorID},
+			setupStorageMock: func(m *mocks.ProjectStorage) {
+				m.On("Get", mock.Anything, name).Return(&projectmodels.Project{Name: name}, nil)
+				m.On("Get", mock.Anything, anchor).Return(&projectmodels.Project{
+					Name:  anchor,
+					State: projectmodels.DeletedprojectState,
+				}, nil)
+			},
+			wantCode: codes.NotFound,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			storageMock := mocks.NewProjectStorage(t)
+			tt.setupStorageMock(storageMock)
+
+			project, stat := projectsrv.New(storageMock).Move(context.Background(), tt.args)
+			require.Equal(t, tt.wantCode, stat.Code())
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, "VV", project.Position)
+			}
+		})
+	}
+}
+
+func TestSerice_Rebalance(t *testing.T) {
+	t.Parallel()
+
+	storageMock := mocks.NewProjectStorage(t)
+	storageMock.On("Rebalance", mock.Anything, 16).Return(int64(3), nil)
+
+	rebalanced, stat := projectsrv.New(storageMock, projectsrv.WithMaxPositionLength(16)).Rebalance(context.Background())
+	require.Nil(t, stat)
+	assert.Equal(t, int64(3), rebalanced)
+}
+
+func TestSerice_BatchCreate(t *testing.T) {
+	t.Parallel()
+
+	const (
+		firstID  = "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"
+		secondID = "b1ffcd88-8d1a-4ef8-bb6d-6bb9bd380a22"
+	)
+
+	tests := []struct {
+		name           string
+		colorTags      []string
+		maxBatchSize   int
+		wantCreated    bool
+		wantCode       codes.Code
+		wantViolations []string
+	}{
+		{
+			name:        "all projects are created",
+			colorTags:   []string{"red", "#0F0"},
+			wantCreated: true,
+			wantCode:    codes.OK,
+		},
+		{
+			name:           "invalid color",
+			colorTags:      []string{"red", "garbage"},
+			wantCode:       codes.InvalidArgument,
+			wantViolations: []string{"requests[1].project.color_tag"},
+		},
+		{
+			name:           "batch too large",
+			colorTags:      []string{"red", "blue"},
+			maxBatchSize:   1,
+			wantCode:       codes.InvalidArgument,
+			wantViolations: []string{"requests"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			storageMock := mocks.NewProjectStorage(t)
+			if tt.wantCreated {
+				storageMock.On("BatchCreate", mock.Anything, mock.MatchedBy(func(projects []*projectmodels.Project) bool {
+					return len(projects) == 2 &&
+						projects[0].Name == resourcename.ProjectName(firstID) && projects[0].ColorTag == "#ef4444" &&
+						projects[1].Name == resourcename.ProjectName(secondID) && projects[1].ColorTag == "#00ff00"
+				})).Return(nil).Once()
+			}
+
+			args := projectapi.BatchCreateProjectsArgs{}
+			for i, projectID := range []string{firstID, secondID} {
+				args.Requests = append(args.Requests, projectapi.CreateProjectArgs{
+					ProjectID: projectID,
+					Project:   &projectmodels.Project{ColorTag: tt.colorTags[i]},
+				})
+			}
+
+			service := projectsrv.New(storageMock, projectsrv.WithMaxBatchSize(tt.maxBatchSize))
+			projects, stat := service.BatchCreate(context.Background(), args)
+			require.Equal(t, tt.wantCode, stat.Code())
+			if tt.wantCode == codes.OK {
+				assert.Len(t, projects, 2)
+				return
+			}
+
+			var fields []string
+			for _, detail := range stat.Details() {
+				if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+					for _, violation := range badRequest.GetFieldViolations() {
+						fields = append(fields, violation.GetField())
+					}
+				}
+			}
+			assert.Equal(t, tt.wantViolations, fields)
+		})
+	}
+}
+
+func TestSerice_BatchGet(t *testing.T) {
+	t.Parallel()
+
+	const (
+		firstID  = "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"
+		secondID = "b1ffcd88-8d1a-4ef8-bb6d-6bb9bd380a22"
+	)
+	names := []string{resourcename.ProjectName(firstID), resourcename.ProjectName(secondID)}
+
+	tests := []struct {
+		name        string
+		secondState projectmodels.ProjectState
+		wantCode    codes.Code
+	}{
+		{
+			name:        "all projects are found",
+			secondState: projectmodels.ArchivedProjectState,
+			wantCode:    codes.OK,
+		},
+		{
+			name:        "deleted project is not found",
+			secondState: projectmodels.DeletedprojectState,
+			wantCode:    codes.NotFound,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			storageMock := mocks.NewProjectStorage(t)
+			storageMock.On("BatchGet", mock.Anything, names).Return([]*projectmodels.Project{
+				{Name: names[0], State: projectmodels.ActiveProjectState},
+				{Name: names[1], State: tt.secondState},
+			}, nil).Once()
+
+			projects, stat := projectsrv.New(storageMock).BatchGet(context.Background(), projectapi.BatchGetProjectsArgs{
+				ProjectIDs: []string{firstID, secondID},
+			})
+			require.Equal(t, tt.wantCode, stat.Code())
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, names[1], projects[1].Name)
+			}
+		})
+	}
+}
+
+func TestSerice_BatchDelete(t *testing.T) {
+	t.Parallel()
+
+	const (
+		firstID  = "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"
+		secondID = "b1ffcd88-8d1a-4ef8-bb6d-6bb9bd380a22"
+	)
+	names := []string{resourcename.ProjectName(firstID), resourcename.ProjectName(secondID)}
+
+	tests := []struct {
+		name       string
+		updateStat *status.Status
+		wantCode   codes.Code
+	}{
+		{
+			name:     "all projects are deleted",
+			wantCode: codes.OK,
+		},
+		{
+			name:       "concurrent change deletes nothing",
+			updateStat: status.New(codes.Aborted, "project was changed concurrently"),
+			wantCode:   codes.Aborted,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			storageMock := mocks.NewProjectStorage(t)
+			storageMock.On("BatchGet", mock.Anything, names).Return([]*projectmodels.Project{
+				{Name: names[0], State: projectmodels.ActiveProjectState, Version: 1},
+				{Name: names[1], State: projectmodels.ArchivedProjectState, Version: 3},
+			}, nil).Once()
+			storageMock.On("BatchUpdate", mock.Anything, mock.MatchedBy(func(projects []*projectmodels.Project) bool {
+				for _, project := range projects {
+					if project.State != projectmodels.DeletedprojectState || project.PurgeTime.IsZero() {
+						return false
+					}
+				}
+				return len(projects) == 2
+			})).Return(tt.updateStat).Once()
+
+			projects, stat := projectsrv.New(storageMock).BatchDelete(context.Background(), projectapi.BatchDeleteProjectsArgs{
+				ProjectIDs: []string{firstID, secondID},
+			})
+			require.Equal(t, tt.wantCode, stat.Code())
+			if tt.wantCode == codes.OK {
+				assert.Len(t, projects, 2)
+			}
+		})
+	}
+}
+
+// watch runs Watch until it returns or limit messages were sent and returns
+// the sent messages.
+func watch(t *testing.T, service *projectsrv.Serice, token string, limit int) ([]*projectmodels.ProjectChange, *status.Status) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var sent []*projectmodels.ProjectChange
+	stat := service.Watch(ctx, projectapi.WatchProjectsArgs{ChangeToken: token}, func(change *projectmodels.ProjectChange) error {
+		sent = append(sent, change)
+		if len(sent) == limit {
+			cancel()
+		}
+		return nil
+	})
+	return sent, stat
+}
+
+func TestSerice_Watch(t *testing.T) {
+	t.Parallel()
+
+	name := resourcename.ProjectName("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11")
+	project := &projectmodels.Project{Name: name, Version: 2}
+
+	storageMock := mocks.NewProjectStorage(t)
+	storageMock.On("LastChange", mock.Anything).Return(int64(7), nil).Once()
+	storageMock.On("ListChanges", mock.Anything, int64(7), mock.Anything).Return([]*projectmodels.ProjectChange{
+		{Seq: 8, Type: projectmodels.CreatedChangeType, Name: name, Project: project},
+		{Seq: 9, Type: projectmodels.UpdatedChangeType, Name: name, Project: project},
+	}, nil).Once()
+	storageMock.On("ListChanges", mock.Anything, int64(8), mock.Anything).Return([]*projectmodels.ProjectChange{
+		{Seq: 9, Type: projectmodels.UpdatedChangeType, Name: name, Project: project},
+	}, nil).Once()
+	storageMock.On("ListChanges", mock.Anything, int64(9), mock.Anything).Return(nil, nil).Maybe()
+
+	service := projectsrv.New(storageMock, projectsrv.WithPageTokenSecret("secret"))
+
+	sent, stat := watch(t, service, "", 3)
+	require.Nil(t, stat)
+	require.Len(t, sent, 3)
+	assert.Equal(t, projectmodels.HeartbeatChangeType, sent[0].Type)
+	assert.NotEmpty(t, sent[0].Token)
+	assert.Equal(t, projectmodels.CreatedChangeType, sent[1].Type)
+	assert.Equal(t, project, sent[1].Project)
+	assert.Equal(t, projectmodels.UpdatedChangeType, sent[2].Type)
+
+	// A new call resumes right after the change the token was sent with.
+	resumed, stat := watch(t, service, sent[1].Token, 2)
+	require.Nil(t, stat)
+	require.Len(t, resumed, 2)
+	assert.Equal(t, projectmodels.HeartbeatChangeType, resumed[0].Type)
+	assert.Equal(t, int64(9), resumed[1].Seq)
+}
+
+func TestSerice_Watch_Heartbeat(t *testing.T) {
+	t.Parallel()
+
+	storageMock := mocks.NewProjectStorage(t)
+	storageMock.On("LastChange", mock.Anything).Return(int64(0), nil).Once()
+	storageMock.On("ListChanges", mock.Anything, int64(0), mock.Anything).Return(nil, nil)
+
+	service := projectsrv.New(storageMock, projectsrv.WithWatchIntervals(10*time.Millisecond, time.Hour))
+
+	sent, stat := watch(t, service, "", 3)
+	require.Nil(t, stat)
+	require.Len(t, sent, 3)
+	for _, change := range sent {
+		assert.Equal(t, projectmodels.HeartbeatChangeType, change.Type)
+		assert.Equal(t, sent[0].Token, change.Token)
+	}
+}
+
+func TestSerice_Watch_Notified(t *testing.T) {
+	t.Parallel()
+
+	name := resourcename.ProjectName("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11")
+
+	wake := make(chan struct{}, 1)
+	notifierMock := mocks.NewChangeNotifier(t)
+	notifierMock.On("Subscribe").Return((<-chan struct{})(wake), func() {}).Once()
+
+	storageMock := mocks.NewProjectStorage(t)
+	storageMock.On("LastChange", mock.Anything).Return(int64(3), nil).Once()
+	storageMock.On("ListChanges", mock.Anything, int64(3), mock.Anything).Return(nil, nil).Once()
+	storageMock.On("ListChanges", mock.Anything, int64(3), mock.Anything).Return([]*projectmodels.ProjectChange{
+		{Seq: 4, Type: projectmodels.DeletedChangeType, Name: name},
+	}, nil).Once()
+	storageMock.On("ListChanges", mock.Anything, int64(4), mock.Anything).Return(nil, nil).Maybe()
+
+	service := projectsrv.New(storageMock,
+		projectsrv.WithChangeNotifier(notifierMock),
+		projectsrv.WithWatchIntervals(time.Hour, time.Hour),
+	)
+
+	wake <- struct{}{}
+	sent, stat := watch(t, service, "", 2)
+	require.Nil(t, stat)
+	require.Len(t, sent, 2)
+	assert.Equal(t, projectmodels.DeletedChangeType, sent[1].Type)
+	assert.Nil(t, sent[1].Project)
+}
+
+func TestSerice_Watch_Errors(t *testing.T) {
+	t.Parallel()
+
+	listToken := func(t *testing.T) string {
+		storageMock := mocks.NewProjectStorage(t)
+		storageMock.On("List", mock.Anything, mock.Anything).Return([]*projectmodels.Project{{Name: "projects/a"}, {Name: "projects/b"}}, nil)
+		_, token, stat := projectsrv.New(storageMock, projectsrv.WithPageTokenSecret("secret")).
+			List(context.Background(), projectapi.ListProjectsArgs{PageSize: 1})
+		require.Nil(t, stat)
+		return token
+	}
+
+	tests := []struct {
+		name     string
+		token    func(t *testing.T) string
+		setup    func(storageMock *mocks.ProjectStorage, notifierMock *mocks.ChangeNotifier)
+		wantCode codes.Code
+	}{
+		{
+			name:     "malformed token",
+			token:    func(*testing.T) string { return "garbage" },
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "page token",
+			token:    listToken,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:  "expired token",
+			token: func(*testing.T) string { return "" },
+			setup: func(storageMock *mocks.ProjectStorage, notifierMock *mocks.ChangeNotifier) {
+				notifierMock.On("Subscribe").Return((<-chan struct{})(make(chan struct{})), func() {}).Once()
+				storageMock.On("LastChange", mock.Anything).Return(int64(1), nil).Once()
+				storageMock.On("ListChanges", mock.Anything, int64(1), mock.Anything).
+					Return(nil, status.New(codes.FailedPrecondition, "changes after change 1 are not available")).Once()
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:  "shutdown",
+			token: func(*testing.T) string { return "" },
+			setup: func(storageMock *mocks.ProjectStorage, notifierMock *mocks.ChangeNotifier) {
+				closed := make(chan struct{})
+				close(closed)
+				notifierMock.On("Subscribe").Return((<-chan struct{})(closed), func() {}).Once()
+				storageMock.On("LastChange", mock.Anything).Return(int64(1), nil).Once()
+				storageMock.On("ListChanges", mock.Anything, int64(1), mock.Anything).Return(nil, nil).Once()
+			},
+			wantCode: codes.Unavailable,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			storageMock := mocks.NewProjectStorage(t)
+			notifierMock := mocks.NewChangeNotifier(t)
+			if tt.setup != nil {
+				tt.setup(storageMock, notifierMock)
+			}
+
+			service := projectsrv.New(storageMock,
+				projectsrv.WithPageTokenSecret("secret"),
+				projectsrv.WithChangeNotifier(notifierMock),
+				projectsrv.WithWatchIntervals(time.Hour, time.Hour),
+			)
+
+			_, stat := watch(t, service, tt.token(t), 0)
+			require.Equal(t, tt.wantCode, stat.Code())
+		})
+	}
+}
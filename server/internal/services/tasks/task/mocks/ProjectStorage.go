@@ -0,0 +1,62 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	projectmodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/project"
+	mock "github.com/stretchr/testify/mock"
+	status "google.golang.org/grpc/status"
+)
+
+// ProjectStorage is an autogenerated mock type for the ProjectStorage type
+type ProjectStorage struct {
+	mock.Mock
+}
+
+// Get provides a mock function with given fields: ctx, name
+func (_m *ProjectStorage) Get(ctx context.Context, name string) (*projectmodels.Project, *status.Status) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *projectmodels.Project
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*projectmodels.Project, *status.Status)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *projectmodels.Project); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*projectmodels.Project)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) *status.Status); ok {
+		r1 = rf(ctx, name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// NewProjectStorage creates a new instance of ProjectStorage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewProjectStorage(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ProjectStorage {
+	mock := &ProjectStorage{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
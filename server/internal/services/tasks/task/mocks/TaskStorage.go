@@ -0,0 +1,223 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	status "google.golang.org/grpc/status"
+
+	taskmodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/task"
+
+	tasksrv "github.com/10Narratives/ready-to-do/server/internal/services/tasks/task"
+
+	time "time"
+)
+
+// TaskStorage is an autogenerated mock type for the TaskStorage type
+type TaskStorage struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, task
+func (_m *TaskStorage) Create(ctx context.Context, task *taskmodels.Task) *status.Status {
+	ret := _m.Called(ctx, task)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *taskmodels.Task) *status.Status); ok {
+		r0 = rf(ctx, task)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*status.Status)
+		}
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: ctx, name
+func (_m *TaskStorage) Delete(ctx context.Context, name string) *status.Status {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, string) *status.Status); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*status.Status)
+		}
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, name
+func (_m *TaskStorage) Get(ctx context.Context, name string) (*taskmodels.Task, *status.Status) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *taskmodels.Task
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*taskmodels.Task, *status.Status)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *taskmodels.Task); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*taskmodels.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) *status.Status); ok {
+		r1 = rf(ctx, name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, query
+func (_m *TaskStorage) List(ctx context.Context, query tasksrv.ListTasksQuery) ([]*taskmodels.Task, *status.Status) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*taskmodels.Task
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, tasksrv.ListTasksQuery) ([]*taskmodels.Task, *status.Status)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, tasksrv.ListTasksQuery) []*taskmodels.Task); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*taskmodels.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, tasksrv.ListTasksQuery) *status.Status); ok {
+		r1 = rf(ctx, query)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// Move provides a mock function with given fields: ctx, query
+func (_m *TaskStorage) Move(ctx context.Context, query tasksrv.MoveTaskQuery) (*taskmodels.Task, *status.Status) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Move")
+	}
+
+	var r0 *taskmodels.Task
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, tasksrv.MoveTaskQuery) (*taskmodels.Task, *status.Status)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, tasksrv.MoveTaskQuery) *taskmodels.Task); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*taskmodels.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, tasksrv.MoveTaskQuery) *status.Status); ok {
+		r1 = rf(ctx, query)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// Transition provides a mock function with given fields: ctx, name, from, to, cascade, updateTime
+func (_m *TaskStorage) Transition(ctx context.Context, name string, from taskmodels.TaskState, to taskmodels.TaskState, cascade bool, updateTime time.Time) (*taskmodels.Task, *status.Status) {
+	ret := _m.Called(ctx, name, from, to, cascade, updateTime)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Transition")
+	}
+
+	var r0 *taskmodels.Task
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, string, taskmodels.TaskState, taskmodels.TaskState, bool, time.Time) (*taskmodels.Task, *status.Status)); ok {
+		return rf(ctx, name, from, to, cascade, updateTime)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, taskmodels.TaskState, taskmodels.TaskState, bool, time.Time) *taskmodels.Task); ok {
+		r0 = rf(ctx, name, from, to, cascade, updateTime)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*taskmodels.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, taskmodels.TaskState, taskmodels.TaskState, bool, time.Time) *status.Status); ok {
+		r1 = rf(ctx, name, from, to, cascade, updateTime)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, task
+func (_m *TaskStorage) Update(ctx context.Context, task *taskmodels.Task) *status.Status {
+	ret := _m.Called(ctx, task)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *taskmodels.Task) *status.Status); ok {
+		r0 = rf(ctx, task)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*status.Status)
+		}
+	}
+
+	return r0
+}
+
+// NewTaskStorage creates a new instance of TaskStorage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTaskStorage(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TaskStorage {
+	mock := &TaskStorage{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,391 @@
+package tasksrv
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/10Narratives/ready-to-do/server/internal/apierror"
+	"github.com/10Narratives/ready-to-do/server/internal/auth"
+	"github.com/10Narratives/ready-to-do/server/internal/filter"
+	projectmodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/project"
+	taskmodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/task"
+	"github.com/10Narratives/ready-to-do/server/internal/pagetoken"
+	"github.com/10Narratives/ready-to-do/server/internal/resourcename"
+	taskapi "github.com/10Narratives/ready-to-do/server/internal/transport/grpc/tasks/task"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 1000
+	// DefaultMaxDepth is how deep subtasks may be nested by default.
+	DefaultMaxDepth = 4
+)
+
+//go:generate mockery --name TaskStorage --output ./mocks/
+type TaskStorage interface {
+	Create(ctx context.Context, task *taskmodels.Task) *status.Status
+	Get(ctx context.Context, name string) (*taskmodels.Task, *status.Status)
+	// List returns up to query.PageSize+1 tasks; the extra task tells the
+	// caller that another page exists.
+	List(ctx context.Context, query ListTasksQuery) ([]*taskmodels.Task, *status.Status)
+	Update(ctx context.Context, task *taskmodels.Task) *status.Status
+	Delete(ctx context.Context, name string) *status.Status
+	// Transition moves a task from state from to state to if it is still in
+	// state from, and returns FailedPrecondition otherwise. With cascade, the
+	// subtasks of the task that are in state from are moved too.
+	Transition(ctx context.Context, name string, from, to taskmodels.TaskState, cascade bool, updateTime time.Time) (*taskmodels.Task, *status.Status)
+	// Move reparents a task with its subtasks in one transaction. It returns
+	// FailedPrecondition if the move would create a cycle or nest a subtask
+	// deeper than query.MaxDepth.
+	Move(ctx context.Context, query MoveTaskQuery) (*taskmodels.Task, *status.Status)
+}
+
+// ProjectStorage reads the projects tasks belong to. Projects of other users
+// are not found, which hides their tasks as well.
+//
+//go:generate mockery --name ProjectStorage --output ./mocks/
+type ProjectStorage interface {
+	Get(ctx context.Context, name string) (*projectmodels.Project, *status.Status)
+}
+
+// ListTasksQuery selects one keyset page of the tasks of a project.
+type ListTasksQuery struct {
+	Parent   string
+	PageSize int
+	OrderBy  taskmodels.TaskOrder
+	After    *TaskCursor
+	// Filter further restricts the listed tasks. A nil filter matches all.
+	Filter *filter.Filter[*taskmodels.Task]
+	// Tree lists tasks in depth-first tree order instead of OrderBy.
+	Tree bool
+}
+
+// MoveTaskQuery describes where a task is moved to.
+type MoveTaskQuery struct {
+	Name string
+	// Project is the name of the destination project.
+	Project string
+	// ParentTask is the name of the new parent task, empty for the top level.
+	ParentTask string
+	MaxDepth   int
+	UpdateTime time.Time
+}
+
+// TaskCursor is the sort key of the last task on a page.
+type TaskCursor struct {
+	Name      string                  `json:"n"`
+	CreatedAt time.Time               `json:"c"`
+	UpdatedAt time.Time               `json:"u"`
+	DueTime   time.Time               `json:"d"`
+	Priority  taskmodels.TaskPriority `json:"p"`
+	Title     string                  `json:"t"`
+	Path      []string                `json:"tp,omitempty"`
+}
+
+func NewTaskCursor(task *taskmodels.Task) TaskCursor {
+	return TaskCursor{
+		Name:      task.Name,
+		CreatedAt: task.CreatedAt,
+		UpdatedAt: task.UpdatedAt,
+		DueTime:   task.DueTime,
+		Priority:  task.Priority,
+		Title:     task.Title,
+		Path:      task.Path,
+	}
+}
+
+type Service struct {
+	storage  TaskStorage
+	projects ProjectStorage
+
+	pageTokens      *pagetoken.Codec
+	defaultPageSize int
+	maxPageSize     int
+	maxDepth        int
+}
+
+var _ taskapi.TaskService = &Service{}
+
+type Option func(*Service)
+
+// WithPageTokenSecret sets the key used to sign page tokens. Replicas serving
+// the same clients must share it.
+func WithPageTokenSecret(secret string) Option {
+	return func(s *Service) {
+		s.pageTokens = pagetoken.New(secret)
+	}
+}
+
+// WithPageSize overrides the default and maximum list page sizes.
+func WithPageSize(defaultSize, maxSize int) Option {
+	return func(s *Service) {
+		if defaultSize > 0 {
+			s.defaultPageSize = defaultSize
+		}
+		if maxSize > 0 {
+			s.maxPageSize = maxSize
+		}
+	}
+}
+
+// WithMaxDepth limits how deep subtasks may be nested. Top-level tasks have
+// depth 0.
+func WithMaxDepth(depth int) Option {
+	return func(s *Service) {
+		if depth >= 0 {
+			s.maxDepth = depth
+		}
+	}
+}
+
+func New(storage TaskStorage, projects ProjectStorage, opts ...Option) *Service {
+	s := &Service{
+		storage:         storage,
+		projects:        projects,
+		defaultPageSize: DefaultPageSize,
+		maxPageSize:     MaxPageSize,
+		maxDepth:        DefaultMaxDepth,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.pageTokens == nil {
+		s.pageTokens = pagetoken.New("")
+	}
+	return s
+}
+
+func (s *Service) Create(ctx context.Context, args taskapi.CreateTaskArgs) (*taskmodels.Task, *status.Status) {
+	if stat := s.checkProject(ctx, args.ProjectID, true); stat != nil {
+		return nil, stat
+	}
+
+	if args.Task.ParentTask != "" {
+		parentProjectID, _, err := resourcename.ParseTaskName(args.Task.ParentTask)
+		if err != nil {
+			return nil, apierror.BadRequest("invalid parent_task", apierror.Violation("task.parent_task", err.Error()))
+		}
+		if parentProjectID != args.ProjectID {
+			return nil, apierror.BadRequest("invalid parent_task", apierror.Violation("task.parent_task", fmt.Sprintf("parent task %q belongs to another project", args.Task.ParentTask)))
+		}
+		parent, stat := s.storage.Get(ctx, args.Task.ParentTask)
+		if stat != nil {
+			return nil, stat
+		}
+		if parent.Depth+1 > s.maxDepth {
+			return nil, apierror.New(codes.FailedPrecondition, apierror.ReasonNestingTooDeep,
+				apierror.Metadata{"maxDepth": strconv.Itoa(s.maxDepth)},
+				fmt.Sprintf("subtasks cannot be nested deeper than %d levels", s.maxDepth))
+		}
+	}
+
+	now := time.Now().UTC()
+	task := *args.Task
+	task.Name = resourcename.TaskName(args.ProjectID, args.TaskID)
+	task.State = taskmodels.OpenTaskState
+	task.CompletedAt = time.Time{}
+	task.CreatedAt = now
+	task.UpdatedAt = now
+
+	if stat := s.storage.Create(ctx, &task); stat != nil {
+		return nil, stat
+	}
+
+	return &task, nil
+}
+
+func (s *Service) Get(ctx context.Context, args taskapi.GetTaskArgs) (*taskmodels.Task, *status.Status) {
+	if stat := s.checkProject(ctx, args.ProjectID, false); stat != nil {
+		return nil, stat
+	}
+
+	return s.storage.Get(ctx, resourcename.TaskName(args.ProjectID, args.TaskID))
+}
+
+func (s *Service) List(ctx context.Context, args taskapi.ListTasksArgs) ([]*taskmodels.Task, string, *status.Status) {
+	if stat := s.checkProject(ctx, args.ProjectID, false); stat != nil {
+		return nil, "", stat
+	}
+
+	taskFilter, err := filter.Compile(args.Filter, taskmodels.TaskFilterSchema)
+	if err != nil {
+		return nil, "", apierror.BadRequest("invalid filter", apierror.Violation("filter", err.Error()))
+	}
+
+	query := ListTasksQuery{
+		Parent:   resourcename.ProjectName(args.ProjectID),
+		PageSize: s.pageSize(args.PageSize),
+		OrderBy:  args.OrderBy,
+		Filter:   taskFilter,
+		Tree:     args.Tree,
+	}
+
+	fingerprint := listFingerprint(args)
+	if args.PageToken != "" {
+		var cursor TaskCursor
+		if err := s.pageTokens.Decode(args.PageToken, fingerprint, &cursor); err != nil {
+			return nil, "", apierror.BadRequest("invalid page token", apierror.Violation("page_token", err.Error()))
+		}
+		query.After = &cursor
+	}
+
+	tasks, stat := s.storage.List(ctx, query)
+	if stat != nil {
+		return nil, "", stat
+	}
+
+	if len(tasks) <= query.PageSize {
+		return tasks, "", nil
+	}
+
+	tasks = tasks[:query.PageSize]
+	nextPageToken, err := s.pageTokens.Encode(fingerprint, NewTaskCursor(tasks[len(tasks)-1]))
+	if err != nil {
+		return nil, "", status.Newf(codes.Internal, "cannot issue page token: %v", err)
+	}
+
+	return tasks, nextPageToken, nil
+}
+
+func (s *Service) Update(ctx context.Context, args taskapi.UpdateTaskArgs) (*taskmodels.Task, *status.Status) {
+	if stat := s.checkProject(ctx, args.ProjectID, true); stat != nil {
+		return nil, stat
+	}
+
+	task, stat := s.storage.Get(ctx, resourcename.TaskName(args.ProjectID, args.TaskID))
+	if stat != nil {
+		return nil, stat
+	}
+
+	for _, field := range args.Fields {
+		switch field {
+		case taskmodels.TitleField:
+			task.Title = args.Task.Title
+		case taskmodels.NotesField:
+			task.Notes = args.Task.Notes
+		case taskmodels.DueTimeField:
+			task.DueTime = args.Task.DueTime
+		case taskmodels.DueTimeZoneField:
+			task.DueTimeZone = args.Task.DueTimeZone
+		case taskmodels.PriorityField:
+			task.Priority = args.Task.Priority
+		case taskmodels.LabelsField:
+			task.Labels = args.Task.Labels
+		default:
+			return nil, apierror.BadRequest("invalid update mask", apierror.Violation("update_mask", fmt.Sprintf("field %q cannot be updated", field)))
+		}
+	}
+	task.UpdatedAt = time.Now().UTC()
+
+	if stat := s.storage.Update(ctx, task); stat != nil {
+		return nil, stat
+	}
+
+	return task, nil
+}
+
+func (s *Service) Delete(ctx context.Context, args taskapi.DeleteTaskArgs) *status.Status {
+	if stat := s.checkProject(ctx, args.ProjectID, true); stat != nil {
+		return stat
+	}
+
+	return s.storage.Delete(ctx, resourcename.TaskName(args.ProjectID, args.TaskID))
+}
+
+// Complete moves an OPEN task, and with args.Cascade its OPEN subtasks, to
+// the COMPLETED state.
+func (s *Service) Complete(ctx context.Context, args taskapi.CompleteTaskArgs) (*taskmodels.Task, *status.Status) {
+	if stat := s.checkProject(ctx, args.ProjectID, true); stat != nil {
+		return nil, stat
+	}
+
+	return s.storage.Transition(ctx, resourcename.TaskName(args.ProjectID, args.TaskID),
+		taskmodels.OpenTaskState, taskmodels.CompletedTaskState, args.Cascade, time.Now().UTC())
+}
+
+// Reopen moves a COMPLETED task back to the OPEN state.
+func (s *Service) Reopen(ctx context.Context, args taskapi.ReopenTaskArgs) (*taskmodels.Task, *status.Status) {
+	if stat := s.checkProject(ctx, args.ProjectID, true); stat != nil {
+		return nil, stat
+	}
+
+	return s.storage.Transition(ctx, resourcename.TaskName(args.ProjectID, args.TaskID),
+		taskmodels.CompletedTaskState, taskmodels.OpenTaskState, false, time.Now().UTC())
+}
+
+// Move reparents a task with its subtasks. Both the current and the
+// destination project must be ACTIVE.
+func (s *Service) Move(ctx context.Context, args taskapi.MoveTaskArgs) (*taskmodels.Task, *status.Status) {
+	if stat := s.checkProject(ctx, args.ProjectID, true); stat != nil {
+		return nil, stat
+	}
+	if args.DestinationProjectID != args.ProjectID {
+		if stat := s.checkProject(ctx, args.DestinationProjectID, true); stat != nil {
+			return nil, stat
+		}
+	}
+
+	query := MoveTaskQuery{
+		Name:       resourcename.TaskName(args.ProjectID, args.TaskID),
+		Project:    resourcename.ProjectName(args.DestinationProjectID),
+		MaxDepth:   s.maxDepth,
+		UpdateTime: time.Now().UTC(),
+	}
+	if args.DestinationParentTaskID != "" {
+		query.ParentTask = resourcename.TaskName(args.DestinationProjectID, args.DestinationParentTaskID)
+	}
+
+	return s.storage.Move(ctx, query)
+}
+
+// checkProject makes sure the parent project is visible. Tasks of deleted
+// projects are not found, and tasks of archived projects and of projects
+// shared with the user of ctx are read-only.
+func (s *Service) checkProject(ctx context.Context, projectID string, write bool) *status.Status {
+	name := resourcename.ProjectName(projectID)
+
+	project, stat := s.projects.Get(ctx, name)
+	if stat != nil {
+		return stat
+	}
+
+	var user string
+	if principal, ok := auth.FromContext(ctx); ok {
+		user = principal.User
+	}
+
+	switch {
+	case project.State == projectmodels.DeletedprojectState:
+		return apierror.NotFound(resourcename.Project.Type(), name)
+	case write && project.Owner != user:
+		return apierror.New(codes.PermissionDenied, apierror.ReasonPermissionDenied, apierror.Metadata{"resourceName": name},
+			fmt.Sprintf("project %q is shared, its tasks cannot be changed", name),
+			apierror.Resource(resourcename.Project.Type(), name, ""))
+	case write && project.State != projectmodels.ActiveProjectState:
+		return apierror.New(codes.FailedPrecondition, apierror.ReasonProjectArchived, apierror.Metadata{"resourceName": name},
+			fmt.Sprintf("project %q is archived, its tasks cannot be changed", name),
+			apierror.Resource(resourcename.Project.Type(), name, ""))
+	default:
+		return nil
+	}
+}
+
+func (s *Service) pageSize(requested int32) int {
+	switch {
+	case requested <= 0:
+		return s.defaultPageSize
+	case int(requested) > s.maxPageSize:
+		return s.maxPageSize
+	default:
+		return int(requested)
+	}
+}
+
+// listFingerprint describes every list parameter a page token is bound to.
+func listFingerprint(args taskapi.ListTasksArgs) string {
+	return fmt.Sprintf("parent=%s;filter=%q;order_by=%s;tree=%t", resourcename.ProjectName(args.ProjectID), args.Filter, args.OrderBy, args.Tree)
+}
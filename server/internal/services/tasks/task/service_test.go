@@ -0,0 +1,547 @@
+package tasksrv_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/10Narratives/ready-to-do/server/internal/auth"
+	projectmodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/project"
+	taskmodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/task"
+	"github.com/10Narratives/ready-to-do/server/internal/resourcename"
+	tasksrv "github.com/10Narratives/ready-to-do/server/internal/services/tasks/task"
+	"github.com/10Narratives/ready-to-do/server/internal/services/tasks/task/mocks"
+	taskapi "github.com/10Narratives/ready-to-do/server/internal/transport/grpc/tasks/task"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	projectID = "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"
+	taskID    = "b1eebc99-9c0b-4ef8-bb6d-6bb9bd380a22"
+)
+
+func projectIn(state projectmodels.ProjectState) *projectmodels.Project {
+	return &projectmodels.Project{Name: resourcename.ProjectName(projectID), State: state}
+}
+
+func TestService_Create(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		setupProjectMock func(m *mocks.ProjectStorage)
+		setupStorageMock func(m *mocks.TaskStorage)
+		wantCode         codes.Code
+	}{
+		{
+			name: "successful execution",
+			setupProjectMock: func(m *mocks.ProjectStorage) {
+				m.On("Get", mock.Anything, resourcename.ProjectName(projectID)).Return(projectIn(projectmodels.ActiveProjectState), nil)
+			},
+			setupStorageMock: func(m *mocks.TaskStorage) {
+				m.On("Create", mock.Anything, mock.MatchedBy(func(task *taskmodels.Task) bool {
+					return task.Name == resourcename.TaskName(projectID, taskID) &&
+						task.State == taskmodels.OpenTaskState &&
+						!task.CreatedAt.IsZero() && task.CreatedAt.Equal(task.UpdatedAt)
+				})).Return(nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "archived project is read-only",
+			setupProjectMock: func(m *mocks.ProjectStorage) {
+				m.On("Get", mock.Anything, mock.Anything).Return(projectIn(projectmodels.ArchivedProjectState), nil)
+			},
+			setupStorageMock: func(m *mocks.TaskStorage) {},
+			wantCode:         codes.FailedPrecondition,
+		},
+		{
+			name: "deleted project is not found",
+			setupProjectMock: func(m *mocks.ProjectStorage) {
+				m.On("Get", mock.Anything, mock.Anything).Return(projectIn(projectmodels.DeletedprojectState), nil)
+			},
+			setupStorageMock: func(m *mocks.TaskStorage) {},
+			wantCode:         codes.NotFound,
+		},
+		{
+			name: "storage status is passed up",
+			setupProjectMock: func(m *mocks.ProjectStorage) {
+				m.On("Get", mock.Anything, mock.Anything).Return(projectIn(projectmodels.ActiveProjectState), nil)
+			},
+			setupStorageMock: func(m *mocks.TaskStorage) {
+				m.On("Create", mock.Anything, mock.Anything).Return(status.New(codes.AlreadyExists, "task already exists"))
+			},
+			wantCode: codes.AlreadyExists,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			projectMock := mocks.NewProjectStorage(t)
+			tt.setupProjectMock(projectMock)
+			storageMock := mocks.NewTaskStorage(t)
+			tt.setupStorageMock(storageMock)
+
+			service := tasksrv.New(storageMock, projectMock)
+			got, stat := service.Create(context.Background(), taskapi.CreateTaskArgs{
+				ProjectID: projectID,
+				TaskID:    taskID,
+				Task:      &taskmodels.Task{Title: "write tests"},
+			})
+
+			require.Equal(t, tt.wantCode, stat.Code())
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, "write tests", got.Title)
+			}
+		})
+	}
+}
+
+func TestService_SharedProject(t *testing.T) {
+	t.Parallel()
+
+	const user = "users/c2eebc99-9c0b-4ef8-bb6d-6bb9bd380a33"
+	ctx := auth.NewContext(context.Background(), &auth.Principal{User: user})
+	task := &taskmodels.Task{Name: resourcename.TaskName(projectID, taskID), Title: "write tests"}
+
+	tests := []struct {
+		name             string
+		owner            string
+		setupStorageMock func(m *mocks.TaskStorage)
+		call             func(service *tasksrv.Service) *status.Status
+		wantCode         codes.Code
+	}{
+		{
+			name:  "tasks of shared projects are readable",
+			owner: "",
+			setupStorageMock: func(m *mocks.TaskStorage) {
+				m.On("Get", mock.Anything, task.Name).Return(task, nil)
+			},
+			call: func(service *tasksrv.Service) *status.Status {
+				_, stat := service.Get(ctx, taskapi.GetTaskArgs{ProjectID: projectID, TaskID: taskID})
+				return stat
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:             "tasks of shared projects cannot be created",
+			owner:            "",
+			setupStorageMock: func(m *mocks.TaskStorage) {},
+			call: func(service *tasksrv.Service) *status.Status {
+				_, stat := service.Create(ctx, taskapi.CreateTaskArgs{ProjectID: projectID, TaskID: taskID, Task: &taskmodels.Task{Title: "write tests"}})
+				return stat
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:             "tasks of shared projects cannot be completed",
+			owner:            "",
+			setupStorageMock: func(m *mocks.TaskStorage) {},
+			call: func(service *tasksrv.Service) *status.Status {
+				_, stat := service.Complete(ctx, taskapi.CompleteTaskArgs{ProjectID: projectID, TaskID: taskID})
+				return stat
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:             "tasks of shared projects cannot be deleted",
+			owner:            "",
+			setupStorageMock: func(m *mocks.TaskStorage) {},
+			call: func(service *tasksrv.Service) *status.Status {
+				return service.Delete(ctx, taskapi.DeleteTaskArgs{ProjectID: projectID, TaskID: taskID})
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:  "tasks of own projects can be changed",
+			owner: user,
+			setupStorageMock: func(m *mocks.TaskStorage) {
+				m.On("Create", mock.Anything, mock.Anything).Return(nil)
+			},
+			call: func(service *tasksrv.Service) *status.Status {
+				_, stat := service.Create(ctx, taskapi.CreateTaskArgs{ProjectID: projectID, TaskID: taskID, Task: &taskmodels.Task{Title: "write tests"}})
+				return stat
+			},
+			wantCode: codes.OK,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			project := projectIn(projectmodels.ActiveProjectState)
+			project.Owner = tt.owner
+			projectMock := mocks.NewProjectStorage(t)
+			projectMock.On("Get", mock.Anything, project.Name).Return(project, nil)
+			storageMock := mocks.NewTaskStorage(t)
+			tt.setupStorageMock(storageMock)
+
+			stat := tt.call(tasksrv.New(storageMock, projectMock))
+			require.Equal(t, tt.wantCode, stat.Code())
+		})
+	}
+}
+
+func TestService_Get(t *testing.T) {
+	t.Parallel()
+
+	task := &taskmodels.Task{Name: resourcename.TaskName(projectID, taskID), State: taskmodels.OpenTaskState}
+
+	tests := []struct {
+		name             string
+		projectState     projectmodels.ProjectState
+		setupStorageMock func(m *mocks.TaskStorage)
+		want             *taskmodels.Task
+		wantCode         codes.Code
+	}{
+		{
+			name:         "successful execution",
+			projectState: projectmodels.ActiveProjectState,
+			setupStorageMock: func(m *mocks.TaskStorage) {
+				m.On("Get", mock.Anything, task.Name).Return(task, nil)
+			},
+			want:     task,
+			wantCode: codes.OK,
+		},
+		{
+			name:         "tasks of archived projects are readable",
+			projectState: projectmodels.ArchivedProjectState,
+			setupStorageMock: func(m *mocks.TaskStorage) {
+				m.On("Get", mock.Anything, task.Name).Return(task, nil)
+			},
+			want:     task,
+			wantCode: codes.OK,
+		},
+		{
+			name:             "tasks of deleted projects are hidden",
+			projectState:     projectmodels.DeletedprojectState,
+			setupStorageMock: func(m *mocks.TaskStorage) {},
+			wantCode:         codes.NotFound,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			projectMock := mocks.NewProjectStorage(t)
+			projectMock.On("Get", mock.Anything, resourcename.ProjectName(projectID)).Return(projectIn(tt.projectState), nil)
+			storageMock := mocks.NewTaskStorage(t)
+			tt.setupStorageMock(storageMock)
+
+			service := tasksrv.New(storageMock, projectMock)
+			got, stat := service.Get(context.Background(), taskapi.GetTaskArgs{ProjectID: projectID, TaskID: taskID})
+
+			require.Equal(t, tt.wantCode, stat.Code())
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestService_List(t *testing.T) {
+	t.Parallel()
+
+	tasks := make([]*taskmodels.Task, 0, 3)
+	for i := 0; i < 3; i++ {
+		tasks = append(tasks, &taskmodels.Task{
+			Name:      resourcename.TaskName(projectID, fmt.Sprintf("b1eebc99-9c0b-4ef8-bb6d-6bb9bd380a2%d", i)),
+			CreatedAt: time.Date(2026, 1, i+1, 0, 0, 0, 0, time.UTC),
+			State:     taskmodels.OpenTaskState,
+		})
+	}
+	args := taskapi.ListTasksArgs{
+		ProjectID: projectID,
+		PageSize:  2,
+		OrderBy:   taskmodels.TaskOrder{Field: taskmodels.OrderByCreatedAt},
+	}
+
+	projectMock := mocks.NewProjectStorage(t)
+	projectMock.On("Get", mock.Anything, resourcename.ProjectName(projectID)).Return(projectIn(projectmodels.ActiveProjectState), nil)
+
+	storageMock := mocks.NewTaskStorage(t)
+	storageMock.On("List", mock.Anything, tasksrv.ListTasksQuery{
+		Parent:   resourcename.ProjectName(projectID),
+		PageSize: 2,
+		OrderBy:  args.OrderBy,
+	}).Return(tasks, nil).Once()
+	storageMock.On("List", mock.Anything, mock.MatchedBy(func(query tasksrv.ListTasksQuery) bool {
+		return query.After != nil && query.After.Name == tasks[1].Name
+	})).Return(tasks[2:], nil).Once()
+
+	service := tasksrv.New(storageMock, projectMock, tasksrv.WithPageTokenSecret("secret"))
+
+	firstPage, nextPageToken, stat := service.List(context.Background(), args)
+	require.Nil(t, stat)
+	assert.Equal(t, tasks[:2], firstPage)
+	require.NotEmpty(t, nextPageToken)
+
+	args.PageToken = nextPageToken
+	secondPage, nextPageToken, stat := service.List(context.Background(), args)
+	require.Nil(t, stat)
+	assert.Equal(t, tasks[2:], secondPage)
+	assert.Empty(t, nextPageToken)
+
+	args.Filter = "state = OPEN"
+	_, _, stat = service.List(context.Background(), args)
+	assert.Equal(t, codes.InvalidArgument, stat.Code())
+
+	args.PageToken = ""
+	args.Filter = "owner = 1"
+	_, _, stat = service.List(context.Background(), args)
+	assert.Equal(t, codes.InvalidArgument, stat.Code())
+}
+
+func TestService_Update(t *testing.T) {
+	t.Parallel()
+
+	name := resourcename.TaskName(projectID, taskID)
+	due := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	projectMock := mocks.NewProjectStorage(t)
+	projectMock.On("Get", mock.Anything, resourcename.ProjectName(projectID)).Return(projectIn(projectmodels.ActiveProjectState), nil)
+
+	storageMock := mocks.NewTaskStorage(t)
+	storageMock.On("Get", mock.Anything, name).Return(&taskmodels.Task{
+		Name:     name,
+		Title:    "old title",
+		Notes:    "keep me",
+		Priority: taskmodels.LowTaskPriority,
+		State:    taskmodels.OpenTaskState,
+	}, nil)
+	storageMock.On("Update", mock.Anything, mock.Anything).Return(nil)
+
+	service := tasksrv.New(storageMock, projectMock)
+	got, stat := service.Update(context.Background(), taskapi.UpdateTaskArgs{
+		ProjectID: projectID,
+		TaskID:    taskID,
+		Task: &taskmodels.Task{
+			Title:       "new title",
+			Notes:       "ignored",
+			DueTime:     due,
+			DueTimeZone: "Europe/Berlin",
+			Priority:    taskmodels.HighTaskPriority,
+		},
+		Fields: []string{taskmodels.TitleField, taskmodels.DueTimeField, taskmodels.DueTimeZoneField, taskmodels.PriorityField},
+	})
+
+	require.Nil(t, stat)
+	assert.Equal(t, "new title", got.Title)
+	assert.Equal(t, "keep me", got.Notes)
+	assert.Equal(t, due, got.DueTime)
+	assert.Equal(t, "Europe/Berlin", got.DueTimeZone)
+	assert.Equal(t, taskmodels.HighTaskPriority, got.Priority)
+	assert.False(t, got.UpdatedAt.IsZero())
+}
+
+func TestService_Complete(t *testing.T) {
+	t.Parallel()
+
+	name := resourcename.TaskName(projectID, taskID)
+	completed := &taskmodels.Task{Name: name, State: taskmodels.CompletedTaskState, CompletedAt: time.Now().UTC()}
+
+	projectMock := mocks.NewProjectStorage(t)
+	projectMock.On("Get", mock.Anything, resourcename.ProjectName(projectID)).Return(projectIn(projectmodels.ActiveProjectState), nil)
+
+	storageMock := mocks.NewTaskStorage(t)
+	storageMock.On("Transition", mock.Anything, name,
+		taskmodels.OpenTaskState, taskmodels.CompletedTaskState, true, mock.Anything).Return(completed, nil).Once()
+	storageMock.On("Transition", mock.Anything, name,
+		taskmodels.CompletedTaskState, taskmodels.OpenTaskState, false, mock.Anything).
+		Return(nil, status.New(codes.FailedPrecondition, "task is OPEN, expected COMPLETED")).Once()
+
+	service := tasksrv.New(storageMock, projectMock)
+
+	task, stat := service.Complete(context.Background(), taskapi.CompleteTaskArgs{ProjectID: projectID, TaskID: taskID, Cascade: true})
+	require.Nil(t, stat)
+	assert.Equal(t, completed, task)
+
+	_, stat = service.Reopen(context.Background(), taskapi.ReopenTaskArgs{ProjectID: projectID, TaskID: taskID})
+	assert.Equal(t, codes.FailedPrecondition, stat.Code())
+}
+
+func TestService_Create_Subtask(t *testing.T) {
+	t.Parallel()
+
+	const parentID = "c2eebc99-9c0b-4ef8-bb6d-6bb9bd380a33"
+	parentName := resourcename.TaskName(projectID, parentID)
+
+	tests := []struct {
+		name             string
+		parent           string
+		parentDepth      int
+		setupStorageMock func(m *mocks.TaskStorage)
+		wantCode         codes.Code
+	}{
+		{
+			name:        "successful execution",
+			parentDepth: 1,
+			setupStorageMock: func(m *mocks.TaskStorage) {
+				m.On("Create", mock.Anything, mock.MatchedBy(func(task *taskmodels.Task) bool {
+					return task.ParentTask == parentName
+				})).Return(nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:             "too deep",
+			parentDepth:      2,
+			setupStorageMock: func(m *mocks.TaskStorage) {},
+			wantCode:         codes.FailedPrecondition,
+		},
+		{
+			name:             "parent is not a task name",
+			parent:           resourcename.ProjectName(projectID),
+			setupStorageMock: func(m *mocks.TaskStorage) {},
+			wantCode:         codes.InvalidArgument,
+		},
+		{
+			name:             "parent in another project",
+			parent:           resourcename.TaskName("d3eebc99-9c0b-4ef8-bb6d-6bb9bd380a44", parentID),
+			setupStorageMock: func(m *mocks.TaskStorage) {},
+			wantCode:         codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			projectMock := mocks.NewProjectStorage(t)
+			projectMock.On("Get", mock.Anything, resourcename.ProjectName(projectID)).Return(projectIn(projectmodels.ActiveProjectState), nil)
+			storageMock := mocks.NewTaskStorage(t)
+			storageMock.On("Get", mock.Anything, parentName).Return(&taskmodels.Task{Name: parentName, Depth: tt.parentDepth}, nil).Maybe()
+			tt.setupStorageMock(storageMock)
+
+			parent := parentName
+			if tt.parent != "" {
+				parent = tt.parent
+			}
+
+			service := tasksrv.New(storageMock, projectMock, tasksrv.WithMaxDepth(2))
+			_, stat := service.Create(context.Background(), taskapi.CreateTaskArgs{
+				ProjectID: projectID,
+				TaskID:    taskID,
+				Task:      &taskmodels.Task{Title: "subtask", ParentTask: parent},
+			})
+
+			require.Equal(t, tt.wantCode, stat.Code())
+		})
+	}
+}
+
+func TestService_Move(t *testing.T) {
+	t.Parallel()
+
+	const (
+		parentID             = "c2eebc99-9c0b-4ef8-bb6d-6bb9bd380a33"
+		destinationProjectID = "d3eebc99-9c0b-4ef8-bb6d-6bb9bd380a44"
+	)
+
+	tests := []struct {
+		name             string
+		args             taskapi.MoveTaskArgs
+		setupProjectMock func(m *mocks.ProjectStorage)
+		setupStorageMock func(m *mocks.TaskStorage)
+		wantCode         codes.Code
+	}{
+		{
+			name: "under another task",
+			args: taskapi.MoveTaskArgs{
+				ProjectID:               projectID,
+				TaskID:                  taskID,
+				DestinationProjectID:    projectID,
+				DestinationParentTaskID: parentID,
+			},
+			setupProjectMock: func(m *mocks.ProjectStorage) {
+				m.On("Get", mock.Anything, resourcename.ProjectName(projectID)).Return(projectIn(projectmodels.ActiveProjectState), nil).Once()
+			},
+			setupStorageMock: func(m *mocks.TaskStorage) {
+				m.On("Move", mock.Anything, mock.MatchedBy(func(query tasksrv.MoveTaskQuery) bool {
+					return query.Name == resourcename.TaskName(projectID, taskID) &&
+						query.Project == resourcename.ProjectName(projectID) &&
+						query.ParentTask == resourcename.TaskName(projectID, parentID) &&
+						query.MaxDepth == tasksrv.DefaultMaxDepth
+				})).Return(&taskmodels.Task{Name: resourcename.TaskName(projectID, taskID)}, nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "to the top level of another project",
+			args: taskapi.MoveTaskArgs{
+				ProjectID:            projectID,
+				TaskID:               taskID,
+				DestinationProjectID: destinationProjectID,
+			},
+			setupProjectMock: func(m *mocks.ProjectStorage) {
+				m.On("Get", mock.Anything, resourcename.ProjectName(projectID)).Return(projectIn(projectmodels.ActiveProjectState), nil)
+				m.On("Get", mock.Anything, resourcename.ProjectName(destinationProjectID)).Return(&projectmodels.Project{
+					Name:  resourcename.ProjectName(destinationProjectID),
+					State: projectmodels.ActiveProjectState,
+				}, nil)
+			},
+			setupStorageMock: func(m *mocks.TaskStorage) {
+				m.On("Move", mock.Anything, mock.MatchedBy(func(query tasksrv.MoveTaskQuery) bool {
+					return query.Project == resourcename.ProjectName(destinationProjectID) && query.ParentTask == ""
+				})).Return(&taskmodels.Task{Name: resourcename.TaskName(destinationProjectID, taskID)}, nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "archived destination project",
+			args: taskapi.MoveTaskArgs{
+				ProjectID:            projectID,
+				TaskID:               taskID,
+				DestinationProjectID: destinationProjectID,
+			},
+			setupProjectMock: func(m *mocks.ProjectStorage) {
+				m.On("Get", mock.Anything, resourcename.ProjectName(projectID)).Return(projectIn(projectmodels.ActiveProjectState), nil)
+				m.On("Get", mock.Anything, resourcename.ProjectName(destinationProjectID)).Return(&projectmodels.Project{
+					Name:  resourcename.ProjectName(destinationProjectID),
+					State: projectmodels.ArchivedProjectState,
+				}, nil)
+			},
+			setupStorageMock: func(m *mocks.TaskStorage) {},
+			wantCode:         codes.FailedPrecondition,
+		},
+		{
+			name: "cycle is rejected by storage",
+			args: taskapi.MoveTaskArgs{
+				ProjectID:               projectID,
+				TaskID:                  taskID,
+				DestinationProjectID:    projectID,
+				DestinationParentTaskID: parentID,
+			},
+			setupProjectMock: func(m *mocks.ProjectStorage) {
+				m.On("Get", mock.Anything, resourcename.ProjectName(projectID)).Return(projectIn(projectmodels.ActiveProjectState), nil)
+			},
+			setupStorageMock: func(m *mocks.TaskStorage) {
+				m.On("Move", mock.Anything, mock.Anything).Return(nil, status.New(codes.FailedPrecondition, "task cannot be moved under its subtask"))
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			projectMock := mocks.NewProjectStorage(t)
+			tt.setupProjectMock(projectMock)
+			storageMock := mocks.NewTaskStorage(t)
+			tt.setupStorageMock(storageMock)
+
+			service := tasksrv.New(storageMock, projectMock)
+			_, stat := service.Move(context.Background(), tt.args)
+
+			require.Equal(t, tt.wantCode, stat.Code())
+		})
+	}
+}
@@ -0,0 +1,135 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	status "google.golang.org/grpc/status"
+
+	usermodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/user"
+)
+
+// UserStorage is an autogenerated mock type for the UserStorage type
+type UserStorage struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, user
+func (_m *UserStorage) Create(ctx context.Context, user *usermodels.User) *status.Status {
+	ret := _m.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *usermodels.User) *status.Status); ok {
+		r0 = rf(ctx, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*status.Status)
+		}
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, name
+func (_m *UserStorage) Get(ctx context.Context, name string) (*usermodels.User, *status.Status) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *usermodels.User
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*usermodels.User, *status.Status)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *usermodels.User); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usermodels.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) *status.Status); ok {
+		r1 = rf(ctx, name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetBySubject provides a mock function with given fields: ctx, issuer, subject
+func (_m *UserStorage) GetBySubject(ctx context.Context, issuer string, subject string) (*usermodels.User, *status.Status) {
+	ret := _m.Called(ctx, issuer, subject)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBySubject")
+	}
+
+	var r0 *usermodels.User
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*usermodels.User, *status.Status)); ok {
+		return rf(ctx, issuer, subject)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *usermodels.User); ok {
+		r0 = rf(ctx, issuer, subject)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usermodels.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) *status.Status); ok {
+		r1 = rf(ctx, issuer, subject)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, user
+func (_m *UserStorage) Update(ctx context.Context, user *usermodels.User) *status.Status {
+	ret := _m.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *usermodels.User) *status.Status); ok {
+		r0 = rf(ctx, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*status.Status)
+		}
+	}
+
+	return r0
+}
+
+// NewUserStorage creates a new instance of UserStorage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUserStorage(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UserStorage {
+	mock := &UserStorage{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,114 @@
+package usersrv
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"time"
+
+	"github.com/10Narratives/ready-to-do/server/internal/apierror"
+	"github.com/10Narratives/ready-to-do/server/internal/auth"
+	usermodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/user"
+	"github.com/10Narratives/ready-to-do/server/internal/resourcename"
+	userapi "github.com/10Narratives/ready-to-do/server/internal/transport/grpc/tasks/user"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//go:generate mockery --name UserStorage --output ./mocks/
+type UserStorage interface {
+	// Create inserts a user. Returns AlreadyExists if a user with the same
+	// name, or the same issuer and subject, is stored.
+	Create(ctx context.Context, user *usermodels.User) *status.Status
+	Get(ctx context.Context, name string) (*usermodels.User, *status.Status)
+	// GetBySubject returns the user authenticating with tokens of subject by
+	// issuer.
+	GetBySubject(ctx context.Context, issuer, subject string) (*usermodels.User, *status.Status)
+	// Update overwrites the profile of a user.
+	Update(ctx context.Context, user *usermodels.User) *status.Status
+}
+
+type Service struct {
+	storage UserStorage
+}
+
+var (
+	_ userapi.UserService = &Service{}
+	_ auth.Users          = &Service{}
+)
+
+func New(storage UserStorage) *Service {
+	return &Service{
+		storage: storage,
+	}
+}
+
+// Get returns the caller. Other users are not visible to anybody.
+func (s *Service) Get(ctx context.Context, args userapi.GetUserArgs) (*usermodels.User, *status.Status) {
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, apierror.New(codes.Unauthenticated, apierror.ReasonMissingCredentials, nil, "the call is not authenticated")
+	}
+
+	name := principal.User
+	if args.UserID != userapi.MeUserID {
+		name = resourcename.UserName(args.UserID)
+	}
+	if name != principal.User {
+		return nil, apierror.New(codes.PermissionDenied, apierror.ReasonPermissionDenied, nil,
+			fmt.Sprintf("permission denied on user %q", name),
+			apierror.Resource(resourcename.User.Type(), name, "users can only get themselves"))
+	}
+
+	return s.storage.Get(ctx, name)
+}
+
+// Resolve returns the user of claims. The first token of a subject creates
+// its user; later ones update the profile if their claims changed it.
+func (s *Service) Resolve(ctx context.Context, claims *auth.Claims) (*usermodels.User, *status.Status) {
+	user, stat := s.storage.GetBySubject(ctx, claims.Issuer, claims.Subject)
+	if stat == nil {
+		if user.Email == claims.Email && user.DisplayName == claims.Name {
+			return user, nil
+		}
+		user.Email = claims.Email
+		user.DisplayName = claims.Name
+		user.UpdatedAt = time.Now().UTC()
+		if stat := s.storage.Update(ctx, user); stat != nil {
+			return nil, stat
+		}
+		return user, nil
+	}
+	if stat.Code() != codes.NotFound {
+		return nil, stat
+	}
+
+	now := time.Now().UTC()
+	user = &usermodels.User{
+		Name:        resourcename.UserName(newUserID()),
+		Issuer:      claims.Issuer,
+		Subject:     claims.Subject,
+		Email:       claims.Email,
+		DisplayName: claims.Name,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if stat := s.storage.Create(ctx, user); stat != nil {
+		if stat.Code() == codes.AlreadyExists {
+			// A concurrent call of the same subject created the user first.
+			return s.storage.GetBySubject(ctx, claims.Issuer, claims.Subject)
+		}
+		return nil, stat
+	}
+
+	return user, nil
+}
+
+// newUserID returns a random version 4 UUID.
+func newUserID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
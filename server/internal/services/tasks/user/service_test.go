@@ -0,0 +1,176 @@
+package usersrv_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/10Narratives/ready-to-do/server/internal/auth"
+	usermodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/user"
+	"github.com/10Narratives/ready-to-do/server/internal/resourcename"
+	usersrv "github.com/10Narratives/ready-to-do/server/internal/services/tasks/user"
+	"github.com/10Narratives/ready-to-do/server/internal/services/tasks/user/mocks"
+	userapi "github.com/10Narratives/ready-to-do/server/internal/transport/grpc/tasks/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	userID  = "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"
+	otherID = "b1ffcd88-8d1a-4ef8-bb6d-6bb9bd380a22"
+	issuer  = "https://issuer.example.com"
+)
+
+func TestService_Get(t *testing.T) {
+	t.Parallel()
+
+	principal := &auth.Principal{User: "users/" + userID, Issuer: issuer, Subject: "alice"}
+
+	tests := []struct {
+		name             string
+		principal        *auth.Principal
+		userID           string
+		setupStorageMock func(m *mocks.UserStorage)
+		wantCode         codes.Code
+	}{
+		{
+			name:      "me",
+			principal: principal,
+			userID:    userapi.MeUserID,
+			setupStorageMock: func(m *mocks.UserStorage) {
+				m.On("Get", mock.Anything, "users/"+userID).Return(&usermodels.User{Name: "users/" + userID}, nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:      "caller by id",
+			principal: principal,
+			userID:    userID,
+			setupStorageMock: func(m *mocks.UserStorage) {
+				m.On("Get", mock.Anything, "users/"+userID).Return(&usermodels.User{Name: "users/" + userID}, nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:             "other user",
+			principal:        principal,
+			userID:           otherID,
+			setupStorageMock: func(m *mocks.UserStorage) {},
+			wantCode:         codes.PermissionDenied,
+		},
+		{
+			name:             "anonymous caller",
+			userID:           userapi.MeUserID,
+			setupStorageMock: func(m *mocks.UserStorage) {},
+			wantCode:         codes.Unauthenticated,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			storageMock := mocks.NewUserStorage(t)
+			tt.setupStorageMock(storageMock)
+
+			ctx := context.Background()
+			if tt.principal != nil {
+				ctx = auth.NewContext(ctx, tt.principal)
+			}
+
+			got, stat := usersrv.New(storageMock).Get(ctx, userapi.GetUserArgs{UserID: tt.userID})
+			require.Equal(t, tt.wantCode, stat.Code())
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, "users/"+userID, got.Name)
+			}
+		})
+	}
+}
+
+func TestService_Resolve(t *testing.T) {
+	t.Parallel()
+
+	claims := &auth.Claims{Issuer: issuer, Subject: "alice", Email: "alice@example.com", Name: "Alice"}
+	stored := func() *usermodels.User {
+		return &usermodels.User{Name: "users/" + userID, Issuer: issuer, Subject: "alice", Email: "alice@example.com", DisplayName: "Alice"}
+	}
+
+	tests := []struct {
+		name             string
+		claims           *auth.Claims
+		setupStorageMock func(m *mocks.UserStorage)
+		wantCode         codes.Code
+		wantName         string
+	}{
+		{
+			name:   "known user",
+			claims: claims,
+			setupStorageMock: func(m *mocks.UserStorage) {
+				m.On("GetBySubject", mock.Anything, issuer, "alice").Return(stored(), nil)
+			},
+			wantCode: codes.OK,
+			wantName: "users/" + userID,
+		},
+		{
+			name:   "changed profile is updated",
+			claims: &auth.Claims{Issuer: issuer, Subject: "alice", Email: "alice@example.org", Name: "Alice"},
+			setupStorageMock: func(m *mocks.UserStorage) {
+				m.On("GetBySubject", mock.Anything, issuer, "alice").Return(stored(), nil)
+				m.On("Update", mock.Anything, mock.MatchedBy(func(user *usermodels.User) bool {
+					return user.Email == "alice@example.org" && !user.UpdatedAt.IsZero()
+				})).Return(nil)
+			},
+			wantCode: codes.OK,
+			wantName: "users/" + userID,
+		},
+		{
+			name:   "new user is created",
+			claims: claims,
+			setupStorageMock: func(m *mocks.UserStorage) {
+				m.On("GetBySubject", mock.Anything, issuer, "alice").Return(nil, status.New(codes.NotFound, "no user"))
+				m.On("Create", mock.Anything, mock.MatchedBy(func(user *usermodels.User) bool {
+					return resourcename.User.Validate(user.Name) == nil &&
+						user.Subject == "alice" && user.Email == "alice@example.com" &&
+						!user.CreatedAt.IsZero() && user.CreatedAt.Equal(user.UpdatedAt)
+				})).Return(nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:   "concurrently created user is read",
+			claims: claims,
+			setupStorageMock: func(m *mocks.UserStorage) {
+				m.On("GetBySubject", mock.Anything, issuer, "alice").Return(nil, status.New(codes.NotFound, "no user")).Once()
+				m.On("Create", mock.Anything, mock.Anything).Return(status.New(codes.AlreadyExists, "user already exists"))
+				m.On("GetBySubject", mock.Anything, issuer, "alice").Return(stored(), nil).Once()
+			},
+			wantCode: codes.OK,
+			wantName: "users/" + userID,
+		},
+		{
+			name:   "storage status is passed up",
+			claims: claims,
+			setupStorageMock: func(m *mocks.UserStorage) {
+				m.On("GetBySubject", mock.Anything, issuer, "alice").Return(nil, status.New(codes.Internal, "database is down"))
+			},
+			wantCode: codes.Internal,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			storageMock := mocks.NewUserStorage(t)
+			tt.setupStorageMock(storageMock)
+
+			got, stat := usersrv.New(storageMock).Resolve(context.Background(), tt.claims)
+			require.Equal(t, tt.wantCode, stat.Code())
+			if tt.wantName != "" {
+				assert.Equal(t, tt.wantName, got.Name)
+			}
+		})
+	}
+}
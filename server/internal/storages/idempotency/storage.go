@@ -0,0 +1,128 @@
+package idempotencystore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/10Narratives/ready-to-do/server/internal/apierror"
+	idempotencymodels "github.com/10Narratives/ready-to-do/server/internal/models/idempotency"
+	idempotencysrv "github.com/10Narratives/ready-to-do/server/internal/services/idempotency"
+)
+
+type Storage struct {
+	db *sql.DB
+}
+
+var _ idempotencysrv.RecordStorage = &Storage{}
+
+func New(db *sql.DB) *Storage {
+	return &Storage{
+		db: db,
+	}
+}
+
+// Reserve inserts record, replacing a record with the same key that expired
+// by now. If the key is held by an unexpired record, that record is returned
+// and nothing is stored. Concurrent reservations of a key are serialized by
+// its primary key, so exactly one of them succeeds.
+func (s *Storage) Reserve(ctx context.Context, record *idempotencymodels.Record, now time.Time) (*idempotencymodels.Record, *status.Status) {
+	const (
+		reserveQuery = `
+			INSERT INTO idempotency_keys (key, request_hash, response, expire_time)
+			VALUES ($1, $2, NULL, $3)
+			ON CONFLICT (key) DO UPDATE
+			SET request_hash = EXCLUDED.request_hash, response = NULL, expire_time = EXCLUDED.expire_time
+			WHERE idempotency_keys.expire_time <= $4`
+		getQuery = `
+			SELECT key, request_hash, response, expire_time FROM idempotency_keys
+			WHERE key = $1 AND expire_time > $2`
+	)
+
+	res, err := s.db.ExecContext(ctx, reserveQuery, record.Key, record.RequestHash, record.ExpireTime, now)
+	if err != nil {
+		return nil, status.Newf(codes.Internal, "cannot reserve idempotency key: %v", err)
+	}
+	reserved, err := res.RowsAffected()
+	if err != nil {
+		return nil, status.Newf(codes.Internal, "cannot check affected rows: %v", err)
+	}
+	if reserved > 0 {
+		return nil, nil
+	}
+
+	var stored idempotencymodels.Record
+	err = s.db.QueryRowContext(ctx, getQuery, record.Key, now).Scan(
+		&stored.Key,
+		&stored.RequestHash,
+		&stored.Response,
+		&stored.ExpireTime,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		// The holding record expired or was released in the meantime.
+		return nil, apierror.Aborted(apierror.ReasonConcurrentChange, apierror.Metadata{"key": record.Key},
+			fmt.Sprintf("idempotency key %q changed concurrently, retry the request", record.Key))
+	} else if err != nil {
+		return nil, status.Newf(codes.Internal, "cannot read idempotency key: %v", err)
+	}
+
+	return &stored, nil
+}
+
+// Complete stores the response of a reserved key.
+// Returns NotFound if the key is not reserved.
+func (s *Storage) Complete(ctx context.Context, key string, response []byte, expireTime time.Time) *status.Status {
+	const query = `
+		UPDATE idempotency_keys SET response = $2, expire_time = $3
+		WHERE key = $1 AND response IS NULL`
+
+	res, err := s.db.ExecContext(ctx, query, key, response, expireTime)
+	if err != nil {
+		return status.Newf(codes.Internal, "cannot store response: %v", err)
+	}
+	return checkAffected(res, key)
+}
+
+// Release deletes the reservation of a key. Completed keys are kept.
+func (s *Storage) Release(ctx context.Context, key string) *status.Status {
+	const query = `DELETE FROM idempotency_keys WHERE key = $1 AND response IS NULL`
+
+	if _, err := s.db.ExecContext(ctx, query, key); err != nil {
+		return status.Newf(codes.Internal, "cannot release idempotency key: %v", err)
+	}
+	return nil
+}
+
+// Purge removes records whose expire time is not after the given time and
+// returns how many were removed.
+func (s *Storage) Purge(ctx context.Context, before time.Time) (int64, *status.Status) {
+	const query = `DELETE FROM idempotency_keys WHERE expire_time <= $1`
+
+	res, err := s.db.ExecContext(ctx, query, before)
+	if err != nil {
+		return 0, status.Newf(codes.Internal, "cannot purge idempotency keys: %v", err)
+	}
+
+	purged, err := res.RowsAffected()
+	if err != nil {
+		return 0, status.Newf(codes.Internal, "cannot check affected rows: %v", err)
+	}
+
+	return purged, nil
+}
+
+func checkAffected(res sql.Result, key string) *status.Status {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return status.Newf(codes.Internal, "cannot check affected rows: %v", err)
+	}
+	if affected == 0 {
+		return status.Newf(codes.NotFound, "idempotency key %q is not reserved", key)
+	}
+	return nil
+}
@@ -0,0 +1,267 @@
+package labelstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/10Narratives/ready-to-do/server/internal/apierror"
+	labelmodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/label"
+	"github.com/10Narratives/ready-to-do/server/internal/resourcename"
+	labelsrv "github.com/10Narratives/ready-to-do/server/internal/services/tasks/label"
+	"github.com/10Narratives/ready-to-do/server/internal/storages/tenancy"
+)
+
+// uniqueViolation is the SQLSTATE code PostgreSQL reports for duplicate keys.
+const uniqueViolation = "23505"
+
+const labelColumns = `name, display_name, color, created_at, updated_at, COALESCE(owner, '')`
+
+// visibleTo returns the condition selecting the labels visible to the tenant
+// in parameter n: its own labels and the shared ones.
+func visibleTo(n int) string {
+	return fmt.Sprintf(`(owner IS NULL OR owner = $%d)`, n)
+}
+
+// writableBy returns the condition selecting the labels the tenant in
+// parameter n may change: its own labels. Shared labels are read-only for
+// users and only writable by the empty tenant of unauthenticated calls.
+func writableBy(n int) string {
+	return fmt.Sprintf(`COALESCE(owner, '') = $%d`, n)
+}
+
+// Storage stores labels in PostgreSQL. Every call is scoped to the tenant of
+// its context, see package tenancy.
+type Storage struct {
+	db *sql.DB
+}
+
+var _ labelsrv.LabelStorage = &Storage{}
+
+func New(db *sql.DB) *Storage {
+	return &Storage{
+		db: db,
+	}
+}
+
+// Create inserts a new label row owned by the tenant and sets the owner of
+// label.
+// Returns AlreadyExists if the name is taken, by a label of any tenant.
+func (s *Storage) Create(ctx context.Context, label *labelmodels.Label) *status.Status {
+	const query = `
+		INSERT INTO labels (name, display_name, color, created_at, updated_at, owner)
+		VALUES ($1, $2, $3, $4, $5, $6)`
+
+	tenant := tenancy.Of(ctx)
+	tx, stat := s.begin(ctx, tenant)
+	if stat != nil {
+		return stat
+	}
+	defer tx.Rollback()
+
+	_, err := tx.ExecContext(ctx, query,
+		label.Name,
+		label.DisplayName,
+		label.Color,
+		label.CreatedAt,
+		label.UpdatedAt,
+		nullString(tenant),
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return nameTaken(label.Name)
+		}
+		return status.Newf(codes.Internal, "cannot create label: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return status.Newf(codes.Internal, "cannot commit transaction: %v", err)
+	}
+
+	label.Owner = tenant
+	return nil
+}
+
+// Get reads the label with the given resource name.
+// Returns NotFound if there is no such label visible to the tenant.
+func (s *Storage) Get(ctx context.Context, name string) (*labelmodels.Label, *status.Status) {
+	query := `SELECT ` + labelColumns + ` FROM labels WHERE name = $1 AND ` + visibleTo(2)
+
+	tenant := tenancy.Of(ctx)
+	tx, stat := s.begin(ctx, tenant)
+	if stat != nil {
+		return nil, stat
+	}
+	defer tx.Rollback()
+
+	label, err := scanLabel(tx.QueryRowContext(ctx, query, name, tenant))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apierror.NotFound(resourcename.Label.Type(), name)
+	} else if err != nil {
+		return nil, status.Newf(codes.Internal, "cannot get label: %v", err)
+	}
+
+	return label, nil
+}
+
+// List reads one keyset page of the labels visible to the tenant ordered by
+// name.
+func (s *Storage) List(ctx context.Context, query labelsrv.ListLabelsQuery) ([]*labelmodels.Label, *status.Status) {
+	sqlQuery := `SELECT ` + labelColumns + ` FROM labels WHERE name > $1 AND ` + visibleTo(3) + ` ORDER BY name LIMIT $2`
+
+	tenant := tenancy.Of(ctx)
+	tx, stat := s.begin(ctx, tenant)
+	if stat != nil {
+		return nil, stat
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, sqlQuery, query.AfterName, query.PageSize+1, tenant)
+	if err != nil {
+		return nil, status.Newf(codes.Internal, "cannot list labels: %v", err)
+	}
+	defer rows.Close()
+
+	labels := make([]*labelmodels.Label, 0, query.PageSize+1)
+	for rows.Next() {
+		label, err := scanLabel(rows)
+		if err != nil {
+			return nil, status.Newf(codes.Internal, "cannot list labels: %v", err)
+		}
+		labels = append(labels, label)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Newf(codes.Internal, "cannot list labels: %v", err)
+	}
+
+	return labels, nil
+}
+
+// Update overwrites every mutable column of the stored label.
+// Returns NotFound if there is no such label visible to the tenant and
+// PermissionDenied if it is shared.
+func (s *Storage) Update(ctx context.Context, label *labelmodels.Label) *status.Status {
+	query := `
+		UPDATE labels
+		SET display_name = $2, color = $3, updated_at = $4
+		WHERE name = $1 AND ` + writableBy(5)
+
+	tenant := tenancy.Of(ctx)
+	tx, stat := s.begin(ctx, tenant)
+	if stat != nil {
+		return stat
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, query,
+		label.Name,
+		label.DisplayName,
+		label.Color,
+		label.UpdatedAt,
+		tenant,
+	)
+	if err != nil {
+		return status.Newf(codes.Internal, "cannot update label: %v", err)
+	}
+	if stat := s.checkAffected(ctx, res, label.Name); stat != nil {
+		return stat
+	}
+
+	if err := tx.Commit(); err != nil {
+		return status.Newf(codes.Internal, "cannot commit transaction: %v", err)
+	}
+	return nil
+}
+
+// Delete removes the label row with the given resource name. Its project and
+// task assignments are removed by the cascading foreign keys.
+// Returns NotFound if there is no such label visible to the tenant and
+// PermissionDenied if it is shared.
+func (s *Storage) Delete(ctx context.Context, name string) *status.Status {
+	query := `DELETE FROM labels WHERE name = $1 AND ` + writableBy(2)
+
+	tenant := tenancy.Of(ctx)
+	tx, stat := s.begin(ctx, tenant)
+	if stat != nil {
+		return stat
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, query, name, tenant)
+	if err != nil {
+		return status.Newf(codes.Internal, "cannot delete label: %v", err)
+	}
+	if stat := s.checkAffected(ctx, res, name); stat != nil {
+		return stat
+	}
+
+	if err := tx.Commit(); err != nil {
+		return status.Newf(codes.Internal, "cannot commit transaction: %v", err)
+	}
+	return nil
+}
+
+// nameTaken reports a label name that is already in use. Names are unique
+// across tenants, so the status must not tell whether the label is visible.
+func nameTaken(name string) *status.Status {
+	return apierror.New(codes.AlreadyExists, apierror.ReasonAlreadyExists, nil,
+		fmt.Sprintf("label name %q is not available, choose another label id", name))
+}
+
+// begin starts a transaction scoped to tenant.
+func (s *Storage) begin(ctx context.Context, tenant string) (*sql.Tx, *status.Status) {
+	tx, err := tenancy.Begin(ctx, s.db, tenant)
+	if err != nil {
+		return nil, status.Newf(codes.Internal, "cannot begin transaction: %v", err)
+	}
+	return tx, nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanLabel(row scanner) (*labelmodels.Label, error) {
+	var label labelmodels.Label
+	if err := row.Scan(
+		&label.Name,
+		&label.DisplayName,
+		&label.Color,
+		&label.CreatedAt,
+		&label.UpdatedAt,
+		&label.Owner,
+	); err != nil {
+		return nil, err
+	}
+	return &label, nil
+}
+
+// checkAffected explains a write that matched no label: either there is no
+// such label visible to the tenant or it is shared and read-only.
+func (s *Storage) checkAffected(ctx context.Context, res sql.Result, name string) *status.Status {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return status.Newf(codes.Internal, "cannot check affected rows: %v", err)
+	}
+	if affected > 0 {
+		return nil
+	}
+
+	label, stat := s.Get(ctx, name)
+	if stat != nil {
+		return stat
+	}
+	return apierror.New(codes.PermissionDenied, apierror.ReasonPermissionDenied, apierror.Metadata{"resourceName": name},
+		fmt.Sprintf("label %q is shared and cannot be changed", label.Name),
+		apierror.Resource(resourcename.Label.Type(), name, ""))
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
@@ -0,0 +1,267 @@
+package projectstore
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	projectmodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/project"
+	"github.com/10Narratives/ready-to-do/server/internal/storages/tenancy"
+)
+
+// ChangesChannel is the notification channel the projects trigger signals
+// after recording a change.
+const ChangesChannel = "project_changes"
+
+// changeLockID identifies the transaction advisory lock held while change log
+// entries are sequenced.
+const changeLockID int64 = 0x70726f6a63686e67 // "projchng"
+
+// LastChange returns the sequence number of the latest committed change of a
+// project visible to the tenant, or zero if there is none. Watchers start
+// there, so it is never below the purged part of the log; the sequence
+// numbers of changes of other tenants are not revealed.
+func (s *Storage) LastChange(ctx context.Context) (int64, *status.Status) {
+	const (
+		horizonQuery = `SELECT COALESCE(min(seq), 1) - 1 FROM project_changes`
+		lastQuery    = `SELECT COALESCE(max(seq), 0) FROM project_changes WHERE owner IS NULL OR owner = $1`
+	)
+
+	if stat := s.sequenceChanges(ctx); stat != nil {
+		return 0, stat
+	}
+
+	var horizon int64
+	if err := s.scanAll(ctx, horizonQuery, &horizon); err != nil {
+		return 0, status.Newf(codes.Internal, "cannot read change range: %v", err)
+	}
+
+	tenant := tenancy.Of(ctx)
+	tx, stat := s.begin(ctx, tenant)
+	if stat != nil {
+		return 0, stat
+	}
+	defer tx.Rollback()
+
+	var seq int64
+	if err := tx.QueryRowContext(ctx, lastQuery, tenant).Scan(&seq); err != nil {
+		return 0, status.Newf(codes.Internal, "cannot read last change: %v", err)
+	}
+	return max(seq, horizon), nil
+}
+
+// ListChanges returns up to limit changes of projects visible to the tenant
+// after the change with sequence number after, in order, together with the
+// state of the changed projects when the changes were committed. Returns
+// FailedPrecondition if changes after it were purged already or it was never
+// issued.
+func (s *Storage) ListChanges(ctx context.Context, after int64, limit int) ([]*projectmodels.ProjectChange, *status.Status) {
+	const (
+		rangeQuery = `SELECT COALESCE(min(seq), 1), COALESCE(max(seq), 0) FROM project_changes`
+		listQuery  = `
+			SELECT seq, kind, project, change_time, snapshot FROM project_changes
+			WHERE seq > $1 AND (owner IS NULL OR owner = $3) ORDER BY seq LIMIT $2`
+	)
+
+	if stat := s.sequenceChanges(ctx); stat != nil {
+		return nil, stat
+	}
+
+	// Purging keeps the latest change and sequence numbers have no gaps, so
+	// the log holds every change after first-1. Sequence numbers are shared
+	// by all tenants, so the range is too.
+	var first, last int64
+	if err := s.scanAll(ctx, rangeQuery, &first, &last); err != nil {
+		return nil, status.Newf(codes.Internal, "cannot read change range: %v", err)
+	}
+	if after < first-1 || after > last {
+		return nil, status.Newf(codes.FailedPrecondition, "changes after change %d are not available", after)
+	}
+
+	tenant := tenancy.Of(ctx)
+	tx, stat := s.begin(ctx, tenant)
+	if stat != nil {
+		return nil, stat
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, listQuery, after, limit, tenant)
+	if err != nil {
+		return nil, status.Newf(codes.Internal, "cannot list changes: %v", err)
+	}
+	defer rows.Close()
+
+	var changes []*projectmodels.ProjectChange
+	for rows.Next() {
+		var (
+			change   projectmodels.ProjectChange
+			snapshot []byte
+		)
+		if err := rows.Scan(&change.Seq, &change.Type, &change.Name, &change.Time, &snapshot); err != nil {
+			return nil, status.Newf(codes.Internal, "cannot list changes: %v", err)
+		}
+		if snapshot != nil {
+			if change.Project, err = decodeSnapshot(snapshot); err != nil {
+				return nil, status.Newf(codes.Internal, "cannot decode change %d: %v", change.Seq, err)
+			}
+		}
+		changes = append(changes, &change)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Newf(codes.Internal, "cannot list changes: %v", err)
+	}
+	return changes, nil
+}
+
+// projectSnapshot is a projects row as recorded in the snapshot column of
+// project_changes. Columns that are NULL keep their zero values.
+type projectSnapshot struct {
+	Name        string                     `json:"name"`
+	DisplayName string                     `json:"display_name"`
+	Description string                     `json:"description"`
+	ColorTag    string                     `json:"color_tag"`
+	CreatedAt   snapshotTime               `json:"created_at"`
+	UpdatedAt   snapshotTime               `json:"updated_at"`
+	State       projectmodels.ProjectState `json:"state"`
+	DeleteTime  snapshotTime               `json:"delete_time"`
+	PurgeTime   snapshotTime               `json:"purge_time"`
+	Position    string                     `json:"position"`
+	Labels      string                     `json:"labels"`
+	Version     int64                      `json:"version"`
+	Owner       string                     `json:"owner"`
+}
+
+// decodeSnapshot returns the project recorded in a change snapshot.
+func decodeSnapshot(data []byte) (*projectmodels.Project, error) {
+	var snapshot projectSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, err
+	}
+	return &projectmodels.Project{
+		Name:        snapshot.Name,
+		DisplayName: snapshot.DisplayName,
+		Description: snapshot.Description,
+		ColorTag:    snapshot.ColorTag,
+		CreatedAt:   time.Time(snapshot.CreatedAt),
+		UpdatedAt:   time.Time(snapshot.UpdatedAt),
+		State:       snapshot.State,
+		DeleteTime:  time.Time(snapshot.DeleteTime),
+		PurgeTime:   time.Time(snapshot.PurgeTime),
+		Position:    snapshot.Position,
+		Labels:      splitLabels(snapshot.Labels),
+		Version:     snapshot.Version,
+		Owner:       snapshot.Owner,
+	}, nil
+}
+
+// snapshotTimeLayout is how PostgreSQL writes TIMESTAMP values to JSON.
+const snapshotTimeLayout = "2006-01-02T15:04:05.999999"
+
+// snapshotTime is a TIMESTAMP column of a snapshot, in UTC like all stored
+// times.
+type snapshotTime time.Time
+
+func (t *snapshotTime) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	parsed, err := time.Parse(snapshotTimeLayout, value)
+	if err != nil {
+		return err
+	}
+	*t = snapshotTime(parsed)
+	return nil
+}
+
+// PurgeChanges removes changes of all tenants sequenced before the given time
+// and returns how many were removed. The latest change is always kept, so
+// that sequence numbers keep growing.
+func (s *Storage) PurgeChanges(ctx context.Context, before time.Time) (int64, *status.Status) {
+	const query = `
+		DELETE FROM project_changes
+		WHERE seq <= (SELECT max(seq) FROM project_changes WHERE sequence_time < $1)
+		AND seq < (SELECT max(seq) FROM project_changes)`
+
+	tx, stat := s.begin(ctx, tenancy.All)
+	if stat != nil {
+		return 0, stat
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, query, before)
+	if err != nil {
+		return 0, status.Newf(codes.Internal, "cannot purge changes: %v", err)
+	}
+
+	purged, err := res.RowsAffected()
+	if err != nil {
+		return 0, status.Newf(codes.Internal, "cannot check affected rows: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, status.Newf(codes.Internal, "cannot commit transaction: %v", err)
+	}
+	return purged, nil
+}
+
+// sequenceChanges numbers the committed changes that have no sequence number
+// yet, continuing after the highest one. Changes are recorded in transactions
+// that commit in any order, so numbering them on commit instead of on insert
+// guarantees that a reader who has seen a sequence number has also seen all
+// lower ones.
+func (s *Storage) sequenceChanges(ctx context.Context) *status.Status {
+	const (
+		pendingQuery  = `SELECT EXISTS (SELECT 1 FROM project_changes WHERE seq IS NULL)`
+		sequenceQuery = `
+			UPDATE project_changes
+			SET seq = last.seq + pending.n, sequence_time = clock_timestamp() AT TIME ZONE 'UTC'
+			FROM (SELECT COALESCE(max(seq), 0) AS seq FROM project_changes) AS last,
+				(SELECT id, row_number() OVER (ORDER BY id) AS n FROM project_changes WHERE seq IS NULL) AS pending
+			WHERE project_changes.id = pending.id`
+	)
+
+	var pending bool
+	if err := s.scanAll(ctx, pendingQuery, &pending); err != nil {
+		return status.Newf(codes.Internal, "cannot check pending changes: %v", err)
+	}
+	if !pending {
+		return nil
+	}
+
+	tx, stat := s.begin(ctx, tenancy.All)
+	if stat != nil {
+		return stat
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock($1)`, changeLockID); err != nil {
+		return status.Newf(codes.Internal, "cannot lock changes: %v", err)
+	}
+	if _, err := tx.ExecContext(ctx, sequenceQuery); err != nil {
+		return status.Newf(codes.Internal, "cannot sequence changes: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return status.Newf(codes.Internal, "cannot commit transaction: %v", err)
+	}
+	return nil
+}
+
+// scanAll scans the single row query returns across tenants.
+func (s *Storage) scanAll(ctx context.Context, query string, dest ...any) error {
+	tx, err := tenancy.Begin(ctx, s.db, tenancy.All)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	return tx.QueryRowContext(ctx, query).Scan(dest...)
+}
@@ -0,0 +1,45 @@
+//go:build integration
+
+package projectstore_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	projectmodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/project"
+)
+
+func TestStorage_ListChangesSnapshots(t *testing.T) {
+	tt := setupTenants(t)
+
+	project := *tt.aliceProject
+	project.DisplayName = "renamed"
+	require.Nil(t, tt.storage.Update(tt.alice, &project))
+
+	changes, stat := tt.storage.ListChanges(tt.alice, tt.firstChange, 10000)
+	require.Nil(t, stat)
+
+	var mine []*projectmodels.ProjectChange
+	for _, change := range changes {
+		if change.Name == project.Name {
+			mine = append(mine, change)
+		}
+	}
+	require.Len(t, mine, 2)
+
+	// Every change carries the project as it was committed, not as it is now.
+	assert.Equal(t, projectmodels.CreatedChangeType, mine[0].Type)
+	require.NotNil(t, mine[0].Project)
+	assert.Equal(t, tt.aliceProject.DisplayName, mine[0].Project.DisplayName)
+	assert.Equal(t, tt.aliceProject.Version, mine[0].Project.Version)
+	assert.Equal(t, tt.aliceUser, mine[0].Project.Owner)
+	assert.WithinDuration(t, tt.aliceProject.CreatedAt, mine[0].Project.CreatedAt, time.Millisecond)
+
+	assert.Equal(t, projectmodels.UpdatedChangeType, mine[1].Type)
+	require.NotNil(t, mine[1].Project)
+	assert.Equal(t, "renamed", mine[1].Project.DisplayName)
+	assert.Equal(t, project.Version, mine[1].Project.Version)
+}
@@ -3,22 +3,840 @@ package projectstore
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
 
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/10Narratives/ready-to-do/server/internal/apierror"
 	projectmodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/project"
+	"github.com/10Narratives/ready-to-do/server/internal/rank"
+	"github.com/10Narratives/ready-to-do/server/internal/resourcename"
 	projectsrv "github.com/10Narratives/ready-to-do/server/internal/services/tasks/project"
+	"github.com/10Narratives/ready-to-do/server/internal/storages/tenancy"
 )
 
+// SQLSTATE codes PostgreSQL reports for constraint violations.
+const (
+	uniqueViolation     = "23505"
+	foreignKeyViolation = "23503"
+)
+
+// positionLockID identifies the transaction advisory lock held while project
+// positions are assigned, so that no two projects get the same position.
+const positionLockID int64 = 0x70726f6a706f7321 // "projpos!"
+
+const projectColumns = `name, COALESCE(display_name, ''), COALESCE(description, ''), COALESCE(color_tag, ''), created_at, updated_at, COALESCE(state, 0), delete_time, purge_time, position, ` +
+	`COALESCE((SELECT string_agg(label, ',' ORDER BY label) FROM project_labels WHERE project_labels.project = projects.name), ''), version, COALESCE(owner, '')`
+
+// visibleTo returns the condition selecting the projects visible to the
+// tenant in parameter n: its own projects and the shared ones.
+func visibleTo(n int) string {
+	return fmt.Sprintf(`(owner IS NULL OR owner = $%d)`, n)
+}
+
+// writableBy returns the condition selecting the projects the tenant in
+// parameter n may change: its own projects. Shared projects are read-only for
+// users and only writable by the empty tenant of unauthenticated calls.
+func writableBy(n int) string {
+	return fmt.Sprintf(`COALESCE(owner, '') = $%d`, n)
+}
+
+// Storage stores projects in PostgreSQL. Every call is scoped to the tenant
+// of its context, see package tenancy, except for the maintenance calls
+// Purge, Rebalance and PurgeChanges, which work across tenants.
 type Storage struct {
+	db *sql.DB
 }
 
 var _ projectsrv.ProjectStorage = &Storage{}
 
 func New(db *sql.DB) *Storage {
-	return &Storage{}
+	return &Storage{
+		db: db,
+	}
 }
 
+// Create inserts a new project row owned by the tenant and positioned after
+// all other projects of the tenant, assigns its labels and sets the position,
+// version and owner of project.
+// Returns AlreadyExists if a project with the same name is already stored and
+// NotFound if one of its labels does not exist. Names are unique across
+// tenants, so the conflict does not tell whether the tenant can see the
+// project.
 func (s *Storage) Create(ctx context.Context, project *projectmodels.Project) *status.Status {
+	return s.BatchCreate(ctx, []*projectmodels.Project{project})
+}
+
+// BatchCreate inserts projects in one transaction, positioned after all other
+// projects in the given order, and sets their positions, versions and owners.
+// Nothing is stored if any of them cannot be.
+// Returns AlreadyExists if a project with the same name is already stored and
+// NotFound if one of the labels does not exist.
+func (s *Storage) BatchCreate(ctx context.Context, projects []*projectmodels.Project) *status.Status {
+	const query = `
+		INSERT INTO projects (name, display_name, description, color_tag, created_at, updated_at, state, position, owner)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING version`
+
+	tenant := tenancy.Of(ctx)
+	tx, stat := s.beginPositioning(ctx, tenant)
+	if stat != nil {
+		return stat
+	}
+	defer tx.Rollback()
+
+	var last string
+	lastQuery := `SELECT COALESCE(max(position), '') FROM projects WHERE ` + visibleTo(1)
+	if err := tx.QueryRowContext(ctx, lastQuery, tenant).Scan(&last); err != nil {
+		return status.Newf(codes.Internal, "cannot read last position: %v", err)
+	}
+
+	positions := make([]string, len(projects))
+	versions := make([]int64, len(projects))
+	for i, project := range projects {
+		position, err := rank.After(last)
+		if err != nil {
+			return status.Newf(codes.Internal, "cannot compute position: %v", err)
+		}
+		last = position
+
+		err = tx.QueryRowContext(ctx, query,
+			project.Name,
+			project.DisplayName,
+			project.Description,
+			project.ColorTag,
+			project.CreatedAt,
+			project.UpdatedAt,
+			project.State,
+			position,
+			nullString(tenant),
+		).Scan(&versions[i])
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+				return nameTaken(project.Name)
+			}
+			return status.Newf(codes.Internal, "cannot create project: %v", err)
+		}
+
+		if stat := setLabels(ctx, tx, tenant, project.Name, project.Labels); stat != nil {
+			return stat
+		}
+		positions[i] = position
+	}
+
+	if err := tx.Commit(); err != nil {
+		return status.Newf(codes.Internal, "cannot commit transaction: %v", err)
+	}
+
+	for i, project := range projects {
+		project.Position = positions[i]
+		project.Version = versions[i]
+		project.Owner = tenant
+	}
+	return nil
+}
+
+// Get reads the project with the given resource name.
+// Returns NotFound if there is no such project visible to the tenant.
+func (s *Storage) Get(ctx context.Context, name string) (*projectmodels.Project, *status.Status) {
+	query := `SELECT ` + projectColumns + ` FROM projects WHERE name = $1 AND ` + visibleTo(2)
+
+	tenant := tenancy.Of(ctx)
+	tx, stat := s.begin(ctx, tenant)
+	if stat != nil {
+		return nil, stat
+	}
+	defer tx.Rollback()
+
+	project, err := scanProject(tx.QueryRowContext(ctx, query, name, tenant))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apierror.NotFound(resourcename.Project.Type(), name)
+	} else if err != nil {
+		return nil, status.Newf(codes.Internal, "cannot get project: %v", err)
+	}
+
+	return project, nil
+}
+
+// BatchGet reads the projects with the given resource names in one query and
+// returns them in the order of names. Repeated names yield the same project
+// repeatedly.
+// Returns NotFound if any of the projects does not exist or is not visible to
+// the tenant.
+func (s *Storage) BatchGet(ctx context.Context, names []string) ([]*projectmodels.Project, *status.Status) {
+	query := `SELECT ` + projectColumns + ` FROM projects WHERE name = ANY($1::text[]) AND ` + visibleTo(2)
+
+	tenant := tenancy.Of(ctx)
+	tx, stat := s.begin(ctx, tenant)
+	if stat != nil {
+		return nil, stat
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, query, names, tenant)
+	if err != nil {
+		return nil, status.Newf(codes.Internal, "cannot get projects: %v", err)
+	}
+	defer rows.Close()
+
+	found := make(map[string]*projectmodels.Project, len(names))
+	for rows.Next() {
+		project, err := scanProject(rows)
+		if err != nil {
+			return nil, status.Newf(codes.Internal, "cannot get projects: %v", err)
+		}
+		found[project.Name] = project
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Newf(codes.Internal, "cannot get projects: %v", err)
+	}
+
+	projects := make([]*projectmodels.Project, 0, len(names))
+	var missing []string
+	for _, name := range names {
+		project, ok := found[name]
+		if !ok {
+			missing = append(missing, name)
+			continue
+		}
+		projects = append(projects, project)
+	}
+	if len(missing) > 0 {
+		return nil, apierror.NotFound(resourcename.Project.Type(), missing...)
+	}
+
+	return projects, nil
+}
+
+// List reads one keyset page of the projects visible to the tenant in the
+// requested order.
+// Ties on the order column are broken by name, so pages stay stable while
+// other projects are being inserted.
+func (s *Storage) List(ctx context.Context, query projectsrv.ListProjectsQuery) ([]*projectmodels.Project, *status.Status) {
+	column := orderColumns[query.OrderBy.Field]
+	if column == "" {
+		return nil, status.Newf(codes.InvalidArgument, "unsupported order field %q", query.OrderBy.Field)
+	}
+
+	direction, comparison := "ASC", ">"
+	if query.OrderBy.Desc {
+		direction, comparison = "DESC", "<"
+	}
+
+	states := make([]int32, 0, len(query.States))
+	for _, state := range query.States {
+		states = append(states, int32(state))
+	}
+
+	tenant := tenancy.Of(ctx)
+	args := []any{tenant, states}
+	conditions := []string{visibleTo(1), `COALESCE(state, 0) = ANY($2)`}
+	if query.After != nil {
+		args = append(args, cursorValue(query.OrderBy.Field, query.After), query.After.Name)
+		conditions = append(conditions, fmt.Sprintf(`(%s, name) %s ($%d, $%d)`, column, comparison, len(args)-1, len(args)))
+	}
+	if query.Filter != nil {
+		condition, filterArgs := query.Filter.SQL(len(args) + 1)
+		args = append(args, filterArgs...)
+		conditions = append(conditions, condition)
+	}
+	args = append(args, query.PageSize+1)
+
+	sqlQuery := fmt.Sprintf(`SELECT %s FROM projects WHERE %s ORDER BY %s %s, name %s LIMIT $%d`,
+		projectColumns, strings.Join(conditions, " AND "), column, direction, direction, len(args))
+
+	tx, stat := s.begin(ctx, tenant)
+	if stat != nil {
+		return nil, stat
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, status.Newf(codes.Internal, "cannot list projects: %v", err)
+	}
+	defer rows.Close()
+
+	projects := make([]*projectmodels.Project, 0, query.PageSize+1)
+	for rows.Next() {
+		project, err := scanProject(rows)
+		if err != nil {
+			return nil, status.Newf(codes.Internal, "cannot list projects: %v", err)
+		}
+		projects = append(projects, project)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Newf(codes.Internal, "cannot list projects: %v", err)
+	}
+
+	return projects, nil
+}
+
+// orderColumns maps order fields to the SQL expressions projects are sorted by.
+var orderColumns = map[projectmodels.ProjectOrderField]string{
+	projectmodels.OrderByCreatedAt:   "created_at",
+	projectmodels.OrderByUpdatedAt:   "updated_at",
+	projectmodels.OrderByDisplayName: "COALESCE(display_name, '')",
+	projectmodels.OrderByState:       "COALESCE(state, 0)",
+	projectmodels.OrderByPosition:    "position",
+}
+
+func cursorValue(field projectmodels.ProjectOrderField, cursor *projectsrv.ProjectCursor) any {
+	switch field {
+	case projectmodels.OrderByUpdatedAt:
+		return cursor.UpdatedAt
+	case projectmodels.OrderByDisplayName:
+		return cursor.DisplayName
+	case projectmodels.OrderByState:
+		return int32(cursor.State)
+	case projectmodels.OrderByPosition:
+		return cursor.Position
+	default:
+		return cursor.CreatedAt
+	}
+}
+
+// Update overwrites every mutable column and the labels of the stored project
+// if it is still at project.Version, and sets the new version of project.
+// Returns NotFound if there is no such project visible to the tenant or one of
+// its labels does not exist, PermissionDenied if the project is read-only for
+// the tenant and Aborted if the project has been changed since it was read.
+func (s *Storage) Update(ctx context.Context, project *projectmodels.Project) *status.Status {
+	return s.BatchUpdate(ctx, []*projectmodels.Project{project})
+}
+
+// BatchUpdate updates projects like Update in one transaction. Nothing is
+// stored if any of the projects cannot be.
+func (s *Storage) BatchUpdate(ctx context.Context, projects []*projectmodels.Project) *status.Status {
+	query := `
+		UPDATE projects
+		SET display_name = $2, description = $3, color_tag = $4, updated_at = $5, state = $6,
+			delete_time = $7, purge_time = $8, version = version + 1
+		WHERE name = $1 AND version = $9 AND ` + writableBy(10) + `
+		RETURNING version`
+
+	tenant := tenancy.Of(ctx)
+	tx, stat := s.begin(ctx, tenant)
+	if stat != nil {
+		return stat
+	}
+	defer tx.Rollback()
+
+	versions := make([]int64, len(projects))
+	for i, project := range projects {
+		err := tx.QueryRowContext(ctx, query,
+			project.Name,
+			project.DisplayName,
+			project.Description,
+			project.ColorTag,
+			project.UpdatedAt,
+			project.State,
+			nullTime(project.DeleteTime),
+			nullTime(project.PurgeTime),
+			project.Version,
+			tenant,
+		).Scan(&versions[i])
+		if errors.Is(err, sql.ErrNoRows) {
+			return s.versionConflict(ctx, project.Name)
+		} else if err != nil {
+			return status.Newf(codes.Internal, "cannot update project: %v", err)
+		}
+
+		if stat := setLabels(ctx, tx, tenant, project.Name, project.Labels); stat != nil {
+			return stat
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return status.Newf(codes.Internal, "cannot commit transaction: %v", err)
+	}
+
+	for i, project := range projects {
+		project.Version = versions[i]
+	}
+	return nil
+}
+
+// versionConflict explains why a versioned update of a project matched no
+// row: either the project is gone, it is read-only for the tenant or another
+// change got there first.
+func (s *Storage) versionConflict(ctx context.Context, name string) *status.Status {
+	if _, stat := s.getWritable(ctx, name); stat != nil {
+		return stat
+	}
+	return apierror.Aborted(apierror.ReasonConcurrentChange, apierror.Metadata{"resourceName": name},
+		fmt.Sprintf("project %q was changed concurrently, retry the request", name),
+		apierror.Resource(resourcename.Project.Type(), name, ""))
+}
+
+// Delete removes the project row with the given resource name.
+// Returns NotFound if there is no such project visible to the tenant and
+// PermissionDenied if the project is read-only for the tenant.
+func (s *Storage) Delete(ctx context.Context, name string) *status.Status {
+	query := `DELETE FROM projects WHERE name = $1 AND ` + writableBy(2)
+
+	tenant := tenancy.Of(ctx)
+	tx, stat := s.begin(ctx, tenant)
+	if stat != nil {
+		return stat
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, query, name, tenant)
+	if err != nil {
+		return status.Newf(codes.Internal, "cannot delete project: %v", err)
+	}
+	if stat := checkAffected(res, name); stat != nil {
+		if _, denied := s.getWritable(ctx, name); denied != nil {
+			return denied
+		}
+		return stat
+	}
+
+	if err := tx.Commit(); err != nil {
+		return status.Newf(codes.Internal, "cannot commit transaction: %v", err)
+	}
+	return nil
+}
+
+// Transition atomically moves a project from state from to state to.
+// Returns NotFound if there is no such project visible to the tenant,
+// PermissionDenied if the project is read-only for the tenant and
+// FailedPrecondition if the project is in any other state.
+func (s *Storage) Transition(ctx context.Context, name string, from, to projectmodels.ProjectState, updateTime time.Time) (*projectmodels.Project, *status.Status) {
+	project, stat := s.transition(ctx, name, from, to, updateTime)
+	if stat != nil || project != nil {
+		return project, stat
+	}
+
+	current, stat := s.getWritable(ctx, name)
+	if stat != nil {
+		return nil, stat
+	}
+	state := projectmodels.ProjectStateToGRPC(current.State).String()
+	return nil, apierror.New(codes.FailedPrecondition, apierror.ReasonInvalidState,
+		apierror.Metadata{"resourceName": name, "state": state},
+		fmt.Sprintf("project %q is %s, expected %s", name, state, projectmodels.ProjectStateToGRPC(from)),
+		apierror.Resource(resourcename.Project.Type(), name, ""))
+}
+
+// transition changes the state of a project in state from and returns it, or
+// nil if there is no such project.
+func (s *Storage) transition(ctx context.Context, name string, from, to projectmodels.ProjectState, updateTime time.Time) (*projectmodels.Project, *status.Status) {
+	query := `
+		UPDATE projects SET state = $3, updated_at = $4, version = version + 1
+		WHERE name = $1 AND COALESCE(state, 0) = $2 AND ` + writableBy(5) + `
+		RETURNING ` + projectColumns
+
+	tenant := tenancy.Of(ctx)
+	tx, stat := s.begin(ctx, tenant)
+	if stat != nil {
+		return nil, stat
+	}
+	defer tx.Rollback()
+
+	project, err := scanProject(tx.QueryRowContext(ctx, query, name, from, to, updateTime, tenant))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, status.Newf(codes.Internal, "cannot change project state: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Newf(codes.Internal, "cannot commit transaction: %v", err)
+	}
+	return project, nil
+}
+
+// Purge removes soft-deleted projects of all tenants whose purge time is not
+// after the given time and returns how many were removed.
+func (s *Storage) Purge(ctx context.Context, before time.Time) (int64, *status.Status) {
+	const query = `DELETE FROM projects WHERE state = $1 AND purge_time <= $2`
+
+	tx, stat := s.begin(ctx, tenancy.All)
+	if stat != nil {
+		return 0, stat
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, query, projectmodels.DeletedprojectState, before)
+	if err != nil {
+		return 0, status.Newf(codes.Internal, "cannot purge projects: %v", err)
+	}
+
+	purged, err := res.RowsAffected()
+	if err != nil {
+		return 0, status.Newf(codes.Internal, "cannot check affected rows: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, status.Newf(codes.Internal, "cannot commit transaction: %v", err)
+	}
+	return purged, nil
+}
+
+// Move gives a project a position between the anchor and the project of the
+// tenant next to it, so that only the moved row is rewritten.
+// Returns NotFound if either project does not exist or is not visible to the
+// tenant and PermissionDenied if the moved project is read-only for the
+// tenant.
+func (s *Storage) Move(ctx context.Context, name, anchor string, after bool, updateTime time.Time) (*projectmodels.Project, *status.Status) {
+	tenant := tenancy.Of(ctx)
+	tx, stat := s.beginPositioning(ctx, tenant)
+	if stat != nil {
+		return nil, stat
+	}
+	defer tx.Rollback()
+
+	var anchorPosition string
+	anchorQuery := `SELECT position FROM projects WHERE name = $1 AND ` + visibleTo(2)
+	err := tx.QueryRowContext(ctx, anchorQuery, anchor, tenant).Scan(&anchorPosition)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apierror.NotFound(resourcename.Project.Type(), anchor)
+	} else if err != nil {
+		return nil, status.Newf(codes.Internal, "cannot read anchor position: %v", err)
+	}
+
+	// The neighbour is the project on the other side of the anchor, skipping
+	// the moved project itself.
+	neighbourQuery := `
+		SELECT position FROM projects
+		WHERE name <> $1 AND (position, name) > ($2, $3) AND ` + visibleTo(4) + `
+		ORDER BY position, name LIMIT 1`
+	if !after {
+		neighbourQuery = `
+			SELECT position FROM projects
+			WHERE name <> $1 AND (position, name) < ($2, $3) AND ` + visibleTo(4) + `
+			ORDER BY position DESC, name DESC LIMIT 1`
+	}
+
+	var neighbour string
+	err = tx.QueryRowContext(ctx, neighbourQuery, name, anchorPosition, anchor, tenant).Scan(&neighbour)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Newf(codes.Internal, "cannot read neighbour position: %v", err)
+	}
+
+	lower, upper := anchorPosition, neighbour
+	if !after {
+		lower, upper = neighbour, anchorPosition
+	}
+	position, err := rank.Between(lower, upper)
+	if err != nil {
+		return nil, status.Newf(codes.Internal, "cannot compute position: %v", err)
+	}
+
+	query := `UPDATE projects SET position = $2, updated_at = $3, version = version + 1 WHERE name = $1 AND ` + writableBy(4) + ` RETURNING ` + projectColumns
+	project, err := scanProject(tx.QueryRowContext(ctx, query, name, position, updateTime, tenant))
+	if errors.Is(err, sql.ErrNoRows) {
+		if _, denied := s.getWritable(ctx, name); denied != nil {
+			return nil, denied
+		}
+		return nil, apierror.NotFound(resourcename.Project.Type(), name)
+	} else if err != nil {
+		return nil, status.Newf(codes.Internal, "cannot move project: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Newf(codes.Internal, "cannot commit transaction: %v", err)
+	}
+
+	return project, nil
+}
+
+// Rebalance respaces the positions of every tenant that has a position longer
+// than maxLength and returns how many projects were repositioned. Tenants are
+// rebalanced one at a time, the shared projects like a tenant of their own.
+// Only rows whose position changes are rewritten, and the order every tenant
+// sees is kept: the projects of a user are respaced between the shared
+// projects around them, and shared projects between the projects of users.
+func (s *Storage) Rebalance(ctx context.Context, maxLength int) (int64, *status.Status) {
+	const ownersQuery = `SELECT DISTINCT owner FROM projects WHERE length(position) > $1`
+
+	tx, stat := s.beginPositioning(ctx, tenancy.All)
+	if stat != nil {
+		return 0, stat
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, ownersQuery, maxLength)
+	if err != nil {
+		return 0, status.Newf(codes.Internal, "cannot find long positions: %v", err)
+	}
+	var owners []sql.NullString
+	for rows.Next() {
+		var owner sql.NullString
+		if err := rows.Scan(&owner); err != nil {
+			rows.Close()
+			return 0, status.Newf(codes.Internal, "cannot find long positions: %v", err)
+		}
+		owners = append(owners, owner)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, status.Newf(codes.Internal, "cannot find long positions: %v", err)
+	}
+
+	var rebalanced int64
+	for _, owner := range owners {
+		n, stat := rebalanceOwner(ctx, tx, owner)
+		if stat != nil {
+			return 0, stat
+		}
+		rebalanced += n
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, status.Newf(codes.Internal, "cannot commit transaction: %v", err)
+	}
+
+	return rebalanced, nil
+}
+
+// rebalanceOwner respaces the projects of owner, the shared ones if owner is
+// NULL, between the projects that stay put around them and returns how many
+// positions changed.
+func rebalanceOwner(ctx context.Context, tx *sql.Tx, owner sql.NullString) (int64, *status.Status) {
+	// Users see their own and the shared projects, while shared projects are
+	// seen along with the projects of every user.
+	const (
+		listQuery = `
+			SELECT name, position, owner IS NOT DISTINCT FROM $1 FROM projects
+			WHERE $1::text IS NULL OR owner = $1 OR owner IS NULL
+			ORDER BY position, name`
+		updateQuery = `
+			UPDATE projects SET position = v.position, version = version + 1
+			FROM unnest($1::text[], $2::text[]) AS v(name, position)
+			WHERE projects.name = v.name`
+	)
+
+	rows, err := tx.QueryContext(ctx, listQuery, owner)
+	if err != nil {
+		return 0, status.Newf(codes.Internal, "cannot read positions: %v", err)
+	}
+
+	var (
+		names, positions []string
+		// run holds the names and positions of the projects of owner since
+		// the last project that stays put, at position lower.
+		runNames, runPositions []string
+		lower                  string
+	)
+	respace := func(upper string) error {
+		if len(runNames) == 0 {
+			return nil
+		}
+		spaced, err := rank.SpacedBetween(lower, upper, len(runNames))
+		if errors.Is(err, rank.ErrOrder) {
+			// Projects of different tenants may share a position; leave
+			// the run between them alone.
+			spaced, err = runPositions, nil
+		}
+		if err != nil {
+			return err
+		}
+		for i, position := range spaced {
+			if position != runPositions[i] {
+				names = append(names, runNames[i])
+				positions = append(positions, position)
+			}
+		}
+		runNames, runPositions = runNames[:0], runPositions[:0]
+		return nil
+	}
+	for rows.Next() {
+		var (
+			name, position string
+			mine           bool
+		)
+		if err := rows.Scan(&name, &position, &mine); err != nil {
+			rows.Close()
+			return 0, status.Newf(codes.Internal, "cannot read positions: %v", err)
+		}
+		if mine {
+			runNames = append(runNames, name)
+			runPositions = append(runPositions, position)
+			continue
+		}
+		if err := respace(position); err != nil {
+			rows.Close()
+			return 0, status.Newf(codes.Internal, "cannot compute positions: %v", err)
+		}
+		lower = position
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, status.Newf(codes.Internal, "cannot read positions: %v", err)
+	}
+	if err := respace(""); err != nil {
+		return 0, status.Newf(codes.Internal, "cannot compute positions: %v", err)
+	}
+	if len(names) == 0 {
+		return 0, nil
+	}
+
+	res, err := tx.ExecContext(ctx, updateQuery, names, positions)
+	if err != nil {
+		return 0, status.Newf(codes.Internal, "cannot rebalance positions: %v", err)
+	}
+	rebalanced, err := res.RowsAffected()
+	if err != nil {
+		return 0, status.Newf(codes.Internal, "cannot check affected rows: %v", err)
+	}
+	return rebalanced, nil
+}
+
+// nameTaken reports that a project name is in use. The status is the same
+// whoever owns the project and carries no resource details, so it does not
+// confirm that a project of another tenant exists.
+func nameTaken(name string) *status.Status {
+	return apierror.New(codes.AlreadyExists, apierror.ReasonAlreadyExists, nil,
+		fmt.Sprintf("project name %q is not available, choose another project id", name))
+}
+
+// getWritable reads a project like Get and returns PermissionDenied if the
+// tenant may see but not change it.
+func (s *Storage) getWritable(ctx context.Context, name string) (*projectmodels.Project, *status.Status) {
+	project, stat := s.Get(ctx, name)
+	if stat != nil {
+		return nil, stat
+	}
+	if project.Owner != tenancy.Of(ctx) {
+		return nil, apierror.New(codes.PermissionDenied, apierror.ReasonPermissionDenied, apierror.Metadata{"resourceName": name},
+			fmt.Sprintf("project %q is shared and cannot be changed", name),
+			apierror.Resource(resourcename.Project.Type(), name, ""))
+	}
+	return project, nil
+}
+
+// begin starts a transaction scoped to tenant.
+func (s *Storage) begin(ctx context.Context, tenant string) (*sql.Tx, *status.Status) {
+	tx, err := tenancy.Begin(ctx, s.db, tenant)
+	if err != nil {
+		return nil, status.Newf(codes.Internal, "cannot begin transaction: %v", err)
+	}
+	return tx, nil
+}
+
+// beginPositioning starts a transaction scoped to tenant holding the position
+// lock.
+func (s *Storage) beginPositioning(ctx context.Context, tenant string) (*sql.Tx, *status.Status) {
+	tx, stat := s.begin(ctx, tenant)
+	if stat != nil {
+		return nil, stat
+	}
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock($1)`, positionLockID); err != nil {
+		tx.Rollback()
+		return nil, status.Newf(codes.Internal, "cannot lock positions: %v", err)
+	}
+	return tx, nil
+}
+
+// setLabels makes labels the exact set of labels assigned to a project.
+// Assignments that stay are left untouched. Only labels visible to tenant
+// can be assigned.
+func setLabels(ctx context.Context, tx *sql.Tx, tenant, name string, labels []string) *status.Status {
+	const (
+		deleteQuery = `DELETE FROM project_labels WHERE project = $1 AND label <> ALL($2::text[])`
+		insertQuery = `
+			INSERT INTO project_labels (project, label)
+			SELECT $1, unnest($2::text[])
+			ON CONFLICT DO NOTHING`
+		// Labels of other tenants are reported like missing ones.
+		missingQuery = `
+			SELECT label FROM unnest($1::text[]) AS label
+			WHERE NOT EXISTS (SELECT 1 FROM labels WHERE name = label AND (owner IS NULL OR owner = $2))
+			LIMIT 1`
+	)
+
+	if labels == nil {
+		labels = []string{}
+	}
+	if _, err := tx.ExecContext(ctx, deleteQuery, name, labels); err != nil {
+		return status.Newf(codes.Internal, "cannot unassign labels: %v", err)
+	}
+
+	var missing string
+	err := tx.QueryRowContext(ctx, missingQuery, labels, tenant).Scan(&missing)
+	if err == nil {
+		return apierror.NotFound(resourcename.Label.Type(), missing)
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return status.Newf(codes.Internal, "cannot check labels: %v", err)
+	}
+	if _, err := tx.ExecContext(ctx, insertQuery, name, labels); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+			return status.Newf(codes.NotFound, "cannot assign labels to project %q: %s", name, pgErr.Detail)
+		}
+		return status.Newf(codes.Internal, "cannot assign labels: %v", err)
+	}
+	return nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanProject(row scanner) (*projectmodels.Project, error) {
+	var (
+		project    projectmodels.Project
+		deleteTime sql.NullTime
+		purgeTime  sql.NullTime
+		labels     string
+	)
+	if err := row.Scan(
+		&project.Name,
+		&project.DisplayName,
+		&project.Description,
+		&project.ColorTag,
+		&project.CreatedAt,
+		&project.UpdatedAt,
+		&project.State,
+		&deleteTime,
+		&purgeTime,
+		&project.Position,
+		&labels,
+		&project.Version,
+		&project.Owner,
+	); err != nil {
+		return nil, err
+	}
+	project.DeleteTime = deleteTime.Time
+	project.PurgeTime = purgeTime.Time
+	project.Labels = splitLabels(labels)
+	return &project, nil
+}
+
+// splitLabels splits the comma separated label names read from the database.
+func splitLabels(labels string) []string {
+	if labels == "" {
+		return nil
+	}
+	return strings.Split(labels, ",")
+}
+
+// nullTime stores zero times as NULL.
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
+
+// nullString stores empty strings as NULL.
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+func checkAffected(res sql.Result, name string) *status.Status {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return status.Newf(codes.Internal, "cannot check affected rows: %v", err)
+	}
+	if affected == 0 {
+		return apierror.NotFound(resourcename.Project.Type(), name)
+	}
 	return nil
 }
@@ -0,0 +1,571 @@
+package taskstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/10Narratives/ready-to-do/server/internal/apierror"
+	taskmodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/task"
+	"github.com/10Narratives/ready-to-do/server/internal/resourcename"
+	tasksrv "github.com/10Narratives/ready-to-do/server/internal/services/tasks/task"
+	"github.com/10Narratives/ready-to-do/server/internal/storages/tenancy"
+)
+
+// SQLSTATE codes PostgreSQL reports for constraint violations.
+const (
+	uniqueViolation     = "23505"
+	foreignKeyViolation = "23503"
+	checkViolation      = "23514"
+)
+
+const taskColumns = `name, title, notes, due_time, due_time_zone, priority, state, completed_at, created_at, updated_at, COALESCE(parent, ''), array_to_string(path, ','), ` +
+	`COALESCE((SELECT string_agg(label, ',' ORDER BY label) FROM task_labels WHERE task_labels.task = tasks.name), '')`
+
+// parentForeignKey is the constraint linking subtasks to their parent task.
+const parentForeignKey = "tasks_parent_fkey"
+
+// parentProjectCheck is the constraint keeping subtasks in the project of
+// their parent task.
+const parentProjectCheck = "tasks_parent_project_check"
+
+// noDueTime sorts tasks without a due time after all tasks with one.
+var noDueTime = time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+type Storage struct {
+	db *sql.DB
+}
+
+var _ tasksrv.TaskStorage = &Storage{}
+
+func New(db *sql.DB) *Storage {
+	return &Storage{
+		db: db,
+	}
+}
+
+// Create inserts a new task row below its parent task, assigns its labels and
+// sets the tree path and depth of the task.
+// Returns AlreadyExists if a task with the same name is already stored,
+// NotFound if its project, parent task or one of its labels does not exist
+// and InvalidArgument if the parent task belongs to another project.
+func (s *Storage) Create(ctx context.Context, task *taskmodels.Task) *status.Status {
+	// The parent row is locked so that a concurrent move cannot leave the
+	// new subtask with a stale path.
+	const query = `
+		INSERT INTO tasks (name, project, parent, title, notes, due_time, due_time_zone, priority, state, completed_at, created_at, updated_at, path)
+		VALUES ($1, $2, NULLIF($3, ''), $4, $5, $6, $7, $8, $9, $10, $11, $12,
+			COALESCE((SELECT path FROM tasks WHERE name = $3 AND project = $2 FOR SHARE), '{}') || $13::text[])
+		RETURNING array_to_string(path, ',')`
+
+	if task.ParentTask != "" && projectOf(task.ParentTask) != projectOf(task.Name) {
+		return otherProjectParent("task.parent_task", task.ParentTask)
+	}
+
+	tenant := tenancy.Of(ctx)
+	tx, err := tenancy.Begin(ctx, s.db, tenant)
+	if err != nil {
+		return status.Newf(codes.Internal, "cannot begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	var path string
+	err = tx.QueryRowContext(ctx, query,
+		task.Name,
+		projectOf(task.Name),
+		task.ParentTask,
+		task.Title,
+		task.Notes,
+		nullTime(task.DueTime),
+		task.DueTimeZone,
+		task.Priority,
+		task.State,
+		nullTime(task.CompletedAt),
+		task.CreatedAt,
+		task.UpdatedAt,
+		[]string{pathKey(task.Name, task.CreatedAt)},
+	).Scan(&path)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch {
+			case pgErr.Code == uniqueViolation:
+				return apierror.AlreadyExists(resourcename.Task.Type(), task.Name)
+			case pgErr.Code == foreignKeyViolation && pgErr.ConstraintName == parentForeignKey:
+				return apierror.New(codes.NotFound, apierror.ReasonNotFound,
+					apierror.Metadata{"resourceType": resourcename.Task.Type(), "resourceName": task.ParentTask},
+					fmt.Sprintf("parent task %q not found", task.ParentTask),
+					apierror.Resource(resourcename.Task.Type(), task.ParentTask, "parent task"))
+			case pgErr.Code == checkViolation && pgErr.ConstraintName == parentProjectCheck:
+				return otherProjectParent("task.parent_task", task.ParentTask)
+			case pgErr.Code == foreignKeyViolation:
+				return apierror.NotFound(resourcename.Project.Type(), projectOf(task.Name))
+			}
+		}
+		return status.Newf(codes.Internal, "cannot create task: %v", err)
+	}
+
+	if stat := setLabels(ctx, tx, tenant, task.Name, task.Labels); stat != nil {
+		return stat
+	}
+
+	if err := tx.Commit(); err != nil {
+		return status.Newf(codes.Internal, "cannot commit transaction: %v", err)
+	}
+
+	setPath(task, path)
+	return nil
+}
+
+// Get reads the task with the given resource name.
+// Returns NotFound if there is no such task.
+func (s *Storage) Get(ctx context.Context, name string) (*taskmodels.Task, *status.Status) {
+	return getTask(ctx, s.db, name, "")
+}
+
+type querier interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// getTask reads a task with q, appending lock to the query.
+func getTask(ctx context.Context, q querier, name, lock string) (*taskmodels.Task, *status.Status) {
+	query := `SELECT ` + taskColumns + ` FROM tasks WHERE name = $1 ` + lock
+
+	task, err := scanTask(q.QueryRowContext(ctx, query, name))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apierror.NotFound(resourcename.Task.Type(), name)
+	} else if err != nil {
+		return nil, status.Newf(codes.Internal, "cannot get task: %v", err)
+	}
+
+	return task, nil
+}
+
+// List reads one keyset page of the tasks of a project in the requested
+// order. Ties on the order column are broken by name. Tree listings are
+// sorted by path, which puts every task right before its subtasks.
+func (s *Storage) List(ctx context.Context, query tasksrv.ListTasksQuery) ([]*taskmodels.Task, *status.Status) {
+	column := orderColumns[query.OrderBy.Field]
+	if query.Tree {
+		column = "path"
+	}
+	if column == "" {
+		return nil, status.Newf(codes.InvalidArgument, "unsupported order field %q", query.OrderBy.Field)
+	}
+
+	direction, comparison := "ASC", ">"
+	if query.OrderBy.Desc && !query.Tree {
+		direction, comparison = "DESC", "<"
+	}
+
+	args := []any{query.Parent}
+	conditions := []string{`project = $1`}
+	if query.After != nil {
+		after := cursorValue(query.OrderBy.Field, query.After)
+		if query.Tree {
+			after = query.After.Path
+		}
+		args = append(args, after, query.After.Name)
+		conditions = append(conditions, fmt.Sprintf(`(%s, name) %s ($%d, $%d)`, column, comparison, len(args)-1, len(args)))
+	}
+	if query.Filter != nil {
+		condition, filterArgs := query.Filter.SQL(len(args) + 1)
+		args = append(args, filterArgs...)
+		conditions = append(conditions, condition)
+	}
+	args = append(args, query.PageSize+1)
+
+	sqlQuery := fmt.Sprintf(`SELECT %s FROM tasks WHERE %s ORDER BY %s %s, name %s LIMIT $%d`,
+		taskColumns, strings.Join(conditions, " AND "), column, direction, direction, len(args))
+
+	rows, err := s.db.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, status.Newf(codes.Internal, "cannot list tasks: %v", err)
+	}
+	defer rows.Close()
+
+	tasks := make([]*taskmodels.Task, 0, query.PageSize+1)
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return nil, status.Newf(codes.Internal, "cannot list tasks: %v", err)
+		}
+		tasks = append(tasks, task)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Newf(codes.Internal, "cannot list tasks: %v", err)
+	}
+
+	return tasks, nil
+}
+
+// orderColumns maps order fields to the SQL expressions tasks are sorted by.
+var orderColumns = map[taskmodels.TaskOrderField]string{
+	taskmodels.OrderByCreatedAt: "created_at",
+	taskmodels.OrderByUpdatedAt: "updated_at",
+	taskmodels.OrderByDueTime:   "COALESCE(due_time, '9999-12-31')",
+	taskmodels.OrderByPriority:  "priority",
+	taskmodels.OrderByTitle:     "title",
+}
+
+func cursorValue(field taskmodels.TaskOrderField, cursor *tasksrv.TaskCursor) any {
+	switch field {
+	case taskmodels.OrderByUpdatedAt:
+		return cursor.UpdatedAt
+	case taskmodels.OrderByDueTime:
+		if cursor.DueTime.IsZero() {
+			return noDueTime
+		}
+		return cursor.DueTime
+	case taskmodels.OrderByPriority:
+		return int32(cursor.Priority)
+	case taskmodels.OrderByTitle:
+		return cursor.Title
+	default:
+		return cursor.CreatedAt
+	}
+}
+
+// Update overwrites every mutable column and the labels of the stored task.
+// Returns NotFound if there is no such task or one of its labels does not
+// exist.
+func (s *Storage) Update(ctx context.Context, task *taskmodels.Task) *status.Status {
+	const query = `
+		UPDATE tasks
+		SET title = $2, notes = $3, due_time = $4, due_time_zone = $5, priority = $6, updated_at = $7
+		WHERE name = $1`
+
+	tenant := tenancy.Of(ctx)
+	tx, err := tenancy.Begin(ctx, s.db, tenant)
+	if err != nil {
+		return status.Newf(codes.Internal, "cannot begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, query,
+		task.Name,
+		task.Title,
+		task.Notes,
+		nullTime(task.DueTime),
+		task.DueTimeZone,
+		task.Priority,
+		task.UpdatedAt,
+	)
+	if err != nil {
+		return status.Newf(codes.Internal, "cannot update task: %v", err)
+	}
+	if stat := checkAffected(res, task.Name); stat != nil {
+		return stat
+	}
+
+	if stat := setLabels(ctx, tx, tenant, task.Name, task.Labels); stat != nil {
+		return stat
+	}
+
+	if err := tx.Commit(); err != nil {
+		return status.Newf(codes.Internal, "cannot commit transaction: %v", err)
+	}
+
+	return nil
+}
+
+// Delete removes the task row with the given resource name. Subtasks are
+// removed by the cascading parent foreign key.
+// Returns NotFound if there is no such task.
+func (s *Storage) Delete(ctx context.Context, name string) *status.Status {
+	const query = `DELETE FROM tasks WHERE name = $1`
+
+	res, err := s.db.ExecContext(ctx, query, name)
+	if err != nil {
+		return status.Newf(codes.Internal, "cannot delete task: %v", err)
+	}
+
+	return checkAffected(res, name)
+}
+
+// Transition atomically moves a task from state from to state to and sets
+// its completion time when it becomes COMPLETED. With cascade, the subtasks
+// in state from are moved in the same transaction.
+// Returns NotFound if there is no such task and FailedPrecondition if the
+// task is in any other state.
+func (s *Storage) Transition(ctx context.Context, name string, from, to taskmodels.TaskState, cascade bool, updateTime time.Time) (*taskmodels.Task, *status.Status) {
+	query := `
+		UPDATE tasks SET state = $3, updated_at = $4, completed_at = $5
+		WHERE name = $1 AND state = $2
+		RETURNING ` + taskColumns
+
+	var completedAt sql.NullTime
+	if to == taskmodels.CompletedTaskState {
+		completedAt = nullTime(updateTime)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Newf(codes.Internal, "cannot begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	task, err := scanTask(tx.QueryRowContext(ctx, query, name, from, to, updateTime, completedAt))
+	if errors.Is(err, sql.ErrNoRows) {
+		current, stat := getTask(ctx, tx, name, "")
+		if stat != nil {
+			return nil, stat
+		}
+		state := taskmodels.TaskStateToGRPC(current.State).String()
+		return nil, apierror.New(codes.FailedPrecondition, apierror.ReasonInvalidState,
+			apierror.Metadata{"resourceName": name, "state": state},
+			fmt.Sprintf("task %q is %s, expected %s", name, state, taskmodels.TaskStateToGRPC(from)),
+			apierror.Resource(resourcename.Task.Type(), name, ""))
+	} else if err != nil {
+		return nil, status.Newf(codes.Internal, "cannot change task state: %v", err)
+	}
+
+	if cascade {
+		const subtasksQuery = `
+			UPDATE tasks SET state = $3, updated_at = $4, completed_at = $5
+			WHERE project = $1 AND path[1:$6] = $7 AND cardinality(path) > $6 AND state = $2`
+
+		_, err := tx.ExecContext(ctx, subtasksQuery,
+			projectOf(name), from, to, updateTime, completedAt, len(task.Path), task.Path)
+		if err != nil {
+			return nil, status.Newf(codes.Internal, "cannot change subtask states: %v", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Newf(codes.Internal, "cannot commit transaction: %v", err)
+	}
+
+	return task, nil
+}
+
+// Move reparents a task with its subtasks. The rows of the affected projects
+// are locked for the duration of the move, so concurrent moves cannot
+// combine into a cycle.
+// Returns NotFound if the task or the new parent does not exist,
+// InvalidArgument if the new parent is not in query.Project and
+// FailedPrecondition if the new parent is the task itself or one of its
+// subtasks, or if the subtree would end up nested deeper than query.MaxDepth.
+func (s *Storage) Move(ctx context.Context, query tasksrv.MoveTaskQuery) (*taskmodels.Task, *status.Status) {
+	if query.ParentTask != "" && projectOf(query.ParentTask) != query.Project {
+		return nil, otherProjectParent("destination_parent_task", query.ParentTask)
+	}
+
+	// The projects are only locked if they are visible to the caller.
+	tx, err := tenancy.Begin(ctx, s.db, tenancy.Of(ctx))
+	if err != nil {
+		return nil, status.Newf(codes.Internal, "cannot begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	source := projectOf(query.Name)
+	projects := []string{source, query.Project}
+	if _, err := tx.ExecContext(ctx, `SELECT name FROM projects WHERE name = ANY($1) ORDER BY name FOR UPDATE`, projects); err != nil {
+		return nil, status.Newf(codes.Internal, "cannot lock projects: %v", err)
+	}
+
+	task, stat := getTask(ctx, tx, query.Name, "FOR UPDATE")
+	if stat != nil {
+		return nil, stat
+	}
+
+	parentPath := []string{}
+	if query.ParentTask != "" {
+		parent, stat := getTask(ctx, tx, query.ParentTask, "FOR UPDATE")
+		if stat != nil {
+			return nil, stat
+		}
+		if parent.Name == task.Name || slices.Contains(parent.Path, task.Path[len(task.Path)-1]) {
+			return nil, status.Newf(codes.FailedPrecondition, "task %q cannot be moved under itself or its subtask %q", task.Name, parent.Name)
+		}
+		parentPath = parent.Path
+	}
+
+	var height int
+	if err := tx.QueryRowContext(ctx,
+		`SELECT max(cardinality(path)) FROM tasks WHERE project = $1 AND path[1:$2] = $3`,
+		source, len(task.Path), task.Path,
+	).Scan(&height); err != nil {
+		return nil, status.Newf(codes.Internal, "cannot measure subtasks: %v", err)
+	}
+	if depth := len(parentPath) + height - len(task.Path); depth > query.MaxDepth {
+		return nil, apierror.New(codes.FailedPrecondition, apierror.ReasonNestingTooDeep,
+			apierror.Metadata{"maxDepth": strconv.Itoa(query.MaxDepth)},
+			fmt.Sprintf("moving task %q would nest subtasks %d levels deep, the limit is %d", task.Name, depth, query.MaxDepth))
+	}
+
+	// Parent links are checked at the end of the statement, by which time the
+	// whole subtree has been renamed. Label assignments follow the new names
+	// through their cascading foreign key.
+	const moveQuery = `
+		UPDATE tasks SET
+			project = $3,
+			name = $3 || '/tasks/' || split_part(name, '/', 4),
+			parent = CASE WHEN name = $1 THEN NULLIF($4, '') ELSE $3 || '/tasks/' || split_part(parent, '/', 4) END,
+			path = $5::text[] || path[$6:],
+			updated_at = CASE WHEN name = $1 THEN $7 ELSE updated_at END
+		WHERE project = $2 AND path[1:$6] = $8`
+
+	_, err = tx.ExecContext(ctx, moveQuery,
+		query.Name, source, query.Project, query.ParentTask, parentPath, len(task.Path), query.UpdateTime, task.Path)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch {
+			case pgErr.Code == uniqueViolation:
+				return nil, status.Newf(codes.AlreadyExists, "project %q already has a task with the id of %q or one of its subtasks", query.Project, task.Name)
+			case pgErr.Code == checkViolation && pgErr.ConstraintName == parentProjectCheck:
+				return nil, otherProjectParent("destination_parent_task", query.ParentTask)
+			}
+		}
+		return nil, status.Newf(codes.Internal, "cannot move task: %v", err)
+	}
+
+	moved, stat := getTask(ctx, tx, query.Project+"/tasks/"+taskIDOf(task.Name), "")
+	if stat != nil {
+		return nil, stat
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Newf(codes.Internal, "cannot commit transaction: %v", err)
+	}
+
+	return moved, nil
+}
+
+// setLabels makes labels the exact set of labels assigned to a task.
+// Assignments that stay are left untouched. Only labels visible to tenant
+// can be assigned.
+func setLabels(ctx context.Context, tx *sql.Tx, tenant, name string, labels []string) *status.Status {
+	const (
+		deleteQuery = `DELETE FROM task_labels WHERE task = $1 AND label <> ALL($2::text[])`
+		insertQuery = `
+			INSERT INTO task_labels (task, label)
+			SELECT $1, unnest($2::text[])
+			ON CONFLICT DO NOTHING`
+		// Labels of other tenants are reported like missing ones.
+		missingQuery = `
+			SELECT label FROM unnest($1::text[]) AS label
+			WHERE NOT EXISTS (SELECT 1 FROM labels WHERE name = label AND (owner IS NULL OR owner = $2))
+			LIMIT 1`
+	)
+
+	if labels == nil {
+		labels = []string{}
+	}
+	if _, err := tx.ExecContext(ctx, deleteQuery, name, labels); err != nil {
+		return status.Newf(codes.Internal, "cannot unassign labels: %v", err)
+	}
+
+	var missing string
+	err := tx.QueryRowContext(ctx, missingQuery, labels, tenant).Scan(&missing)
+	if err == nil {
+		return apierror.NotFound(resourcename.Label.Type(), missing)
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return status.Newf(codes.Internal, "cannot check labels: %v", err)
+	}
+	if _, err := tx.ExecContext(ctx, insertQuery, name, labels); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+			return status.Newf(codes.NotFound, "cannot assign labels to task %q: %s", name, pgErr.Detail)
+		}
+		return status.Newf(codes.Internal, "cannot assign labels: %v", err)
+	}
+	return nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanTask(row scanner) (*taskmodels.Task, error) {
+	var (
+		task        taskmodels.Task
+		dueTime     sql.NullTime
+		completedAt sql.NullTime
+		path        string
+		labels      string
+	)
+	if err := row.Scan(
+		&task.Name,
+		&task.Title,
+		&task.Notes,
+		&dueTime,
+		&task.DueTimeZone,
+		&task.Priority,
+		&task.State,
+		&completedAt,
+		&task.CreatedAt,
+		&task.UpdatedAt,
+		&task.ParentTask,
+		&path,
+		&labels,
+	); err != nil {
+		return nil, err
+	}
+	task.DueTime = dueTime.Time
+	task.CompletedAt = completedAt.Time
+	setPath(&task, path)
+	if labels != "" {
+		task.Labels = strings.Split(labels, ",")
+	}
+	return &task, nil
+}
+
+// setPath sets the tree path and depth of a task from the comma separated
+// path read from the database.
+func setPath(task *taskmodels.Task, path string) {
+	task.Path = strings.Split(path, ",")
+	task.Depth = len(task.Path) - 1
+}
+
+// pathKey is the element a task contributes to the paths of itself and its
+// subtasks. Keys sort siblings by creation time; 0003_add_task_hierarchy
+// computes the same keys for tasks created before paths existed.
+func pathKey(name string, createdAt time.Time) string {
+	return createdAt.UTC().Format("20060102150405.000000") + "/" + taskIDOf(name)
+}
+
+// otherProjectParent returns InvalidArgument for a parent task outside the
+// project of its subtask, reporting the violation on field.
+func otherProjectParent(field, parent string) *status.Status {
+	return apierror.BadRequest("invalid "+field, apierror.Violation(field, fmt.Sprintf("parent task %q belongs to another project", parent)))
+}
+
+// projectOf returns the project part of a projects/{project}/tasks/{task} name.
+func projectOf(name string) string {
+	if i := strings.Index(name, "/tasks/"); i >= 0 {
+		return name[:i]
+	}
+	return name
+}
+
+// taskIDOf returns the task part of a projects/{project}/tasks/{task} name.
+func taskIDOf(name string) string {
+	return name[strings.LastIndex(name, "/")+1:]
+}
+
+// nullTime stores zero times as NULL.
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
+
+func checkAffected(res sql.Result, name string) *status.Status {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return status.Newf(codes.Internal, "cannot check affected rows: %v", err)
+	}
+	if affected == 0 {
+		return apierror.NotFound(resourcename.Task.Type(), name)
+	}
+	return nil
+}
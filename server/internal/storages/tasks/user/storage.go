@@ -0,0 +1,138 @@
+package userstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/10Narratives/ready-to-do/server/internal/apierror"
+	usermodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/user"
+	"github.com/10Narratives/ready-to-do/server/internal/resourcename"
+	usersrv "github.com/10Narratives/ready-to-do/server/internal/services/tasks/user"
+)
+
+// uniqueViolation is the SQLSTATE code PostgreSQL reports for duplicate keys.
+const uniqueViolation = "23505"
+
+const userColumns = `name, issuer, subject, email, display_name, created_at, updated_at`
+
+type Storage struct {
+	db *sql.DB
+}
+
+var _ usersrv.UserStorage = &Storage{}
+
+func New(db *sql.DB) *Storage {
+	return &Storage{
+		db: db,
+	}
+}
+
+// Create inserts a new user row.
+// Returns AlreadyExists if the name or the issuer and subject are taken.
+func (s *Storage) Create(ctx context.Context, user *usermodels.User) *status.Status {
+	const query = `
+		INSERT INTO users (name, issuer, subject, email, display_name, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	_, err := s.db.ExecContext(ctx, query,
+		user.Name,
+		user.Issuer,
+		user.Subject,
+		user.Email,
+		user.DisplayName,
+		user.CreatedAt,
+		user.UpdatedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return apierror.AlreadyExists(resourcename.User.Type(), user.Name)
+		}
+		return status.Newf(codes.Internal, "cannot create user: %v", err)
+	}
+
+	return nil
+}
+
+// Get reads the user with the given resource name.
+// Returns NotFound if there is no such user.
+func (s *Storage) Get(ctx context.Context, name string) (*usermodels.User, *status.Status) {
+	query := `SELECT ` + userColumns + ` FROM users WHERE name = $1`
+
+	user, err := scanUser(s.db.QueryRowContext(ctx, query, name))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apierror.NotFound(resourcename.User.Type(), name)
+	} else if err != nil {
+		return nil, status.Newf(codes.Internal, "cannot get user: %v", err)
+	}
+
+	return user, nil
+}
+
+// GetBySubject reads the user with the given issuer and subject.
+// Returns NotFound if there is no such user.
+func (s *Storage) GetBySubject(ctx context.Context, issuer, subject string) (*usermodels.User, *status.Status) {
+	query := `SELECT ` + userColumns + ` FROM users WHERE issuer = $1 AND subject = $2`
+
+	user, err := scanUser(s.db.QueryRowContext(ctx, query, issuer, subject))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Newf(codes.NotFound, "no user for subject %q of issuer %q", subject, issuer)
+	} else if err != nil {
+		return nil, status.Newf(codes.Internal, "cannot get user: %v", err)
+	}
+
+	return user, nil
+}
+
+// Update overwrites the profile columns of the stored user.
+// Returns NotFound if there is no such user.
+func (s *Storage) Update(ctx context.Context, user *usermodels.User) *status.Status {
+	const query = `
+		UPDATE users
+		SET email = $2, display_name = $3, updated_at = $4
+		WHERE name = $1`
+
+	res, err := s.db.ExecContext(ctx, query,
+		user.Name,
+		user.Email,
+		user.DisplayName,
+		user.UpdatedAt,
+	)
+	if err != nil {
+		return status.Newf(codes.Internal, "cannot update user: %v", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return status.Newf(codes.Internal, "cannot check affected rows: %v", err)
+	}
+	if affected == 0 {
+		return apierror.NotFound(resourcename.User.Type(), user.Name)
+	}
+	return nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanUser(row scanner) (*usermodels.User, error) {
+	var user usermodels.User
+	if err := row.Scan(
+		&user.Name,
+		&user.Issuer,
+		&user.Subject,
+		&user.Email,
+		&user.DisplayName,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
@@ -0,0 +1,53 @@
+// Package tenancy scopes database transactions to the tenant of their call.
+//
+// Projects and labels belong to the user that created them or, without an
+// owner, are shared with everybody. Users read shared ones but only change
+// their own. Shared projects and labels are created and changed by the empty
+// tenant of unauthenticated calls, which only happen while authentication is
+// disabled.
+//
+// Storages filter every query by the tenant themselves; as a second line of
+// defense the row level security policies of the projects, project_changes and
+// labels tables only expose the rows of the tenant a transaction was begun
+// for.
+// Transactions that were not begun here count as the empty tenant.
+package tenancy
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/10Narratives/ready-to-do/server/internal/auth"
+)
+
+// Setting is the transaction-local setting the row level security policies
+// read the tenant from.
+const Setting = "app.tenant"
+
+// All is the tenant of maintenance transactions, such as purging, that work
+// across tenants.
+const All = "*"
+
+// Of returns the tenant of the call of ctx: the resource name of the
+// authenticated user, or the empty tenant, which owns the shared projects, for
+// unauthenticated calls.
+func Of(ctx context.Context) string {
+	if principal, ok := auth.FromContext(ctx); ok {
+		return principal.User
+	}
+	return ""
+}
+
+// Begin starts a transaction scoped to tenant.
+func Begin(ctx context.Context, db *sql.DB, tenant string) (*sql.Tx, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tx.ExecContext(ctx, `SELECT set_config($1, $2, true)`, Setting, tenant); err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("cannot set tenant: %w", err)
+	}
+	return tx, nil
+}
@@ -0,0 +1,74 @@
+package interceptors
+
+import (
+	"context"
+	"strings"
+
+	"github.com/10Narratives/ready-to-do/server/internal/apierror"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+// AuthorizationMetadataKey is the metadata key bearer tokens are read from.
+// The gateway forwards the Authorization header under it.
+const AuthorizationMetadataKey = "authorization"
+
+// Authenticator authenticates the callers of calls.
+type Authenticator interface {
+	// Authenticate returns ctx carrying the caller of token, or an
+	// Unauthenticated error if token is not valid.
+	Authenticate(ctx context.Context, token string) (context.Context, error)
+}
+
+// UnaryAuth rejects calls without a valid bearer token with Unauthenticated,
+// except for calls of publicMethods. Entries ending with a slash, such as
+// "/grpc.health.v1.Health/", make all methods of a service public.
+func UnaryAuth(authenticator Authenticator, publicMethods []string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if public(info.FullMethod, publicMethods) {
+			return handler(ctx, req)
+		}
+		ctx, err := authenticate(ctx, authenticator)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamAuth is the stream counterpart of UnaryAuth.
+func StreamAuth(authenticator Authenticator, publicMethods []string) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if public(info.FullMethod, publicMethods) {
+			return handler(srv, stream)
+		}
+		ctx, err := authenticate(stream.Context(), authenticator)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+func public(method string, publicMethods []string) bool {
+	for _, public := range publicMethods {
+		if method == public || (strings.HasSuffix(public, "/") && strings.HasPrefix(method, public)) {
+			return true
+		}
+	}
+	return false
+}
+
+func authenticate(ctx context.Context, authenticator Authenticator) (context.Context, error) {
+	values := metadata.ValueFromIncomingContext(ctx, AuthorizationMetadataKey)
+	if len(values) == 0 {
+		return nil, apierror.New(codes.Unauthenticated, apierror.ReasonMissingCredentials, nil, "missing bearer token").Err()
+	}
+
+	scheme, token, ok := strings.Cut(values[0], " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+		return nil, apierror.New(codes.Unauthenticated, apierror.ReasonMissingCredentials, nil, "authorization is not a bearer token").Err()
+	}
+	return authenticator.Authenticate(ctx, strings.TrimSpace(token))
+}
@@ -0,0 +1,435 @@
+package interceptors_test
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	tasksv1 "github.com/10Narratives/ready-to-do/contracts/gen/go/proto/tasks/v1"
+	"github.com/10Narratives/ready-to-do/server/internal/transport/grpc/interceptors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+var unaryInfo = &grpc.UnaryServerInfo{FullMethod: tasksv1.ProjectService_GetProject_FullMethodName}
+
+// serverStream is a server stream that receives a single message.
+type serverStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	msg    proto.Message
+	header metadata.MD
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *serverStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *serverStream) RecvMsg(m any) error {
+	proto.Merge(m.(proto.Message), s.msg)
+	return nil
+}
+
+func violationFields(err error) []string {
+	var fields []string
+	for _, detail := range status.Convert(err).Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.GetFieldViolations() {
+				fields = append(fields, violation.GetField())
+			}
+		}
+	}
+	return fields
+}
+
+func TestChainUnary(t *testing.T) {
+	t.Parallel()
+
+	var calls []string
+	record := func(name string) grpc.UnaryServerInterceptor {
+		return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			calls = append(calls, name+":"+info.FullMethod)
+			return handler(ctx, req)
+		}
+	}
+
+	resp, err := interceptors.ChainUnary(record("first"), record("second"))(context.Background(), "req", unaryInfo,
+		func(_ context.Context, req any) (any, error) {
+			calls = append(calls, "handler")
+			return req, nil
+		})
+	require.NoError(t, err)
+	assert.Equal(t, "req", resp)
+	assert.Equal(t, []string{
+		"first:" + tasksv1.ProjectService_GetProject_FullMethodName,
+		"second:" + tasksv1.ProjectService_GetProject_FullMethodName,
+		"handler",
+	}, calls)
+}
+
+func TestUnaryRequestID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		incoming []string
+		wantKept bool
+	}{
+		{
+			name:     "client request id is kept",
+			incoming: []string{"x-request-id", "req-42"},
+			wantKept: true,
+		},
+		{
+			name: "missing request id is generated",
+		},
+		{
+			name:     "unprintable request id is replaced",
+			incoming: []string{"x-request-id", "req\n42"},
+		},
+		{
+			name:     "too long request id is replaced",
+			incoming: []string{"x-request-id", strings.Repeat("a", 129)},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			if tt.incoming != nil {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(tt.incoming...))
+			}
+
+			var got string
+			_, err := interceptors.UnaryRequestID()(ctx, nil, unaryInfo, func(ctx context.Context, _ any) (any, error) {
+				got = interceptors.RequestID(ctx)
+				return nil, nil
+			})
+			require.NoError(t, err)
+			if tt.wantKept {
+				assert.Equal(t, tt.incoming[1], got)
+			} else {
+				assert.Regexp(t, `^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`, got)
+			}
+		})
+	}
+}
+
+func TestStreamRequestID(t *testing.T) {
+	t.Parallel()
+
+	stream := &serverStream{
+		ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-request-id", "req-42")),
+	}
+	err := interceptors.StreamRequestID()(nil, stream, &grpc.StreamServerInfo{}, func(_ any, stream grpc.ServerStream) error {
+		assert.Equal(t, "req-42", interceptors.RequestID(stream.Context()))
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"req-42"}, stream.header.Get("x-request-id"))
+}
+
+func TestUnaryRecovery(t *testing.T) {
+	t.Parallel()
+
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&logs, nil))
+
+	resp, err := interceptors.UnaryRecovery(logger)(context.Background(), nil, unaryInfo, func(context.Context, any) (any, error) {
+		panic("boom")
+	})
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.Contains(t, logs.String(), `"panic":"boom"`)
+	assert.Contains(t, logs.String(), tasksv1.ProjectService_GetProject_FullMethodName)
+}
+
+func TestUnaryAccessLog(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		err       error
+		wantLevel string
+		wantCode  string
+	}{
+		{
+			name:      "successful call",
+			wantLevel: `"level":"INFO"`,
+			wantCode:  `"code":"OK"`,
+		},
+		{
+			name:      "client error",
+			err:       status.Error(codes.NotFound, "project not found"),
+			wantLevel: `"level":"INFO"`,
+			wantCode:  `"code":"NotFound"`,
+		},
+		{
+			name:      "server error",
+			err:       status.Error(codes.Internal, "boom"),
+			wantLevel: `"level":"ERROR"`,
+			wantCode:  `"code":"Internal"`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var logs bytes.Buffer
+			logger := slog.New(slog.NewJSONHandler(&logs, nil))
+
+			_, err := interceptors.UnaryAccessLog(logger)(context.Background(), nil, unaryInfo, func(context.Context, any) (any, error) {
+				return nil, tt.err
+			})
+			assert.Equal(t, tt.err, err)
+			assert.Contains(t, logs.String(), tt.wantLevel)
+			assert.Contains(t, logs.String(), tt.wantCode)
+			assert.Contains(t, logs.String(), tasksv1.ProjectService_GetProject_FullMethodName)
+		})
+	}
+}
+
+func TestUnaryDeadline(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		timeout       time.Duration
+		clientTimeout time.Duration
+		wantDeadline  bool
+		wantRemains   time.Duration
+	}{
+		{
+			name:         "default deadline is applied",
+			timeout:      time.Second,
+			wantDeadline: true,
+			wantRemains:  time.Second,
+		},
+		{
+			name:          "client deadline is kept",
+			timeout:       time.Second,
+			clientTimeout: time.Minute,
+			wantDeadline:  true,
+			wantRemains:   time.Minute,
+		},
+		{
+			name: "zero timeout applies no deadline",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			if tt.clientTimeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.clientTimeout)
+				defer cancel()
+			}
+
+			_, err := interceptors.UnaryDeadline(tt.timeout)(ctx, nil, unaryInfo, func(ctx context.Context, _ any) (any, error) {
+				deadline, ok := ctx.Deadline()
+				require.Equal(t, tt.wantDeadline, ok)
+				if ok {
+					assert.WithinDuration(t, time.Now().Add(tt.wantRemains), deadline, time.Second)
+				}
+				return nil, nil
+			})
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestUnaryValidate(t *testing.T) {
+	t.Parallel()
+
+	const projectID = "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"
+
+	tests := []struct {
+		name           string
+		req            any
+		wantCode       codes.Code
+		wantViolations []string
+	}{
+		{
+			name:     "valid request",
+			req:      &tasksv1.GetProjectRequest{Name: "projects/" + projectID},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "message without rules",
+			req:      "not a message",
+			wantCode: codes.OK,
+		},
+		{
+			name:           "negative page size",
+			req:            &tasksv1.ListProjectsRequest{PageSize: -1},
+			wantCode:       codes.InvalidArgument,
+			wantViolations: []string{"page_size"},
+		},
+		{
+			name: "nested violations",
+			req: &tasksv1.BatchCreateProjectsRequest{Requests: []*tasksv1.CreateProjectRequest{
+				{ProjectId: projectID, Project: &tasksv1.Project{State: tasksv1.Project_ACTIVE}},
+				{ProjectId: "second", Project: &tasksv1.Project{State: tasksv1.Project_ACTIVE, ColorTag: "ultraviolet"}},
+			}},
+			wantCode:       codes.InvalidArgument,
+			wantViolations: []string{"requests[1].project_id", "requests[1].project.color_tag"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			called := false
+			_, err := interceptors.UnaryValidate()(context.Background(), tt.req, unaryInfo, func(context.Context, any) (any, error) {
+				called = true
+				return nil, nil
+			})
+			require.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.wantCode == codes.OK, called)
+			assert.Equal(t, tt.wantViolations, violationFields(err))
+		})
+	}
+}
+
+func TestStreamValidate(t *testing.T) {
+	t.Parallel()
+
+	stream := &serverStream{
+		ctx: context.Background(),
+		msg: &tasksv1.WatchProjectsRequest{ChangeToken: strings.Repeat("a", 257)},
+	}
+	err := interceptors.StreamValidate()(nil, stream, &grpc.StreamServerInfo{}, func(_ any, stream grpc.ServerStream) error {
+		return stream.RecvMsg(new(tasksv1.WatchProjectsRequest))
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, []string{"change_token"}, violationFields(err))
+}
+
+// tokenAuthenticator accepts the token "valid" only.
+type tokenAuthenticator struct{}
+
+type userKey struct{}
+
+func (tokenAuthenticator) Authenticate(ctx context.Context, token string) (context.Context, error) {
+	if token != "valid" {
+		return nil, status.Error(codes.Unauthenticated, "invalid bearer token")
+	}
+	return context.WithValue(ctx, userKey{}, "alice"), nil
+}
+
+func TestUnaryAuth(t *testing.T) {
+	t.Parallel()
+
+	publicMethods := []string{"/grpc.health.v1.Health/", tasksv1.ProjectService_ListProjects_FullMethodName}
+
+	tests := []struct {
+		name          string
+		method        string
+		authorization []string
+		wantCode      codes.Code
+		wantUser      any
+	}{
+		{
+			name:          "valid token",
+			method:        tasksv1.ProjectService_GetProject_FullMethodName,
+			authorization: []string{"Bearer valid"},
+			wantCode:      codes.OK,
+			wantUser:      "alice",
+		},
+		{
+			name:          "scheme is case insensitive",
+			method:        tasksv1.ProjectService_GetProject_FullMethodName,
+			authorization: []string{"bearer valid"},
+			wantCode:      codes.OK,
+			wantUser:      "alice",
+		},
+		{
+			name:     "missing token",
+			method:   tasksv1.ProjectService_GetProject_FullMethodName,
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:          "basic credentials",
+			method:        tasksv1.ProjectService_GetProject_FullMethodName,
+			authorization: []string{"Basic YWxpY2U6c2VjcmV0"},
+			wantCode:      codes.Unauthenticated,
+		},
+		{
+			name:          "invalid token",
+			method:        tasksv1.ProjectService_GetProject_FullMethodName,
+			authorization: []string{"Bearer forged"},
+			wantCode:      codes.Unauthenticated,
+		},
+		{
+			name:     "public service",
+			method:   "/grpc.health.v1.Health/Check",
+			wantCode: codes.OK,
+		},
+		{
+			name:     "public method",
+			method:   tasksv1.ProjectService_ListProjects_FullMethodName,
+			wantCode: codes.OK,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			for _, value := range tt.authorization {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", value))
+			}
+
+			var gotUser any
+			_, err := interceptors.UnaryAuth(tokenAuthenticator{}, publicMethods)(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method},
+				func(ctx context.Context, _ any) (any, error) {
+					gotUser = ctx.Value(userKey{})
+					return nil, nil
+				})
+			require.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.wantUser, gotUser)
+		})
+	}
+}
+
+func TestStreamAuth(t *testing.T) {
+	t.Parallel()
+
+	stream := &serverStream{
+		ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer valid")),
+	}
+	info := &grpc.StreamServerInfo{FullMethod: tasksv1.ProjectService_WatchProjects_FullMethodName}
+	err := interceptors.StreamAuth(tokenAuthenticator{}, nil)(nil, stream, info, func(_ any, stream grpc.ServerStream) error {
+		assert.Equal(t, "alice", stream.Context().Value(userKey{}))
+		return nil
+	})
+	require.NoError(t, err)
+
+	stream = &serverStream{ctx: context.Background()}
+	err = interceptors.StreamAuth(tokenAuthenticator{}, nil)(nil, stream, info, func(any, grpc.ServerStream) error {
+		return nil
+	})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
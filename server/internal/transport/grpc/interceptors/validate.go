@@ -0,0 +1,54 @@
+package interceptors
+
+import (
+	"context"
+
+	"github.com/10Narratives/ready-to-do/server/internal/apierror"
+	"google.golang.org/grpc"
+)
+
+// validator is implemented by messages with protoc-gen-validate rules.
+type validator interface {
+	ValidateAll() error
+}
+
+// UnaryValidate rejects requests that break their validation rules with
+// InvalidArgument before they reach the handler.
+func UnaryValidate() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := validate(req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamValidate is the stream counterpart of UnaryValidate. Every received
+// message is validated.
+func StreamValidate() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatingStream{ServerStream: stream})
+	}
+}
+
+type validatingStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return validate(m)
+}
+
+func validate(req any) error {
+	v, ok := req.(validator)
+	if !ok {
+		return nil
+	}
+	if err := v.ValidateAll(); err != nil {
+		return apierror.InvalidRequest(err).Err()
+	}
+	return nil
+}
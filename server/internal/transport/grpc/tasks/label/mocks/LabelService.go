@@ -0,0 +1,188 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	labelmodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/label"
+	labelapi "github.com/10Narratives/ready-to-do/server/internal/transport/grpc/tasks/label"
+
+	mock "github.com/stretchr/testify/mock"
+
+	status "google.golang.org/grpc/status"
+)
+
+// LabelService is an autogenerated mock type for the LabelService type
+type LabelService struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, args
+func (_m *LabelService) Create(ctx context.Context, args labelapi.CreateLabelArgs) (*labelmodels.Label, *status.Status) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *labelmodels.Label
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, labelapi.CreateLabelArgs) (*labelmodels.Label, *status.Status)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, labelapi.CreateLabelArgs) *labelmodels.Label); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*labelmodels.Label)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, labelapi.CreateLabelArgs) *status.Status); ok {
+		r1 = rf(ctx, args)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, args
+func (_m *LabelService) Delete(ctx context.Context, args labelapi.DeleteLabelArgs) *status.Status {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, labelapi.DeleteLabelArgs) *status.Status); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*status.Status)
+		}
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, args
+func (_m *LabelService) Get(ctx context.Context, args labelapi.GetLabelArgs) (*labelmodels.Label, *status.Status) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *labelmodels.Label
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, labelapi.GetLabelArgs) (*labelmodels.Label, *status.Status)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, labelapi.GetLabelArgs) *labelmodels.Label); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*labelmodels.Label)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, labelapi.GetLabelArgs) *status.Status); ok {
+		r1 = rf(ctx, args)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, args
+func (_m *LabelService) List(ctx context.Context, args labelapi.ListLabelsArgs) ([]*labelmodels.Label, string, *status.Status) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*labelmodels.Label
+	var r1 string
+	var r2 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, labelapi.ListLabelsArgs) ([]*labelmodels.Label, string, *status.Status)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, labelapi.ListLabelsArgs) []*labelmodels.Label); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*labelmodels.Label)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, labelapi.ListLabelsArgs) string); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, labelapi.ListLabelsArgs) *status.Status); ok {
+		r2 = rf(ctx, args)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(*status.Status)
+		}
+	}
+
+	return r0, r1, r2
+}
+
+// Update provides a mock function with given fields: ctx, args
+func (_m *LabelService) Update(ctx context.Context, args labelapi.UpdateLabelArgs) (*labelmodels.Label, *status.Status) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *labelmodels.Label
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, labelapi.UpdateLabelArgs) (*labelmodels.Label, *status.Status)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, labelapi.UpdateLabelArgs) *labelmodels.Label); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*labelmodels.Label)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, labelapi.UpdateLabelArgs) *status.Status); ok {
+		r1 = rf(ctx, args)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// NewLabelService creates a new instance of LabelService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLabelService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LabelService {
+	mock := &LabelService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
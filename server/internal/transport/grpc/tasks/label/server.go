@@ -0,0 +1,238 @@
+package labelapi
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	tasksv1 "github.com/10Narratives/ready-to-do/contracts/gen/go/proto/tasks/v1"
+	"github.com/10Narratives/ready-to-do/server/internal/apierror"
+	labelmodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/label"
+	"github.com/10Narratives/ready-to-do/server/internal/resourcename"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+//go:generate mockery --name LabelService --output ./mocks/
+type LabelService interface {
+	// Create creates a new label.
+	// Returns AlreadyExists if a label with the same ID exists.
+	Create(ctx context.Context, args CreateLabelArgs) (*labelmodels.Label, *status.Status)
+
+	// Get returns the label identified by the provided arguments.
+	// Returns NotFound if the label does not exist.
+	Get(ctx context.Context, args GetLabelArgs) (*labelmodels.Label, *status.Status)
+
+	// List returns one page of labels and the token of the next page.
+	// The token is empty when there are no more labels.
+	List(ctx context.Context, args ListLabelsArgs) ([]*labelmodels.Label, string, *status.Status)
+
+	// Update changes the masked fields of a label and returns the result.
+	Update(ctx context.Context, args UpdateLabelArgs) (*labelmodels.Label, *status.Status)
+
+	// Delete permanently removes a label and all of its assignments.
+	Delete(ctx context.Context, args DeleteLabelArgs) *status.Status
+}
+
+type CreateLabelArgs struct {
+	LabelID string
+	Label   *labelmodels.Label
+}
+
+func newCreateLabelArgs(req *tasksv1.CreateLabelRequest) (CreateLabelArgs, error) {
+	if req.GetLabel() == nil {
+		return CreateLabelArgs{}, fmt.Errorf("cannot convert request to create label args: %w", apierror.Fieldf("label", "label is required"))
+	}
+	return CreateLabelArgs{
+		LabelID: req.GetLabelId(),
+		Label:   labelmodels.LabelFromGRPC(req.GetLabel()),
+	}, nil
+}
+
+type GetLabelArgs struct {
+	LabelID string
+}
+
+func newGetLabelArgs(req *tasksv1.GetLabelRequest) (GetLabelArgs, error) {
+	labelID, err := resourcename.ParseLabelName(req.GetName())
+	if err != nil {
+		return GetLabelArgs{}, fmt.Errorf("cannot convert request to get label args: %w", apierror.Field("name", err))
+	}
+	return GetLabelArgs{
+		LabelID: labelID,
+	}, nil
+}
+
+type ListLabelsArgs struct {
+	PageSize  int32
+	PageToken string
+}
+
+func newListLabelsArgs(req *tasksv1.ListLabelsRequest) (ListLabelsArgs, error) {
+	return ListLabelsArgs{
+		PageSize:  req.GetPageSize(),
+		PageToken: req.GetPageToken(),
+	}, nil
+}
+
+type UpdateLabelArgs struct {
+	LabelID string
+	// Label holds the new values of the fields listed in Fields.
+	Label *labelmodels.Label
+	// Fields lists the label fields to update, with "*" already expanded.
+	Fields []string
+}
+
+func newUpdateLabelArgs(req *tasksv1.UpdateLabelRequest) (UpdateLabelArgs, error) {
+	labelID, err := resourcename.ParseLabelName(req.GetLabel().GetName())
+	if err != nil {
+		return UpdateLabelArgs{}, fmt.Errorf("cannot convert request to update label args: %w", apierror.Field("label.name", err))
+	}
+
+	fields, err := parseUpdateMask(req.GetUpdateMask().GetPaths())
+	if err != nil {
+		return UpdateLabelArgs{}, fmt.Errorf("cannot convert request to update label args: %w", apierror.Field("update_mask", err))
+	}
+
+	return UpdateLabelArgs{
+		LabelID: labelID,
+		Label:   labelmodels.LabelFromGRPC(req.GetLabel()),
+		Fields:  fields,
+	}, nil
+}
+
+// parseUpdateMask checks update mask paths against the updatable label
+// fields and expands "*" to all of them.
+func parseUpdateMask(paths []string) ([]string, error) {
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("update mask must not be empty")
+	}
+
+	fields := make([]string, 0, len(paths))
+	for _, path := range paths {
+		switch path {
+		case "*":
+			if len(paths) > 1 {
+				return nil, fmt.Errorf("update mask path \"*\" cannot be combined with other paths")
+			}
+			return slices.Clone(labelmodels.UpdatableLabelFields), nil
+		case "name", "created_at", "updated_at":
+			return nil, fmt.Errorf("update mask path %q is output only", path)
+		}
+		if !slices.Contains(labelmodels.UpdatableLabelFields, path) {
+			return nil, fmt.Errorf("update mask path %q is unknown", path)
+		}
+		if !slices.Contains(fields, path) {
+			fields = append(fields, path)
+		}
+	}
+
+	return fields, nil
+}
+
+type DeleteLabelArgs struct {
+	LabelID string
+}
+
+func newDeleteLabelArgs(req *tasksv1.DeleteLabelRequest) (DeleteLabelArgs, error) {
+	labelID, err := resourcename.ParseLabelName(req.GetName())
+	if err != nil {
+		return DeleteLabelArgs{}, fmt.Errorf("cannot convert request to delete label args: %w", apierror.Field("name", err))
+	}
+	return DeleteLabelArgs{
+		LabelID: labelID,
+	}, nil
+}
+
+type ServerAPI struct {
+	tasksv1.UnimplementedLabelServiceServer
+	service LabelService
+}
+
+func New(service LabelService) *ServerAPI {
+	return &ServerAPI{
+		service: service,
+	}
+}
+
+func Register(server *grpc.Server, service LabelService) {
+	tasksv1.RegisterLabelServiceServer(server, &ServerAPI{service: service})
+}
+
+func (s *ServerAPI) CreateLabel(ctx context.Context, req *tasksv1.CreateLabelRequest) (*tasksv1.Label, error) {
+	args, err := newCreateLabelArgs(req)
+	if err != nil {
+		return nil, apierror.InvalidRequest(err).Err()
+	}
+
+	label, stat := s.service.Create(ctx, args)
+	if stat != nil {
+		return nil, stat.Err()
+	}
+
+	return labelmodels.LabelToGRPC(label), nil
+}
+
+func (s *ServerAPI) GetLabel(ctx context.Context, req *tasksv1.GetLabelRequest) (*tasksv1.Label, error) {
+	args, err := newGetLabelArgs(req)
+	if err != nil {
+		return nil, apierror.InvalidRequest(err).Err()
+	}
+
+	label, stat := s.service.Get(ctx, args)
+	if stat != nil {
+		return nil, stat.Err()
+	}
+
+	return labelmodels.LabelToGRPC(label), nil
+}
+
+func (s *ServerAPI) ListLabels(ctx context.Context, req *tasksv1.ListLabelsRequest) (*tasksv1.ListLabelsResponse, error) {
+	args, err := newListLabelsArgs(req)
+	if err != nil {
+		return nil, apierror.InvalidRequest(err).Err()
+	}
+
+	labels, nextPageToken, stat := s.service.List(ctx, args)
+	if stat != nil {
+		return nil, stat.Err()
+	}
+
+	resp := &tasksv1.ListLabelsResponse{
+		Labels:        make([]*tasksv1.Label, 0, len(labels)),
+		NextPageToken: nextPageToken,
+	}
+	for _, label := range labels {
+		resp.Labels = append(resp.Labels, labelmodels.LabelToGRPC(label))
+	}
+
+	return resp, nil
+}
+
+func (s *ServerAPI) UpdateLabel(ctx context.Context, req *tasksv1.UpdateLabelRequest) (*tasksv1.Label, error) {
+	args, err := newUpdateLabelArgs(req)
+	if err != nil {
+		return nil, apierror.InvalidRequest(err).Err()
+	}
+
+	label, stat := s.service.Update(ctx, args)
+	if stat != nil {
+		return nil, stat.Err()
+	}
+
+	return labelmodels.LabelToGRPC(label), nil
+}
+
+func (s *ServerAPI) DeleteLabel(ctx context.Context, req *tasksv1.DeleteLabelRequest) (*emptypb.Empty, error) {
+	args, err := newDeleteLabelArgs(req)
+	if err != nil {
+		return nil, apierror.InvalidRequest(err).Err()
+	}
+
+	if stat := s.service.Delete(ctx, args); stat != nil {
+		return nil, stat.Err()
+	}
+
+	return &emptypb.Empty{}, nil
+}
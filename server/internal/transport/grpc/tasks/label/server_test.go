@@ -0,0 +1,179 @@
+package labelapi_test
+
+import (
+	"context"
+	"testing"
+
+	tasksv1 "github.com/10Narratives/ready-to-do/contracts/gen/go/proto/tasks/v1"
+	labelmodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/label"
+	labelapi "github.com/10Narratives/ready-to-do/server/internal/transport/grpc/tasks/label"
+	"github.com/10Narratives/ready-to-do/server/internal/transport/grpc/tasks/label/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestServerAPI_CreateLabel(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                  string
+		setupLabelServiceMock func(m *mocks.LabelService)
+		req                   *tasksv1.CreateLabelRequest
+		wantCode              codes.Code
+	}{
+		{
+			name: "successful execution",
+			setupLabelServiceMock: func(m *mocks.LabelService) {
+				m.On("Create", mock.Anything, labelapi.CreateLabelArgs{
+					LabelID: "urgent",
+					Label:   &labelmodels.Label{DisplayName: "Urgent"},
+				}).Return(&labelmodels.Label{Name: "labels/urgent", DisplayName: "Urgent"}, nil)
+			},
+			req: &tasksv1.CreateLabelRequest{
+				LabelId: "urgent",
+				Label:   &tasksv1.Label{DisplayName: "Urgent"},
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "label already exists",
+			setupLabelServiceMock: func(m *mocks.LabelService) {
+				m.On("Create", mock.Anything, mock.Anything).Return(nil, status.New(codes.AlreadyExists, "label already exists"))
+			},
+			req: &tasksv1.CreateLabelRequest{
+				LabelId: "urgent",
+				Label:   &tasksv1.Label{},
+			},
+			wantCode: codes.AlreadyExists,
+		},
+		{
+			name:                  "missing label",
+			setupLabelServiceMock: func(m *mocks.LabelService) {},
+			req:                   &tasksv1.CreateLabelRequest{LabelId: "urgent"},
+			wantCode:              codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			labelServiceMock := mocks.NewLabelService(t)
+			tt.setupLabelServiceMock(labelServiceMock)
+
+			resp, err := labelapi.New(labelServiceMock).CreateLabel(context.Background(), tt.req)
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, "labels/urgent", resp.GetName())
+			}
+		})
+	}
+}
+
+func TestServerAPI_UpdateLabel(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                  string
+		setupLabelServiceMock func(m *mocks.LabelService)
+		req                   *tasksv1.UpdateLabelRequest
+		wantCode              codes.Code
+	}{
+		{
+			name: "wildcard mask",
+			setupLabelServiceMock: func(m *mocks.LabelService) {
+				m.On("Update", mock.Anything, mock.MatchedBy(func(args labelapi.UpdateLabelArgs) bool {
+					return args.LabelID == "urgent" && len(args.Fields) == len(labelmodels.UpdatableLabelFields)
+				})).Return(&labelmodels.Label{Name: "labels/urgent", DisplayName: "Critical"}, nil)
+			},
+			req: &tasksv1.UpdateLabelRequest{
+				Label:      &tasksv1.Label{Name: "labels/urgent", DisplayName: "Critical"},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"*"}},
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:                  "output only path",
+			setupLabelServiceMock: func(m *mocks.LabelService) {},
+			req: &tasksv1.UpdateLabelRequest{
+				Label:      &tasksv1.Label{Name: "labels/urgent"},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"created_at"}},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:                  "invalid name",
+			setupLabelServiceMock: func(m *mocks.LabelService) {},
+			req: &tasksv1.UpdateLabelRequest{
+				Label:      &tasksv1.Label{Name: "projects/urgent"},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"color"}},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			labelServiceMock := mocks.NewLabelService(t)
+			tt.setupLabelServiceMock(labelServiceMock)
+
+			resp, err := labelapi.New(labelServiceMock).UpdateLabel(context.Background(), tt.req)
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, "Critical", resp.GetDisplayName())
+			}
+		})
+	}
+}
+
+func TestServerAPI_DeleteLabel(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                  string
+		setupLabelServiceMock func(m *mocks.LabelService)
+		req                   *tasksv1.DeleteLabelRequest
+		wantCode              codes.Code
+	}{
+		{
+			name: "successful execution",
+			setupLabelServiceMock: func(m *mocks.LabelService) {
+				m.On("Delete", mock.Anything, labelapi.DeleteLabelArgs{LabelID: "urgent"}).Return(nil)
+			},
+			req:      &tasksv1.DeleteLabelRequest{Name: "labels/urgent"},
+			wantCode: codes.OK,
+		},
+		{
+			name: "missing label",
+			setupLabelServiceMock: func(m *mocks.LabelService) {
+				m.On("Delete", mock.Anything, mock.Anything).Return(status.New(codes.NotFound, "label not found"))
+			},
+			req:      &tasksv1.DeleteLabelRequest{Name: "labels/urgent"},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:                  "invalid name",
+			setupLabelServiceMock: func(m *mocks.LabelService) {},
+			req:                   &tasksv1.DeleteLabelRequest{Name: "labels/"},
+			wantCode:              codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			labelServiceMock := mocks.NewLabelService(t)
+			tt.setupLabelServiceMock(labelServiceMock)
+
+			_, err := labelapi.New(labelServiceMock).DeleteLabel(context.Background(), tt.req)
+			require.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
@@ -5,8 +5,10 @@ package mocks
 import (
 	context "context"
 
+	projectmodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/project"
 	projectapi "github.com/10Narratives/ready-to-do/server/internal/transport/grpc/tasks/project"
 	mock "github.com/stretchr/testify/mock"
+
 	status "google.golang.org/grpc/status"
 )
 
@@ -15,6 +17,134 @@ type ProjectService struct {
 	mock.Mock
 }
 
+// Archive provides a mock function with given fields: ctx, args
+func (_m *ProjectService) Archive(ctx context.Context, args projectapi.ArchiveProjectArgs) (*projectmodels.Project, *status.Status) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Archive")
+	}
+
+	var r0 *projectmodels.Project
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, projectapi.ArchiveProjectArgs) (*projectmodels.Project, *status.Status)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, projectapi.ArchiveProjectArgs) *projectmodels.Project); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*projectmodels.Project)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, projectapi.ArchiveProjectArgs) *status.Status); ok {
+		r1 = rf(ctx, args)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// BatchCreate provides a mock function with given fields: ctx, args
+func (_m *ProjectService) BatchCreate(ctx context.Context, args projectapi.BatchCreateProjectsArgs) ([]*projectmodels.Project, *status.Status) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchCreate")
+	}
+
+	var r0 []*projectmodels.Project
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, projectapi.BatchCreateProjectsArgs) ([]*projectmodels.Project, *status.Status)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, projectapi.BatchCreateProjectsArgs) []*projectmodels.Project); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*projectmodels.Project)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, projectapi.BatchCreateProjectsArgs) *status.Status); ok {
+		r1 = rf(ctx, args)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// BatchDelete provides a mock function with given fields: ctx, args
+func (_m *ProjectService) BatchDelete(ctx context.Context, args projectapi.BatchDeleteProjectsArgs) ([]*projectmodels.Project, *status.Status) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchDelete")
+	}
+
+	var r0 []*projectmodels.Project
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, projectapi.BatchDeleteProjectsArgs) ([]*projectmodels.Project, *status.Status)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, projectapi.BatchDeleteProjectsArgs) []*projectmodels.Project); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*projectmodels.Project)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, projectapi.BatchDeleteProjectsArgs) *status.Status); ok {
+		r1 = rf(ctx, args)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// BatchGet provides a mock function with given fields: ctx, args
+func (_m *ProjectService) BatchGet(ctx context.Context, args projectapi.BatchGetProjectsArgs) ([]*projectmodels.Project, *status.Status) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchGet")
+	}
+
+	var r0 []*projectmodels.Project
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, projectapi.BatchGetProjectsArgs) ([]*projectmodels.Project, *status.Status)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, projectapi.BatchGetProjectsArgs) []*projectmodels.Project); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*projectmodels.Project)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, projectapi.BatchGetProjectsArgs) *status.Status); ok {
+		r1 = rf(ctx, args)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
 // Create provides a mock function with given fields: ctx, args
 func (_m *ProjectService) Create(ctx context.Context, args projectapi.CreateProjectArgs) *status.Status {
 	ret := _m.Called(ctx, args)
@@ -35,6 +165,257 @@ func (_m *ProjectService) Create(ctx context.Context, args projectapi.CreateProj
 	return r0
 }
 
+// Delete provides a mock function with given fields: ctx, args
+func (_m *ProjectService) Delete(ctx context.Context, args projectapi.DeleteProjectArgs) (*projectmodels.Project, *status.Status) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 *projectmodels.Project
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, projectapi.DeleteProjectArgs) (*projectmodels.Project, *status.Status)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, projectapi.DeleteProjectArgs) *projectmodels.Project); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*projectmodels.Project)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, projectapi.DeleteProjectArgs) *status.Status); ok {
+		r1 = rf(ctx, args)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// Get provides a mock function with given fields: ctx, args
+func (_m *ProjectService) Get(ctx context.Context, args projectapi.GetProjectArgs) (*projectmodels.Project, *status.Status) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *projectmodels.Project
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, projectapi.GetProjectArgs) (*projectmodels.Project, *status.Status)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, projectapi.GetProjectArgs) *projectmodels.Project); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*projectmodels.Project)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, projectapi.GetProjectArgs) *status.Status); ok {
+		r1 = rf(ctx, args)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, args
+func (_m *ProjectService) List(ctx context.Context, args projectapi.ListProjectsArgs) ([]*projectmodels.Project, string, *status.Status) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*projectmodels.Project
+	var r1 string
+	var r2 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, projectapi.ListProjectsArgs) ([]*projectmodels.Project, string, *status.Status)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, projectapi.ListProjectsArgs) []*projectmodels.Project); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*projectmodels.Project)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, projectapi.ListProjectsArgs) string); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, projectapi.ListProjectsArgs) *status.Status); ok {
+		r2 = rf(ctx, args)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(*status.Status)
+		}
+	}
+
+	return r0, r1, r2
+}
+
+// Move provides a mock function with given fields: ctx, args
+func (_m *ProjectService) Move(ctx context.Context, args projectapi.MoveProjectArgs) (*projectmodels.Project, *status.Status) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Move")
+	}
+
+	var r0 *projectmodels.Project
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, projectapi.MoveProjectArgs) (*projectmodels.Project, *status.Status)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, projectapi.MoveProjectArgs) *projectmodels.Project); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*projectmodels.Project)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, projectapi.MoveProjectArgs) *status.Status); ok {
+		r1 = rf(ctx, args)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// Unarchive provides a mock function with given fields: ctx, args
+func (_m *ProjectService) Unarchive(ctx context.Context, args projectapi.UnarchiveProjectArgs) (*projectmodels.Project, *status.Status) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unarchive")
+	}
+
+	var r0 *projectmodels.Project
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, projectapi.UnarchiveProjectArgs) (*projectmodels.Project, *status.Status)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, projectapi.UnarchiveProjectArgs) *projectmodels.Project); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*projectmodels.Project)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, projectapi.UnarchiveProjectArgs) *status.Status); ok {
+		r1 = rf(ctx, args)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// Undelete provides a mock function with given fields: ctx, args
+func (_m *ProjectService) Undelete(ctx context.Context, args projectapi.UndeleteProjectArgs) (*projectmodels.Project, *status.Status) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Undelete")
+	}
+
+	var r0 *projectmodels.Project
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, projectapi.UndeleteProjectArgs) (*projectmodels.Project, *status.Status)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, projectapi.UndeleteProjectArgs) *projectmodels.Project); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*projectmodels.Project)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, projectapi.UndeleteProjectArgs) *status.Status); ok {
+		r1 = rf(ctx, args)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, args
+func (_m *ProjectService) Update(ctx context.Context, args projectapi.UpdateProjectArgs) (*projectmodels.Project, *status.Status) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *projectmodels.Project
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, projectapi.UpdateProjectArgs) (*projectmodels.Project, *status.Status)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, projectapi.UpdateProjectArgs) *projectmodels.Project); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*projectmodels.Project)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, projectapi.UpdateProjectArgs) *status.Status); ok {
+		r1 = rf(ctx, args)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// Watch provides a mock function with given fields: ctx, args, send
+func (_m *ProjectService) Watch(ctx context.Context, args projectapi.WatchProjectsArgs, send func(*projectmodels.ProjectChange) error) *status.Status {
+	ret := _m.Called(ctx, args, send)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Watch")
+	}
+
+	var r0 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, projectapi.WatchProjectsArgs, func(*projectmodels.ProjectChange) error) *status.Status); ok {
+		r0 = rf(ctx, args, send)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*status.Status)
+		}
+	}
+
+	return r0
+}
+
 // NewProjectService creates a new instance of ProjectService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewProjectService(t interface {
@@ -3,13 +3,17 @@ package projectapi
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	tasksv1 "github.com/10Narratives/ready-to-do/contracts/gen/go/proto/tasks/v1"
+	"github.com/10Narratives/ready-to-do/server/internal/apierror"
 	projectmodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/project"
+	"github.com/10Narratives/ready-to-do/server/internal/resourcename"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 //go:generate mockery --name ProjectService --output ./mocks/
@@ -17,24 +21,383 @@ type ProjectService interface {
 	// Create creates a new project using the provided arguments.
 	// Returns the created project model and a gRPC status indicating the result.
 	Create(ctx context.Context, args CreateProjectArgs) *status.Status
+
+	// Get returns the project identified by the provided arguments.
+	// Returns NotFound if the project does not exist or has been deleted.
+	Get(ctx context.Context, args GetProjectArgs) (*projectmodels.Project, *status.Status)
+
+	// List returns one page of projects and the token of the next page.
+	// The token is empty when there are no more projects.
+	List(ctx context.Context, args ListProjectsArgs) ([]*projectmodels.Project, string, *status.Status)
+
+	// Update changes the masked fields of a project and returns the result.
+	// Returns NotFound if the project does not exist or has been deleted and
+	// FailedPrecondition if the requested state transition is not allowed.
+	Update(ctx context.Context, args UpdateProjectArgs) (*projectmodels.Project, *status.Status)
+
+	// Delete soft-deletes a project and returns it in the DELETED state.
+	// Returns NotFound if the project does not exist or is already deleted.
+	Delete(ctx context.Context, args DeleteProjectArgs) (*projectmodels.Project, *status.Status)
+
+	// Undelete restores a soft-deleted project.
+	// Returns FailedPrecondition if the project is not deleted.
+	Undelete(ctx context.Context, args UndeleteProjectArgs) (*projectmodels.Project, *status.Status)
+
+	// Archive moves an ACTIVE project to the ARCHIVED state.
+	// Returns FailedPrecondition if the project is in any other state.
+	Archive(ctx context.Context, args ArchiveProjectArgs) (*projectmodels.Project, *status.Status)
+
+	// Unarchive moves an ARCHIVED project back to the ACTIVE state.
+	// Returns FailedPrecondition if the project is in any other state.
+	Unarchive(ctx context.Context, args UnarchiveProjectArgs) (*projectmodels.Project, *status.Status)
+
+	// Move places a project right after or right before another project in
+	// the manual order. Returns NotFound if either project does not exist or
+	// has been deleted.
+	Move(ctx context.Context, args MoveProjectArgs) (*projectmodels.Project, *status.Status)
+
+	// BatchCreate creates all requested projects or none of them and returns
+	// them in the order of the requests.
+	BatchCreate(ctx context.Context, args BatchCreateProjectsArgs) ([]*projectmodels.Project, *status.Status)
+
+	// BatchGet returns the requested projects in the order of the request.
+	// Returns NotFound if any of them does not exist or has been deleted.
+	BatchGet(ctx context.Context, args BatchGetProjectsArgs) ([]*projectmodels.Project, *status.Status)
+
+	// BatchDelete soft-deletes all requested projects or none of them.
+	// Returns NotFound if any of them does not exist or is already deleted.
+	BatchDelete(ctx context.Context, args BatchDeleteProjectsArgs) ([]*projectmodels.Project, *status.Status)
+
+	// Watch passes project changes to send in commit order, interleaved with
+	// heartbeats, until ctx is done or send fails. Returns InvalidArgument
+	// for malformed change tokens and FailedPrecondition for expired ones.
+	Watch(ctx context.Context, args WatchProjectsArgs, send func(*projectmodels.ProjectChange) error) *status.Status
 }
 
 type CreateProjectArgs struct {
 	ProjectID string
 	Project   *projectmodels.Project
+	// RequestID identifies retries of the same creation. It may be empty.
+	RequestID string
 }
 
 func newCreateProjectArgs(req *tasksv1.CreateProjectRequest) (CreateProjectArgs, error) {
+	if req.GetProject() == nil {
+		return CreateProjectArgs{}, fmt.Errorf("cannot convert request to create project args: %w", apierror.Fieldf("project", "project is required"))
+	}
 	model, err := projectmodels.ProjectFromGRPC(req.GetProject())
 	if err != nil {
-		return CreateProjectArgs{}, fmt.Errorf("cannot convert request to create project args: %v", err)
+		return CreateProjectArgs{}, fmt.Errorf("cannot convert request to create project args: %w", apierror.Field("project", err))
 	}
 	return CreateProjectArgs{
-		ProjectID: req.GetProjectId(),
+		ProjectID: strings.ToLower(req.GetProjectId()),
 		Project:   model,
+		RequestID: req.GetRequestId(),
+	}, nil
+}
+
+type GetProjectArgs struct {
+	ProjectID string
+}
+
+func newGetProjectArgs(req *tasksv1.GetProjectRequest) (GetProjectArgs, error) {
+	projectID, err := resourcename.ParseProjectName(req.GetName())
+	if err != nil {
+		return GetProjectArgs{}, fmt.Errorf("cannot convert request to get project args: %w", apierror.Field("name", err))
+	}
+	return GetProjectArgs{
+		ProjectID: projectID,
+	}, nil
+}
+
+type ListProjectsArgs struct {
+	PageSize     int32
+	PageToken    string
+	Filter       string
+	OrderBy      projectmodels.ProjectOrder
+	ShowArchived bool
+	ShowDeleted  bool
+}
+
+func newListProjectsArgs(req *tasksv1.ListProjectsRequest) (ListProjectsArgs, error) {
+	order, err := projectmodels.ParseProjectOrder(req.GetOrderBy())
+	if err != nil {
+		return ListProjectsArgs{}, fmt.Errorf("cannot convert request to list projects args: %w", apierror.Field("order_by", err))
+	}
+	return ListProjectsArgs{
+		PageSize:     req.GetPageSize(),
+		PageToken:    req.GetPageToken(),
+		Filter:       req.GetFilter(),
+		OrderBy:      order,
+		ShowArchived: req.GetShowArchived(),
+		ShowDeleted:  req.GetShowDeleted(),
+	}, nil
+}
+
+type UpdateProjectArgs struct {
+	ProjectID string
+	// Project holds the new values of the fields listed in Fields.
+	Project *projectmodels.Project
+	// Fields lists the project fields to update, with "*" already expanded.
+	Fields []string
+	// Etag must match the current etag of the project unless it is empty.
+	Etag string
+}
+
+func newUpdateProjectArgs(req *tasksv1.UpdateProjectRequest) (UpdateProjectArgs, error) {
+	src := req.GetProject()
+
+	projectID, err := resourcename.ParseProjectName(src.GetName())
+	if err != nil {
+		return UpdateProjectArgs{}, fmt.Errorf("cannot convert request to update project args: %w", apierror.Field("project.name", err))
+	}
+
+	fields, err := parseUpdateMask(req.GetUpdateMask().GetPaths())
+	if err != nil {
+		return UpdateProjectArgs{}, fmt.Errorf("cannot convert request to update project args: %w", apierror.Field("update_mask", err))
+	}
+
+	project := &projectmodels.Project{
+		Name:        resourcename.ProjectName(projectID),
+		DisplayName: src.GetDisplayName(),
+		Description: src.GetDescription(),
+		ColorTag:    src.GetColorTag(),
+		Labels:      src.GetLabels(),
+	}
+	if slices.Contains(fields, projectmodels.StateField) {
+		project.State, err = projectmodels.ProjectStateFromGRPC(src.GetState())
+		if err != nil {
+			return UpdateProjectArgs{}, fmt.Errorf("cannot convert request to update project args: %w", apierror.Field("project.state", err))
+		}
+	}
+
+	return UpdateProjectArgs{
+		ProjectID: projectID,
+		Project:   project,
+		Fields:    fields,
+		Etag:      src.GetEtag(),
+	}, nil
+}
+
+// parseUpdateMask checks update mask paths against the updatable project
+// fields and expands "*" to all of them.
+func parseUpdateMask(paths []string) ([]string, error) {
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("update mask must not be empty")
+	}
+
+	fields := make([]string, 0, len(paths))
+	for _, path := range paths {
+		switch path {
+		case "*":
+			if len(paths) > 1 {
+				return nil, fmt.Errorf("update mask path \"*\" cannot be combined with other paths")
+			}
+			return slices.Clone(projectmodels.UpdatableProjectFields), nil
+		case "name", "created_at", "updated_at", "etag":
+			return nil, fmt.Errorf("update mask path %q is output only", path)
+		case "position":
+			return nil, fmt.Errorf("update mask path %q cannot be updated, use MoveProject", path)
+		}
+		if !slices.Contains(projectmodels.UpdatableProjectFields, path) {
+			return nil, fmt.Errorf("update mask path %q is unknown", path)
+		}
+		if !slices.Contains(fields, path) {
+			fields = append(fields, path)
+		}
+	}
+
+	return fields, nil
+}
+
+type DeleteProjectArgs struct {
+	ProjectID string
+	// Etag must match the current etag of the project unless it is empty.
+	Etag string
+}
+
+func newDeleteProjectArgs(req *tasksv1.DeleteProjectRequest) (DeleteProjectArgs, error) {
+	projectID, err := resourcename.ParseProjectName(req.GetName())
+	if err != nil {
+		return DeleteProjectArgs{}, fmt.Errorf("cannot convert request to delete project args: %w", apierror.Field("name", err))
+	}
+	return DeleteProjectArgs{
+		ProjectID: projectID,
+		Etag:      req.GetEtag(),
+	}, nil
+}
+
+type UndeleteProjectArgs struct {
+	ProjectID string
+}
+
+func newUndeleteProjectArgs(req *tasksv1.UndeleteProjectRequest) (UndeleteProjectArgs, error) {
+	projectID, err := resourcename.ParseProjectName(req.GetName())
+	if err != nil {
+		return UndeleteProjectArgs{}, fmt.Errorf("cannot convert request to undelete project args: %w", apierror.Field("name", err))
+	}
+	return UndeleteProjectArgs{
+		ProjectID: projectID,
+	}, nil
+}
+
+type ArchiveProjectArgs struct {
+	ProjectID string
+}
+
+func newArchiveProjectArgs(req *tasksv1.ArchiveProjectRequest) (ArchiveProjectArgs, error) {
+	projectID, err := resourcename.ParseProjectName(req.GetName())
+	if err != nil {
+		return ArchiveProjectArgs{}, fmt.Errorf("cannot convert request to archive project args: %w", apierror.Field("name", err))
+	}
+	return ArchiveProjectArgs{
+		ProjectID: projectID,
+	}, nil
+}
+
+type UnarchiveProjectArgs struct {
+	ProjectID string
+}
+
+func newUnarchiveProjectArgs(req *tasksv1.UnarchiveProjectRequest) (UnarchiveProjectArgs, error) {
+	projectID, err := resourcename.ParseProjectName(req.GetName())
+	if err != nil {
+		return UnarchiveProjectArgs{}, fmt.Errorf("cannot convert request to unarchive project args: %w", apierror.Field("name", err))
+	}
+	return UnarchiveProjectArgs{
+		ProjectID: projectID,
 	}, nil
 }
 
+type MoveProjectArgs struct {
+	ProjectID string
+	// AnchorID is the project the moved project is placed next to.
+	AnchorID string
+	// After places the project after the anchor, otherwise before it.
+	After bool
+}
+
+func newMoveProjectArgs(req *tasksv1.MoveProjectRequest) (MoveProjectArgs, error) {
+	projectID, err := resourcename.ParseProjectName(req.GetName())
+	if err != nil {
+		return MoveProjectArgs{}, fmt.Errorf("cannot convert request to move project args: %w", apierror.Field("name", err))
+	}
+
+	anchor, after, field := req.GetBefore(), false, "before"
+	if _, ok := req.GetDestination().(*tasksv1.MoveProjectRequest_After); ok {
+		anchor, after, field = req.GetAfter(), true, "after"
+	}
+	anchorID, err := resourcename.ParseProjectName(anchor)
+	if err != nil {
+		return MoveProjectArgs{}, fmt.Errorf("cannot convert request to move project args: %w", apierror.Field(field, err))
+	}
+
+	return MoveProjectArgs{
+		ProjectID: projectID,
+		AnchorID:  anchorID,
+		After:     after,
+	}, nil
+}
+
+type BatchCreateProjectsArgs struct {
+	Requests []CreateProjectArgs
+}
+
+// newBatchCreateProjectsArgs converts every request of a batch and reports
+// the requests that cannot be converted as field violations.
+func newBatchCreateProjectsArgs(req *tasksv1.BatchCreateProjectsRequest) (BatchCreateProjectsArgs, []*errdetails.BadRequest_FieldViolation) {
+	var (
+		args       = BatchCreateProjectsArgs{Requests: make([]CreateProjectArgs, 0, len(req.GetRequests()))}
+		violations []*errdetails.BadRequest_FieldViolation
+		seen       = make(map[string]bool, len(req.GetRequests()))
+	)
+	for i, item := range req.GetRequests() {
+		field := fmt.Sprintf("requests[%d]", i)
+
+		itemArgs, err := newCreateProjectArgs(item)
+		if err != nil {
+			violations = append(violations, apierror.FieldViolations(field, err)...)
+			continue
+		}
+		if itemArgs.RequestID != "" {
+			violations = append(violations, apierror.Violation(field+".request_id", "request IDs are not supported within batches"))
+		}
+		if seen[itemArgs.ProjectID] {
+			violations = append(violations, apierror.Violation(field+".project_id", fmt.Sprintf("project id %q is repeated", itemArgs.ProjectID)))
+		}
+		seen[itemArgs.ProjectID] = true
+		args.Requests = append(args.Requests, itemArgs)
+	}
+	return args, violations
+}
+
+type BatchGetProjectsArgs struct {
+	ProjectIDs []string
+}
+
+func newBatchGetProjectsArgs(req *tasksv1.BatchGetProjectsRequest) (BatchGetProjectsArgs, []*errdetails.BadRequest_FieldViolation) {
+	projectIDs, violations := parseProjectNames(req.GetNames())
+	return BatchGetProjectsArgs{ProjectIDs: projectIDs}, violations
+}
+
+type BatchDeleteProjectsArgs struct {
+	ProjectIDs []string
+}
+
+func newBatchDeleteProjectsArgs(req *tasksv1.BatchDeleteProjectsRequest) (BatchDeleteProjectsArgs, []*errdetails.BadRequest_FieldViolation) {
+	projectIDs, violations := parseProjectNames(req.GetNames())
+	return BatchDeleteProjectsArgs{ProjectIDs: projectIDs}, violations
+}
+
+type WatchProjectsArgs struct {
+	// ChangeToken resumes watching after the change it was issued with. It
+	// may be empty.
+	ChangeToken string
+}
+
+func newWatchProjectsArgs(req *tasksv1.WatchProjectsRequest) WatchProjectsArgs {
+	return WatchProjectsArgs{
+		ChangeToken: req.GetChangeToken(),
+	}
+}
+
+// parseProjectNames parses the names of a batch request and reports the
+// invalid ones as field violations.
+func parseProjectNames(names []string) ([]string, []*errdetails.BadRequest_FieldViolation) {
+	var (
+		projectIDs = make([]string, 0, len(names))
+		violations []*errdetails.BadRequest_FieldViolation
+	)
+	for i, name := range names {
+		projectID, err := resourcename.ParseProjectName(name)
+		if err != nil {
+			violations = append(violations, apierror.Violation(fmt.Sprintf("names[%d]", i), err.Error()))
+			continue
+		}
+		projectIDs = append(projectIDs, projectID)
+	}
+	return projectIDs, violations
+}
+
+// IfMatchMetadataKey is the metadata key the HTTP gateway forwards the
+// If-Match header under. It supplies the etag of requests that carry none.
+const IfMatchMetadataKey = "if-match"
+
+// requestEtag returns etag or, if it is empty, the If-Match value of the
+// incoming metadata. The wildcard "*" matches every version, so it yields no
+// etag at all.
+func requestEtag(ctx context.Context, etag string) string {
+	if etag == "" {
+		if values := metadata.ValueFromIncomingContext(ctx, IfMatchMetadataKey); len(values) > 0 {
+			etag = strings.TrimSpace(values[0])
+		}
+	}
+	if etag == "*" {
+		return ""
+	}
+	return etag
+}
+
 type ServerAPI struct {
 	tasksv1.UnimplementedProjectServiceServer
 	service ProjectService
@@ -51,36 +414,197 @@ func Register(server *grpc.Server, service ProjectService) {
 }
 
 func (s *ServerAPI) CreateProject(ctx context.Context, req *tasksv1.CreateProjectRequest) (*tasksv1.Project, error) {
-	if err := req.ValidateAll(); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
-	}
-
 	args, err := newCreateProjectArgs(req)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+		return nil, apierror.InvalidRequest(err).Err()
 	}
 
-	if stat := s.service.Create(ctx, args); stat.Code() == codes.FailedPrecondition {
-		return nil, status.Errorf(codes.FailedPrecondition, "failed precondition for project creation: %v", err)
-	} else if stat != nil {
-		return nil, status.Errorf(codes.Internal, "cannot create new project: %v", err)
+	if stat := s.service.Create(ctx, args); stat != nil {
+		return nil, stat.Err()
 	}
 
 	return projectmodels.ProjectToGRPC(args.Project), nil
 }
 
-func (s *ServerAPI) DeleteProject(context.Context, *tasksv1.DeleteProjectRequest) (*emptypb.Empty, error) {
-	return nil, nil
+func (s *ServerAPI) DeleteProject(ctx context.Context, req *tasksv1.DeleteProjectRequest) (*tasksv1.Project, error) {
+	args, err := newDeleteProjectArgs(req)
+	if err != nil {
+		return nil, apierror.InvalidRequest(err).Err()
+	}
+	args.Etag = requestEtag(ctx, args.Etag)
+
+	project, stat := s.service.Delete(ctx, args)
+	if stat != nil {
+		return nil, stat.Err()
+	}
+
+	return projectmodels.ProjectToGRPC(project), nil
+}
+
+func (s *ServerAPI) GetProject(ctx context.Context, req *tasksv1.GetProjectRequest) (*tasksv1.Project, error) {
+	args, err := newGetProjectArgs(req)
+	if err != nil {
+		return nil, apierror.InvalidRequest(err).Err()
+	}
+
+	project, stat := s.service.Get(ctx, args)
+	if stat != nil {
+		return nil, stat.Err()
+	}
+
+	return projectmodels.ProjectToGRPC(project), nil
+}
+
+func (s *ServerAPI) ListProjects(ctx context.Context, req *tasksv1.ListProjectsRequest) (*tasksv1.ListProjectsResponse, error) {
+	args, err := newListProjectsArgs(req)
+	if err != nil {
+		return nil, apierror.InvalidRequest(err).Err()
+	}
+
+	projects, nextPageToken, stat := s.service.List(ctx, args)
+	if stat != nil {
+		return nil, stat.Err()
+	}
+
+	resp := &tasksv1.ListProjectsResponse{
+		Projects:      make([]*tasksv1.Project, 0, len(projects)),
+		NextPageToken: nextPageToken,
+	}
+	for _, project := range projects {
+		resp.Projects = append(resp.Projects, projectmodels.ProjectToGRPC(project))
+	}
+
+	return resp, nil
+}
+
+func (s *ServerAPI) UpdateProject(ctx context.Context, req *tasksv1.UpdateProjectRequest) (*tasksv1.Project, error) {
+	args, err := newUpdateProjectArgs(req)
+	if err != nil {
+		return nil, apierror.InvalidRequest(err).Err()
+	}
+	args.Etag = requestEtag(ctx, args.Etag)
+
+	project, stat := s.service.Update(ctx, args)
+	if stat != nil {
+		return nil, stat.Err()
+	}
+
+	return projectmodels.ProjectToGRPC(project), nil
+}
+
+func (s *ServerAPI) UndeleteProject(ctx context.Context, req *tasksv1.UndeleteProjectRequest) (*tasksv1.Project, error) {
+	args, err := newUndeleteProjectArgs(req)
+	if err != nil {
+		return nil, apierror.InvalidRequest(err).Err()
+	}
+
+	project, stat := s.service.Undelete(ctx, args)
+	if stat != nil {
+		return nil, stat.Err()
+	}
+
+	return projectmodels.ProjectToGRPC(project), nil
+}
+
+func (s *ServerAPI) ArchiveProject(ctx context.Context, req *tasksv1.ArchiveProjectRequest) (*tasksv1.Project, error) {
+	args, err := newArchiveProjectArgs(req)
+	if err != nil {
+		return nil, apierror.InvalidRequest(err).Err()
+	}
+
+	project, stat := s.service.Archive(ctx, args)
+	if stat != nil {
+		return nil, stat.Err()
+	}
+
+	return projectmodels.ProjectToGRPC(project), nil
+}
+
+func (s *ServerAPI) UnarchiveProject(ctx context.Context, req *tasksv1.UnarchiveProjectRequest) (*tasksv1.Project, error) {
+	args, err := newUnarchiveProjectArgs(req)
+	if err != nil {
+		return nil, apierror.InvalidRequest(err).Err()
+	}
+
+	project, stat := s.service.Unarchive(ctx, args)
+	if stat != nil {
+		return nil, stat.Err()
+	}
+
+	return projectmodels.ProjectToGRPC(project), nil
+}
+
+func (s *ServerAPI) MoveProject(ctx context.Context, req *tasksv1.MoveProjectRequest) (*tasksv1.Project, error) {
+	args, err := newMoveProjectArgs(req)
+	if err != nil {
+		return nil, apierror.InvalidRequest(err).Err()
+	}
+
+	project, stat := s.service.Move(ctx, args)
+	if stat != nil {
+		return nil, stat.Err()
+	}
+
+	return projectmodels.ProjectToGRPC(project), nil
 }
 
-func (s *ServerAPI) GetProject(context.Context, *tasksv1.GetProjectRequest) (*tasksv1.Project, error) {
-	return nil, nil
+func (s *ServerAPI) BatchCreateProjects(ctx context.Context, req *tasksv1.BatchCreateProjectsRequest) (*tasksv1.BatchCreateProjectsResponse, error) {
+	args, violations := newBatchCreateProjectsArgs(req)
+	if len(violations) > 0 {
+		return nil, apierror.BadRequest("invalid request", violations...).Err()
+	}
+
+	projects, stat := s.service.BatchCreate(ctx, args)
+	if stat != nil {
+		return nil, stat.Err()
+	}
+
+	return &tasksv1.BatchCreateProjectsResponse{Projects: projectsToGRPC(projects)}, nil
 }
 
-func (s *ServerAPI) ListProjects(context.Context, *tasksv1.ListProjectsRequest) (*tasksv1.ListProjectsResponse, error) {
-	return nil, nil
+func (s *ServerAPI) BatchGetProjects(ctx context.Context, req *tasksv1.BatchGetProjectsRequest) (*tasksv1.BatchGetProjectsResponse, error) {
+	args, violations := newBatchGetProjectsArgs(req)
+	if len(violations) > 0 {
+		return nil, apierror.BadRequest("invalid request", violations...).Err()
+	}
+
+	projects, stat := s.service.BatchGet(ctx, args)
+	if stat != nil {
+		return nil, stat.Err()
+	}
+
+	return &tasksv1.BatchGetProjectsResponse{Projects: projectsToGRPC(projects)}, nil
+}
+
+func (s *ServerAPI) BatchDeleteProjects(ctx context.Context, req *tasksv1.BatchDeleteProjectsRequest) (*tasksv1.BatchDeleteProjectsResponse, error) {
+	args, violations := newBatchDeleteProjectsArgs(req)
+	if len(violations) > 0 {
+		return nil, apierror.BadRequest("invalid request", violations...).Err()
+	}
+
+	projects, stat := s.service.BatchDelete(ctx, args)
+	if stat != nil {
+		return nil, stat.Err()
+	}
+
+	return &tasksv1.BatchDeleteProjectsResponse{Projects: projectsToGRPC(projects)}, nil
+}
+
+func (s *ServerAPI) WatchProjects(req *tasksv1.WatchProjectsRequest, stream tasksv1.ProjectService_WatchProjectsServer) error {
+	stat := s.service.Watch(stream.Context(), newWatchProjectsArgs(req), func(change *projectmodels.ProjectChange) error {
+		return stream.Send(projectmodels.ProjectChangeToGRPC(change))
+	})
+	if stat != nil {
+		return stat.Err()
+	}
+
+	return nil
 }
 
-func (s *ServerAPI) UpdateProject(context.Context, *tasksv1.UpdateProjectRequest) (*tasksv1.Project, error) {
-	return nil, nil
+func projectsToGRPC(projects []*projectmodels.Project) []*tasksv1.Project {
+	converted := make([]*tasksv1.Project, 0, len(projects))
+	for _, project := range projects {
+		converted = append(converted, projectmodels.ProjectToGRPC(project))
+	}
+	return converted
 }
@@ -2,18 +2,24 @@ package projectapi_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
 	tasksv1 "github.com/10Narratives/ready-to-do/contracts/gen/go/proto/tasks/v1"
+	"github.com/10Narratives/ready-to-do/server/internal/apierror"
 	projectmodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/project"
 	projectapi "github.com/10Narratives/ready-to-do/server/internal/transport/grpc/tasks/project"
 	"github.com/10Narratives/ready-to-do/server/internal/transport/grpc/tasks/project/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 func TestServerAPI_CreateProject(t *testing.T) {
@@ -83,6 +89,48 @@ func TestServerAPI_CreateProject(t *testing.T) {
 			},
 			wantErr: require.NoError,
 		},
+		{
+			name: "upper-case project id",
+			fields: fields{
+				setupProjectServiceMock: func(m *mocks.ProjectService) {
+					m.On("Create", mock.Anything, projectapi.CreateProjectArgs{
+						ProjectID: projectID,
+						Project:   project,
+					}).Return(nil)
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &tasksv1.CreateProjectRequest{
+					ProjectId: strings.ToUpper(projectID),
+					Project:   projectmodels.ProjectToGRPC(project),
+				},
+			},
+			want:    require.NotNil,
+			wantErr: require.NoError,
+		},
+		{
+			name: "request id",
+			fields: fields{
+				setupProjectServiceMock: func(m *mocks.ProjectService) {
+					m.On("Create", mock.Anything, projectapi.CreateProjectArgs{
+						ProjectID: projectID,
+						Project:   project,
+						RequestID: "b1ffcd88-8d1a-4ef8-bb6d-6bb9bd380a22",
+					}).Return(nil)
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &tasksv1.CreateProjectRequest{
+					ProjectId: projectID,
+					Project:   projectmodels.ProjectToGRPC(project),
+					RequestId: "b1ffcd88-8d1a-4ef8-bb6d-6bb9bd380a22",
+				},
+			},
+			want:    require.NotEmpty,
+			wantErr: require.NoError,
+		},
 		{
 			name: "validation error recieved",
 			fields: fields{
@@ -115,6 +163,21 @@ func TestServerAPI_CreateProject(t *testing.T) {
 			want:    require.Empty,
 			wantErr: require.Error,
 		},
+		{
+			name: "missing project",
+			fields: fields{
+				setupProjectServiceMock: func(m *mocks.ProjectService) {
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &tasksv1.CreateProjectRequest{
+					ProjectId: projectID,
+				},
+			},
+			want:    require.Empty,
+			wantErr: require.Error,
+		},
 		{
 			name: "failed precondition",
 			fields: fields{
@@ -135,6 +198,31 @@ func TestServerAPI_CreateProject(t *testing.T) {
 			want:    require.Empty,
 			wantErr: require.Error,
 		},
+		{
+			name: "service status is kept",
+			fields: fields{
+				setupProjectServiceMock: func(m *mocks.ProjectService) {
+					m.On("Create", mock.Anything, projectapi.CreateProjectArgs{
+						ProjectID: projectID,
+						Project:   project,
+					}).Return(apierror.AlreadyExists("tasks.readytogo.com/Project", project.Name))
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &tasksv1.CreateProjectRequest{
+					ProjectId: projectID,
+					Project:   projectmodels.ProjectToGRPC(project),
+				},
+			},
+			want: require.Empty,
+			wantErr: func(t require.TestingT, err error, _ ...interface{}) {
+				stat := status.Convert(err)
+				require.Equal(t, codes.AlreadyExists, stat.Code())
+				assert.Equal(t, `project "projects/`+projectID+`" already exists`, stat.Message())
+				assert.Len(t, stat.Details(), 2)
+			},
+		},
 		{
 			name: "internal error",
 			fields: fields{
@@ -172,3 +260,1015 @@ func TestServerAPI_CreateProject(t *testing.T) {
 		})
 	}
 }
+
+func TestServerAPI_GetProject(t *testing.T) {
+	t.Parallel()
+
+	var (
+		projectID string = "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"
+		project          = &projectmodels.Project{
+			Name:        "projects/" + projectID,
+			DisplayName: "the awesome project",
+			Description: "the awesome decription",
+			ColorTag:    "#000000",
+			CreatedAt:   time.Now().UTC(),
+			UpdatedAt:   time.Now().UTC(),
+			State:       projectmodels.ActiveProjectState,
+		}
+	)
+
+	type fields struct {
+		setupProjectServiceMock func(m *mocks.ProjectService)
+	}
+	type args struct {
+		ctx context.Context
+		req *tasksv1.GetProjectRequest
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		args     args
+		want     require.ValueAssertionFunc
+		wantCode codes.Code
+	}{
+		{
+			name: "successful execution",
+			fields: fields{
+				setupProjectServiceMock: func(m *mocks.ProjectService) {
+					m.On("Get", mock.Anything, projectapi.GetProjectArgs{
+						ProjectID: projectID,
+					}).Return(project, nil)
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &tasksv1.GetProjectRequest{Name: "projects/" + projectID},
+			},
+			want: func(tt require.TestingT, got interface{}, _ ...interface{}) {
+				p, ok := got.(*tasksv1.Project)
+				require.True(t, ok)
+
+				converted, err := projectmodels.ProjectFromGRPC(p)
+				require.NoError(t, err)
+
+				assert.Equal(t, project, converted)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "wrong collection in name",
+			fields: fields{
+				setupProjectServiceMock: func(m *mocks.ProjectService) {
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &tasksv1.GetProjectRequest{Name: "project/" + projectID},
+			},
+			want:     require.Empty,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "invalid project id",
+			fields: fields{
+				setupProjectServiceMock: func(m *mocks.ProjectService) {
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &tasksv1.GetProjectRequest{Name: "projects/projectID"},
+			},
+			want:     require.Empty,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "nested name",
+			fields: fields{
+				setupProjectServiceMock: func(m *mocks.ProjectService) {
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &tasksv1.GetProjectRequest{Name: "projects/" + projectID + "/tasks/1"},
+			},
+			want:     require.Empty,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "project not found",
+			fields: fields{
+				setupProjectServiceMock: func(m *mocks.ProjectService) {
+					m.On("Get", mock.Anything, projectapi.GetProjectArgs{
+						ProjectID: projectID,
+					}).Return(nil, status.New(codes.NotFound, "project not found"))
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &tasksv1.GetProjectRequest{Name: "projects/" + projectID},
+			},
+			want:     require.Empty,
+			wantCode: codes.NotFound,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			projectServiceMock := mocks.NewProjectService(t)
+			tt.fields.setupProjectServiceMock(projectServiceMock)
+
+			api := projectapi.New(projectServiceMock)
+			resp, err := api.GetProject(tt.args.ctx, tt.args.req)
+
+			tt.want(t, resp)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestServerAPI_ListProjects(t *testing.T) {
+	t.Parallel()
+
+	project := &projectmodels.Project{
+		Name:        "projects/a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11",
+		DisplayName: "the awesome project",
+		CreatedAt:   time.Now().UTC(),
+		UpdatedAt:   time.Now().UTC(),
+		State:       projectmodels.ArchivedProjectState,
+	}
+
+	type fields struct {
+		setupProjectServiceMock func(m *mocks.ProjectService)
+	}
+	type args struct {
+		ctx context.Context
+		req *tasksv1.ListProjectsRequest
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		args     args
+		want     require.ValueAssertionFunc
+		wantCode codes.Code
+	}{
+		{
+			name: "successful execution",
+			fields: fields{
+				setupProjectServiceMock: func(m *mocks.ProjectService) {
+					m.On("List", mock.Anything, projectapi.ListProjectsArgs{
+						PageSize:     10,
+						PageToken:    "token",
+						OrderBy:      projectmodels.ProjectOrder{Field: projectmodels.OrderByDisplayName, Desc: true},
+						ShowArchived: true,
+					}).Return([]*projectmodels.Project{project}, "next", nil)
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &tasksv1.ListProjectsRequest{
+					PageSize:     10,
+					PageToken:    "token",
+					OrderBy:      "display_name desc",
+					ShowArchived: true,
+				},
+			},
+			want: func(tt require.TestingT, got interface{}, _ ...interface{}) {
+				resp, ok := got.(*tasksv1.ListProjectsResponse)
+				require.True(t, ok)
+				require.Len(t, resp.GetProjects(), 1)
+				assert.Equal(t, "next", resp.GetNextPageToken())
+
+				converted, err := projectmodels.ProjectFromGRPC(resp.GetProjects()[0])
+				require.NoError(t, err)
+				assert.Equal(t, project, converted)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "unsupported order",
+			fields: fields{
+				setupProjectServiceMock: func(m *mocks.ProjectService) {
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &tasksv1.ListProjectsRequest{OrderBy: "description"},
+			},
+			want:     require.Empty,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "invalid page token",
+			fields: fields{
+				setupProjectServiceMock: func(m *mocks.ProjectService) {
+					m.On("List", mock.Anything, mock.Anything).
+						Return(nil, "", status.New(codes.InvalidArgument, "invalid page token"))
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &tasksv1.ListProjectsRequest{PageToken: "garbage"},
+			},
+			want:     require.Empty,
+			wantCode: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			projectServiceMock := mocks.NewProjectService(t)
+			tt.fields.setupProjectServiceMock(projectServiceMock)
+
+			api := projectapi.New(projectServiceMock)
+			resp, err := api.ListProjects(tt.args.ctx, tt.args.req)
+
+			tt.want(t, resp)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestServerAPI_UpdateProject(t *testing.T) {
+	t.Parallel()
+
+	var (
+		projectID string = "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"
+		project          = &projectmodels.Project{
+			Name:        "projects/" + projectID,
+			DisplayName: "the awesome project",
+			Description: "the awesome decription",
+			ColorTag:    "#000000",
+			CreatedAt:   time.Now().UTC(),
+			UpdatedAt:   time.Now().UTC(),
+			State:       projectmodels.ActiveProjectState,
+		}
+	)
+
+	type fields struct {
+		setupProjectServiceMock func(m *mocks.ProjectService)
+	}
+	type args struct {
+		ctx context.Context
+		req *tasksv1.UpdateProjectRequest
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		args     args
+		want     require.ValueAssertionFunc
+		wantCode codes.Code
+	}{
+		{
+			name: "partial update",
+			fields: fields{
+				setupProjectServiceMock: func(m *mocks.ProjectService) {
+					m.On("Update", mock.Anything, projectapi.UpdateProjectArgs{
+						ProjectID: projectID,
+						Project: &projectmodels.Project{
+							Name:        "projects/" + projectID,
+							DisplayName: "the awesome project",
+						},
+						Fields: []string{projectmodels.DisplayNameField},
+					}).Return(project, nil)
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &tasksv1.UpdateProjectRequest{
+					Project: &tasksv1.Project{
+						Name:        "projects/" + projectID,
+						DisplayName: "the awesome project",
+					},
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+				},
+			},
+			want:     require.NotEmpty,
+			wantCode: codes.OK,
+		},
+		{
+			name: "wildcard mask",
+			fields: fields{
+				setupProjectServiceMock: func(m *mocks.ProjectService) {
+					m.On("Update", mock.Anything, mock.MatchedBy(func(args projectapi.UpdateProjectArgs) bool {
+						return assert.ObjectsAreEqual(projectmodels.UpdatableProjectFields, args.Fields) &&
+							args.Project.State == projectmodels.ArchivedProjectState
+					})).Return(project, nil)
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &tasksv1.UpdateProjectRequest{
+					Project: &tasksv1.Project{
+						Name:  "projects/" + projectID,
+						State: tasksv1.Project_ARCHIVED,
+					},
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"*"}},
+				},
+			},
+			want:     require.NotEmpty,
+			wantCode: codes.OK,
+		},
+		{
+			name: "etag",
+			fields: fields{
+				setupProjectServiceMock: func(m *mocks.ProjectService) {
+					m.On("Update", mock.Anything, mock.MatchedBy(func(args projectapi.UpdateProjectArgs) bool {
+						return args.Etag == `"3"`
+					})).Return(project, nil)
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &tasksv1.UpdateProjectRequest{
+					Project: &tasksv1.Project{
+						Name:        "projects/" + projectID,
+						DisplayName: "the awesome project",
+						Etag:        `"3"`,
+					},
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+				},
+			},
+			want:     require.NotEmpty,
+			wantCode: codes.OK,
+		},
+		{
+			name: "etag from If-Match",
+			fields: fields{
+				setupProjectServiceMock: func(m *mocks.ProjectService) {
+					m.On("Update", mock.Anything, mock.MatchedBy(func(args projectapi.UpdateProjectArgs) bool {
+						return args.Etag == `W/"3"`
+					})).Return(project, nil)
+				},
+			},
+			args: args{
+				ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs(projectapi.IfMatchMetadataKey, `W/"3"`)),
+				req: &tasksv1.UpdateProjectRequest{
+					Project: &tasksv1.Project{
+						Name:        "projects/" + projectID,
+						DisplayName: "the awesome project",
+					},
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+				},
+			},
+			want:     require.NotEmpty,
+			wantCode: codes.OK,
+		},
+		{
+			name: "output only path",
+			fields: fields{
+				setupProjectServiceMock: func(m *mocks.ProjectService) {
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &tasksv1.UpdateProjectRequest{
+					Project:    &tasksv1.Project{Name: "projects/" + projectID},
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"created_at"}},
+				},
+			},
+			want:     require.Empty,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "unknown path",
+			fields: fields{
+				setupProjectServiceMock: func(m *mocks.ProjectService) {
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &tasksv1.UpdateProjectRequest{
+					Project:    &tasksv1.Project{Name: "projects/" + projectID},
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"owner"}},
+				},
+			},
+			want:     require.Empty,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "missing update mask",
+			fields: fields{
+				setupProjectServiceMock: func(m *mocks.ProjectService) {
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &tasksv1.UpdateProjectRequest{
+					Project: &tasksv1.Project{Name: "projects/" + projectID},
+				},
+			},
+			want:     require.Empty,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "unspecified state in mask",
+			fields: fields{
+				setupProjectServiceMock: func(m *mocks.ProjectService) {
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &tasksv1.UpdateProjectRequest{
+					Project:    &tasksv1.Project{Name: "projects/" + projectID},
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"state"}},
+				},
+			},
+			want:     require.Empty,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "forbidden transition",
+			fields: fields{
+				setupProjectServiceMock: func(m *mocks.ProjectService) {
+					m.On("Update", mock.Anything, mock.Anything).
+						Return(nil, status.New(codes.FailedPrecondition, "forbidden transition"))
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &tasksv1.UpdateProjectRequest{
+					Project: &tasksv1.Project{
+						Name:  "projects/" + projectID,
+						State: tasksv1.Project_DELETED,
+					},
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"state"}},
+				},
+			},
+			want:     require.Empty,
+			wantCode: codes.FailedPrecondition,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			projectServiceMock := mocks.NewProjectService(t)
+			tt.fields.setupProjectServiceMock(projectServiceMock)
+
+			api := projectapi.New(projectServiceMock)
+			resp, err := api.UpdateProject(tt.args.ctx, tt.args.req)
+
+			tt.want(t, resp)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestServerAPI_DeleteProject(t *testing.T) {
+	t.Parallel()
+
+	projectID := "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"
+	deleted := &projectmodels.Project{
+		Name:       "projects/" + projectID,
+		State:      projectmodels.DeletedprojectState,
+		DeleteTime: time.Now().UTC(),
+		PurgeTime:  time.Now().UTC().Add(time.Hour),
+	}
+
+	tests := []struct {
+		name                    string
+		setupProjectServiceMock func(m *mocks.ProjectService)
+		req                     *tasksv1.DeleteProjectRequest
+		ifMatch                 string
+		wantCode                codes.Code
+	}{
+		{
+			name: "successful execution",
+			setupProjectServiceMock: func(m *mocks.ProjectService) {
+				m.On("Delete", mock.Anything, projectapi.DeleteProjectArgs{ProjectID: projectID}).Return(deleted, nil)
+			},
+			req:      &tasksv1.DeleteProjectRequest{Name: "projects/" + projectID},
+			wantCode: codes.OK,
+		},
+		{
+			name: "etag from request",
+			setupProjectServiceMock: func(m *mocks.ProjectService) {
+				m.On("Delete", mock.Anything, projectapi.DeleteProjectArgs{ProjectID: projectID, Etag: `"2"`}).Return(deleted, nil)
+			},
+			req:      &tasksv1.DeleteProjectRequest{Name: "projects/" + projectID, Etag: `"2"`},
+			ifMatch:  `"1"`,
+			wantCode: codes.OK,
+		},
+		{
+			name: "etag from If-Match",
+			setupProjectServiceMock: func(m *mocks.ProjectService) {
+				m.On("Delete", mock.Anything, projectapi.DeleteProjectArgs{ProjectID: projectID, Etag: `"1"`}).Return(deleted, nil)
+			},
+			req:      &tasksv1.DeleteProjectRequest{Name: "projects/" + projectID},
+			ifMatch:  `"1"`,
+			wantCode: codes.OK,
+		},
+		{
+			name: "wildcard If-Match",
+			setupProjectServiceMock: func(m *mocks.ProjectService) {
+				m.On("Delete", mock.Anything, projectapi.DeleteProjectArgs{ProjectID: projectID}).Return(deleted, nil)
+			},
+			req:      &tasksv1.DeleteProjectRequest{Name: "projects/" + projectID},
+			ifMatch:  "*",
+			wantCode: codes.OK,
+		},
+		{
+			name: "etag mismatch",
+			setupProjectServiceMock: func(m *mocks.ProjectService) {
+				m.On("Delete", mock.Anything, mock.Anything).Return(nil, status.New(codes.Aborted, "etag mismatch"))
+			},
+			req:      &tasksv1.DeleteProjectRequest{Name: "projects/" + projectID, Etag: `"1"`},
+			wantCode: codes.Aborted,
+		},
+		{
+			name:                    "invalid name",
+			setupProjectServiceMock: func(m *mocks.ProjectService) {},
+			req:                     &tasksv1.DeleteProjectRequest{Name: "projects/1"},
+			wantCode:                codes.InvalidArgument,
+		},
+		{
+			name: "project not found",
+			setupProjectServiceMock: func(m *mocks.ProjectService) {
+				m.On("Delete", mock.Anything, mock.Anything).Return(nil, status.New(codes.NotFound, "project not found"))
+			},
+			req:      &tasksv1.DeleteProjectRequest{Name: "projects/" + projectID},
+			wantCode: codes.NotFound,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			projectServiceMock := mocks.NewProjectService(t)
+			tt.setupProjectServiceMock(projectServiceMock)
+
+			ctx := context.Background()
+			if tt.ifMatch != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(projectapi.IfMatchMetadataKey, tt.ifMatch))
+			}
+
+			resp, err := projectapi.New(projectServiceMock).DeleteProject(ctx, tt.req)
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, tasksv1.Project_DELETED, resp.GetState())
+				assert.NotNil(t, resp.GetDeleteTime())
+				assert.NotNil(t, resp.GetPurgeTime())
+			}
+		})
+	}
+}
+
+func TestServerAPI_UndeleteProject(t *testing.T) {
+	t.Parallel()
+
+	projectID := "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"
+
+	tests := []struct {
+		name                    string
+		setupProjectServiceMock func(m *mocks.ProjectService)
+		req                     *tasksv1.UndeleteProjectRequest
+		wantCode                codes.Code
+	}{
+		{
+			name: "successful execution",
+			setupProjectServiceMock: func(m *mocks.ProjectService) {
+				m.On("Undelete", mock.Anything, projectapi.UndeleteProjectArgs{ProjectID: projectID}).Return(&projectmodels.Project{
+					Name:  "projects/" + projectID,
+					State: projectmodels.ActiveProjectState,
+				}, nil)
+			},
+			req:      &tasksv1.UndeleteProjectRequest{Name: "projects/" + projectID},
+			wantCode: codes.OK,
+		},
+		{
+			name:                    "invalid name",
+			setupProjectServiceMock: func(m *mocks.ProjectService) {},
+			req:                     &tasksv1.UndeleteProjectRequest{Name: "Projects/" + projectID},
+			wantCode:                codes.InvalidArgument,
+		},
+		{
+			name: "project is not deleted",
+			setupProjectServiceMock: func(m *mocks.ProjectService) {
+				m.On("Undelete", mock.Anything, mock.Anything).Return(nil, status.New(codes.FailedPrecondition, "not deleted"))
+			},
+			req:      &tasksv1.UndeleteProjectRequest{Name: "projects/" + projectID},
+			wantCode: codes.FailedPrecondition,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			projectServiceMock := mocks.NewProjectService(t)
+			tt.setupProjectServiceMock(projectServiceMock)
+
+			resp, err := projectapi.New(projectServiceMock).UndeleteProject(context.Background(), tt.req)
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, tasksv1.Project_ACTIVE, resp.GetState())
+				assert.Nil(t, resp.GetDeleteTime())
+			}
+		})
+	}
+}
+
+func TestServerAPI_ArchiveProject(t *testing.T) {
+	t.Parallel()
+
+	projectID := "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"
+
+	tests := []struct {
+		name                    string
+		setupProjectServiceMock func(m *mocks.ProjectService)
+		call                    func(api *projectapi.ServerAPI) (*tasksv1.Project, error)
+		wantState               tasksv1.Project_State
+		wantCode                codes.Code
+	}{
+		{
+			name: "archive",
+			setupProjectServiceMock: func(m *mocks.ProjectService) {
+				m.On("Archive", mock.Anything, projectapi.ArchiveProjectArgs{ProjectID: projectID}).Return(&projectmodels.Project{
+					Name:  "projects/" + projectID,
+					State: projectmodels.ArchivedProjectState,
+				}, nil)
+			},
+			call: func(api *projectapi.ServerAPI) (*tasksv1.Project, error) {
+				return api.ArchiveProject(context.Background(), &tasksv1.ArchiveProjectRequest{Name: "projects/" + projectID})
+			},
+			wantState: tasksv1.Project_ARCHIVED,
+			wantCode:  codes.OK,
+		},
+		{
+			name: "archive deleted project",
+			setupProjectServiceMock: func(m *mocks.ProjectService) {
+				m.On("Archive", mock.Anything, mock.Anything).
+					Return(nil, status.New(codes.FailedPrecondition, "project is DELETED, expected ACTIVE"))
+			},
+			call: func(api *projectapi.ServerAPI) (*tasksv1.Project, error) {
+				return api.ArchiveProject(context.Background(), &tasksv1.ArchiveProjectRequest{Name: "projects/" + projectID})
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name: "unarchive",
+			setupProjectServiceMock: func(m *mocks.ProjectService) {
+				m.On("Unarchive", mock.Anything, projectapi.UnarchiveProjectArgs{ProjectID: projectID}).Return(&projectmodels.Project{
+					Name:  "projects/" + projectID,
+					State: projectmodels.ActiveProjectState,
+				}, nil)
+			},
+			call: func(api *projectapi.ServerAPI) (*tasksv1.Project, error) {
+				return api.UnarchiveProject(context.Background(), &tasksv1.UnarchiveProjectRequest{Name: "projects/" + projectID})
+			},
+			wantState: tasksv1.Project_ACTIVE,
+			wantCode:  codes.OK,
+		},
+		{
+			name:                    "unarchive with invalid name",
+			setupProjectServiceMock: func(m *mocks.ProjectService) {},
+			call: func(api *projectapi.ServerAPI) (*tasksv1.Project, error) {
+				return api.UnarchiveProject(context.Background(), &tasksv1.UnarchiveProjectRequest{Name: projectID})
+			},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			projectServiceMock := mocks.NewProjectService(t)
+			tt.setupProjectServiceMock(projectServiceMock)
+
+			resp, err := tt.call(projectapi.New(projectServiceMock))
+			require.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.wantState, resp.GetState())
+		})
+	}
+}
+
+func TestServerAPI_MoveProject(t *testing.T) {
+	t.Parallel()
+
+	projectID := "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"
+	anchorID := "b0eebc99-9c0b-4ef8-bb6d-6bb9bd380a22"
+
+	tests := []struct {
+		name                    string
+		setupProjectServiceMock func(m *mocks.ProjectService)
+		req                     *tasksv1.MoveProjectRequest
+		wantCode                codes.Code
+	}{
+		{
+			name: "move after",
+			setupProjectServiceMock: func(m *mocks.ProjectService) {
+				m.On("Move", mock.Anything, projectapi.MoveProjectArgs{ProjectID: projectID, AnchorID: anchorID, After: true}).
+					Return(&projectmodels.Project{Name: "projects/" + projectID, Position: "VV"}, nil)
+			},
+			req: &tasksv1.MoveProjectRequest{
+				Name:        "projects/" + projectID,
+				Destination: &tasksv1.MoveProjectRequest_After{After: "projects/" + anchorID},
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "move before",
+			setupProjectServiceMock: func(m *mocks.ProjectService) {
+				m.On("Move", mock.Anything, projectapi.MoveProjectArgs{ProjectID: projectID, AnchorID: anchorID}).
+					Return(&projectmodels.Project{Name: "projects/" + projectID, Position: "VV"}, nil)
+			},
+			req: &tasksv1.MoveProjectRequest{
+				Name:        "projects/" + projectID,
+				Destination: &tasksv1.MoveProjectRequest_Before{Before: "projects/" + anchorID},
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:                    "missing destination",
+			setupProjectServiceMock: func(m *mocks.ProjectService) {},
+			req:                     &tasksv1.MoveProjectRequest{Name: "projects/" + projectID},
+			wantCode:                codes.InvalidArgument,
+		},
+		{
+			name:                    "invalid anchor name",
+			setupProjectServiceMock: func(m *mocks.ProjectService) {},
+			req: &tasksv1.MoveProjectRequest{
+				Name:        "projects/" + projectID,
+				Destination: &tasksv1.MoveProjectRequest_After{After: anchorID},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			projectServiceMock := mocks.NewProjectService(t)
+			tt.setupProjectServiceMock(projectServiceMock)
+
+			resp, err := projectapi.New(projectServiceMock).MoveProject(context.Background(), tt.req)
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, "VV", resp.GetPosition())
+			}
+		})
+	}
+}
+
+// violationFields returns the fields of the BadRequest details of err.
+func violationFields(err error) []string {
+	var fields []string
+	for _, detail := range status.Convert(err).Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.GetFieldViolations() {
+				fields = append(fields, violation.GetField())
+			}
+		}
+	}
+	return fields
+}
+
+func TestServerAPI_BatchCreateProjects(t *testing.T) {
+	t.Parallel()
+
+	const (
+		firstID  = "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"
+		secondID = "b1ffcd88-8d1a-4ef8-bb6d-6bb9bd380a22"
+	)
+
+	tests := []struct {
+		name                    string
+		setupProjectServiceMock func(m *mocks.ProjectService)
+		req                     *tasksv1.BatchCreateProjectsRequest
+		wantCode                codes.Code
+		wantViolations          []string
+	}{
+		{
+			name: "successful execution",
+			setupProjectServiceMock: func(m *mocks.ProjectService) {
+				m.On("BatchCreate", mock.Anything, mock.MatchedBy(func(args projectapi.BatchCreateProjectsArgs) bool {
+					return len(args.Requests) == 2 && args.Requests[1].ProjectID == secondID
+				})).Return([]*projectmodels.Project{
+					{Name: "projects/" + firstID, State: projectmodels.ActiveProjectState},
+					{Name: "projects/" + secondID, State: projectmodels.ActiveProjectState},
+				}, nil)
+			},
+			req: &tasksv1.BatchCreateProjectsRequest{Requests: []*tasksv1.CreateProjectRequest{
+				{ProjectId: firstID, Project: &tasksv1.Project{State: tasksv1.Project_ACTIVE}},
+				{ProjectId: secondID, Project: &tasksv1.Project{State: tasksv1.Project_ACTIVE}},
+			}},
+			wantCode: codes.OK,
+		},
+		{
+			name:                    "repeated project id and request id",
+			setupProjectServiceMock: func(m *mocks.ProjectService) {},
+			req: &tasksv1.BatchCreateProjectsRequest{Requests: []*tasksv1.CreateProjectRequest{
+				{ProjectId: firstID, Project: &tasksv1.Project{State: tasksv1.Project_ACTIVE}},
+				{ProjectId: firstID, Project: &tasksv1.Project{State: tasksv1.Project_ACTIVE}, RequestId: secondID},
+			}},
+			wantCode:       codes.InvalidArgument,
+			wantViolations: []string{"requests[1].request_id", "requests[1].project_id"},
+		},
+		{
+			name: "project already exists",
+			setupProjectServiceMock: func(m *mocks.ProjectService) {
+				m.On("BatchCreate", mock.Anything, mock.Anything).Return(nil, status.New(codes.AlreadyExists, "project already exists"))
+			},
+			req: &tasksv1.BatchCreateProjectsRequest{Requests: []*tasksv1.CreateProjectRequest{
+				{ProjectId: firstID, Project: &tasksv1.Project{State: tasksv1.Project_ACTIVE}},
+			}},
+			wantCode: codes.AlreadyExists,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			projectServiceMock := mocks.NewProjectService(t)
+			tt.setupProjectServiceMock(projectServiceMock)
+
+			resp, err := projectapi.New(projectServiceMock).BatchCreateProjects(context.Background(), tt.req)
+			require.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.wantViolations, violationFields(err))
+			if tt.wantCode == codes.OK {
+				assert.Len(t, resp.GetProjects(), 2)
+			}
+		})
+	}
+}
+
+func TestServerAPI_BatchGetProjects(t *testing.T) {
+	t.Parallel()
+
+	const projectID = "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"
+
+	tests := []struct {
+		name                    string
+		setupProjectServiceMock func(m *mocks.ProjectService)
+		names                   []string
+		wantCode                codes.Code
+		wantViolations          []string
+	}{
+		{
+			name: "successful execution",
+			setupProjectServiceMock: func(m *mocks.ProjectService) {
+				m.On("BatchGet", mock.Anything, projectapi.BatchGetProjectsArgs{ProjectIDs: []string{projectID, projectID}}).
+					Return([]*projectmodels.Project{{Name: "projects/" + projectID}, {Name: "projects/" + projectID}}, nil)
+			},
+			names:    []string{"projects/" + projectID, "projects/" + projectID},
+			wantCode: codes.OK,
+		},
+		{
+			name:                    "invalid names",
+			setupProjectServiceMock: func(m *mocks.ProjectService) {},
+			names:                   []string{"projects/" + projectID, "projects/1", "tasks/" + projectID},
+			wantCode:                codes.InvalidArgument,
+			wantViolations:          []string{"names[1]", "names[2]"},
+		},
+		{
+			name: "project not found",
+			setupProjectServiceMock: func(m *mocks.ProjectService) {
+				m.On("BatchGet", mock.Anything, mock.Anything).Return(nil, status.New(codes.NotFound, "project not found"))
+			},
+			names:    []string{"projects/" + projectID},
+			wantCode: codes.NotFound,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			projectServiceMock := mocks.NewProjectService(t)
+			tt.setupProjectServiceMock(projectServiceMock)
+
+			resp, err := projectapi.New(projectServiceMock).BatchGetProjects(context.Background(), &tasksv1.BatchGetProjectsRequest{Names: tt.names})
+			require.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.wantViolations, violationFields(err))
+			if tt.wantCode == codes.OK {
+				assert.Len(t, resp.GetProjects(), len(tt.names))
+			}
+		})
+	}
+}
+
+func TestServerAPI_BatchDeleteProjects(t *testing.T) {
+	t.Parallel()
+
+	const projectID = "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"
+
+	tests := []struct {
+		name                    string
+		setupProjectServiceMock func(m *mocks.ProjectService)
+		names                   []string
+		wantCode                codes.Code
+		wantViolations          []string
+	}{
+		{
+			name: "successful execution",
+			setupProjectServiceMock: func(m *mocks.ProjectService) {
+				m.On("BatchDelete", mock.Anything, projectapi.BatchDeleteProjectsArgs{ProjectIDs: []string{projectID}}).
+					Return([]*projectmodels.Project{{Name: "projects/" + projectID, State: projectmodels.DeletedprojectState}}, nil)
+			},
+			names:    []string{"projects/" + projectID},
+			wantCode: codes.OK,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			projectServiceMock := mocks.NewProjectService(t)
+			tt.setupProjectServiceMock(projectServiceMock)
+
+			resp, err := projectapi.New(projectServiceMock).BatchDeleteProjects(context.Background(), &tasksv1.BatchDeleteProjectsRequest{Names: tt.names})
+			require.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.wantViolations, violationFields(err))
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, tasksv1.Project_DELETED, resp.GetProjects()[0].GetState())
+			}
+		})
+	}
+}
+
+// watchStream records the responses of a WatchProjects call.
+type watchStream struct {
+	grpc.ServerStream
+	sent []*tasksv1.WatchProjectsResponse
+}
+
+func (s *watchStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *watchStream) Send(resp *tasksv1.WatchProjectsResponse) error {
+	s.sent = append(s.sent, resp)
+	return nil
+}
+
+func TestServerAPI_WatchProjects(t *testing.T) {
+	t.Parallel()
+
+	const name = "projects/a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"
+
+	tests := []struct {
+		name                    string
+		setupProjectServiceMock func(m *mocks.ProjectService)
+		changeToken             string
+		wantCode                codes.Code
+		wantTypes               []tasksv1.WatchProjectsResponse_ChangeType
+	}{
+		{
+			name: "successful execution",
+			setupProjectServiceMock: func(m *mocks.ProjectService) {
+				m.On("Watch", mock.Anything, projectapi.WatchProjectsArgs{ChangeToken: "token"}, mock.Anything).
+					Run(func(args mock.Arguments) {
+						send := args.Get(2).(func(*projectmodels.ProjectChange) error)
+						_ = send(&projectmodels.ProjectChange{Type: projectmodels.HeartbeatChangeType, Token: "token"})
+						_ = send(&projectmodels.ProjectChange{
+							Type:    projectmodels.UpdatedChangeType,
+							Name:    name,
+							Project: &projectmodels.Project{Name: name},
+							Token:   "next",
+						})
+					}).Return(nil)
+			},
+			changeToken: "token",
+			wantCode:    codes.OK,
+			wantTypes:   []tasksv1.WatchProjectsResponse_ChangeType{tasksv1.WatchProjectsResponse_HEARTBEAT, tasksv1.WatchProjectsResponse_UPDATED},
+		},
+		{
+			name: "expired change token",
+			setupProjectServiceMock: func(m *mocks.ProjectService) {
+				m.On("Watch", mock.Anything, mock.Anything, mock.Anything).
+					Return(status.New(codes.FailedPrecondition, "change token expired"))
+			},
+			changeToken: "token",
+			wantCode:    codes.FailedPrecondition,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			projectServiceMock := mocks.NewProjectService(t)
+			tt.setupProjectServiceMock(projectServiceMock)
+
+			stream := &watchStream{}
+			err := projectapi.New(projectServiceMock).WatchProjects(&tasksv1.WatchProjectsRequest{ChangeToken: tt.changeToken}, stream)
+			require.Equal(t, tt.wantCode, status.Code(err))
+
+			var types []tasksv1.WatchProjectsResponse_ChangeType
+			for _, resp := range stream.sent {
+				types = append(types, resp.GetChangeType())
+			}
+			assert.Equal(t, tt.wantTypes, types)
+		})
+	}
+}
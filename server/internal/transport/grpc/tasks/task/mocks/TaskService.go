@@ -0,0 +1,284 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	status "google.golang.org/grpc/status"
+
+	taskapi "github.com/10Narratives/ready-to-do/server/internal/transport/grpc/tasks/task"
+
+	taskmodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/task"
+)
+
+// TaskService is an autogenerated mock type for the TaskService type
+type TaskService struct {
+	mock.Mock
+}
+
+// Complete provides a mock function with given fields: ctx, args
+func (_m *TaskService) Complete(ctx context.Context, args taskapi.CompleteTaskArgs) (*taskmodels.Task, *status.Status) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Complete")
+	}
+
+	var r0 *taskmodels.Task
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, taskapi.CompleteTaskArgs) (*taskmodels.Task, *status.Status)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, taskapi.CompleteTaskArgs) *taskmodels.Task); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*taskmodels.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, taskapi.CompleteTaskArgs) *status.Status); ok {
+		r1 = rf(ctx, args)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// Create provides a mock function with given fields: ctx, args
+func (_m *TaskService) Create(ctx context.Context, args taskapi.CreateTaskArgs) (*taskmodels.Task, *status.Status) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *taskmodels.Task
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, taskapi.CreateTaskArgs) (*taskmodels.Task, *status.Status)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, taskapi.CreateTaskArgs) *taskmodels.Task); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*taskmodels.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, taskapi.CreateTaskArgs) *status.Status); ok {
+		r1 = rf(ctx, args)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, args
+func (_m *TaskService) Delete(ctx context.Context, args taskapi.DeleteTaskArgs) *status.Status {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, taskapi.DeleteTaskArgs) *status.Status); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*status.Status)
+		}
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, args
+func (_m *TaskService) Get(ctx context.Context, args taskapi.GetTaskArgs) (*taskmodels.Task, *status.Status) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *taskmodels.Task
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, taskapi.GetTaskArgs) (*taskmodels.Task, *status.Status)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, taskapi.GetTaskArgs) *taskmodels.Task); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*taskmodels.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, taskapi.GetTaskArgs) *status.Status); ok {
+		r1 = rf(ctx, args)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, args
+func (_m *TaskService) List(ctx context.Context, args taskapi.ListTasksArgs) ([]*taskmodels.Task, string, *status.Status) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*taskmodels.Task
+	var r1 string
+	var r2 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, taskapi.ListTasksArgs) ([]*taskmodels.Task, string, *status.Status)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, taskapi.ListTasksArgs) []*taskmodels.Task); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*taskmodels.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, taskapi.ListTasksArgs) string); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, taskapi.ListTasksArgs) *status.Status); ok {
+		r2 = rf(ctx, args)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(*status.Status)
+		}
+	}
+
+	return r0, r1, r2
+}
+
+// Move provides a mock function with given fields: ctx, args
+func (_m *TaskService) Move(ctx context.Context, args taskapi.MoveTaskArgs) (*taskmodels.Task, *status.Status) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Move")
+	}
+
+	var r0 *taskmodels.Task
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, taskapi.MoveTaskArgs) (*taskmodels.Task, *status.Status)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, taskapi.MoveTaskArgs) *taskmodels.Task); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*taskmodels.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, taskapi.MoveTaskArgs) *status.Status); ok {
+		r1 = rf(ctx, args)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// Reopen provides a mock function with given fields: ctx, args
+func (_m *TaskService) Reopen(ctx context.Context, args taskapi.ReopenTaskArgs) (*taskmodels.Task, *status.Status) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reopen")
+	}
+
+	var r0 *taskmodels.Task
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, taskapi.ReopenTaskArgs) (*taskmodels.Task, *status.Status)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, taskapi.ReopenTaskArgs) *taskmodels.Task); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*taskmodels.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, taskapi.ReopenTaskArgs) *status.Status); ok {
+		r1 = rf(ctx, args)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, args
+func (_m *TaskService) Update(ctx context.Context, args taskapi.UpdateTaskArgs) (*taskmodels.Task, *status.Status) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *taskmodels.Task
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, taskapi.UpdateTaskArgs) (*taskmodels.Task, *status.Status)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, taskapi.UpdateTaskArgs) *taskmodels.Task); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*taskmodels.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, taskapi.UpdateTaskArgs) *status.Status); ok {
+		r1 = rf(ctx, args)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// NewTaskService creates a new instance of TaskService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTaskService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TaskService {
+	mock := &TaskService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,430 @@
+package taskapi
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	tasksv1 "github.com/10Narratives/ready-to-do/contracts/gen/go/proto/tasks/v1"
+	"github.com/10Narratives/ready-to-do/server/internal/apierror"
+	taskmodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/task"
+	"github.com/10Narratives/ready-to-do/server/internal/resourcename"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+//go:generate mockery --name TaskService --output ./mocks/
+type TaskService interface {
+	// Create creates a new OPEN task in an ACTIVE project.
+	// Returns NotFound if the project does not exist and FailedPrecondition
+	// if it is archived.
+	Create(ctx context.Context, args CreateTaskArgs) (*taskmodels.Task, *status.Status)
+
+	// Get returns the task identified by the provided arguments.
+	// Returns NotFound if the task or its project does not exist.
+	Get(ctx context.Context, args GetTaskArgs) (*taskmodels.Task, *status.Status)
+
+	// List returns one page of the tasks of a project and the token of the
+	// next page. The token is empty when there are no more tasks.
+	List(ctx context.Context, args ListTasksArgs) ([]*taskmodels.Task, string, *status.Status)
+
+	// Update changes the masked fields of a task and returns the result.
+	Update(ctx context.Context, args UpdateTaskArgs) (*taskmodels.Task, *status.Status)
+
+	// Delete permanently removes a task together with its subtasks.
+	Delete(ctx context.Context, args DeleteTaskArgs) *status.Status
+
+	// Complete moves an OPEN task to the COMPLETED state.
+	// Returns FailedPrecondition if the task is already completed.
+	Complete(ctx context.Context, args CompleteTaskArgs) (*taskmodels.Task, *status.Status)
+
+	// Reopen moves a COMPLETED task back to the OPEN state.
+	// Returns FailedPrecondition if the task is open.
+	Reopen(ctx context.Context, args ReopenTaskArgs) (*taskmodels.Task, *status.Status)
+
+	// Move reparents a task together with its subtasks.
+	// Returns FailedPrecondition if the move would create a cycle or exceed
+	// the maximum nesting depth.
+	Move(ctx context.Context, args MoveTaskArgs) (*taskmodels.Task, *status.Status)
+}
+
+type CreateTaskArgs struct {
+	ProjectID string
+	TaskID    string
+	Task      *taskmodels.Task
+}
+
+func newCreateTaskArgs(req *tasksv1.CreateTaskRequest) (CreateTaskArgs, error) {
+	projectID, err := resourcename.ParseProjectName(req.GetParent())
+	if err != nil {
+		return CreateTaskArgs{}, fmt.Errorf("cannot convert request to create task args: %w", apierror.Field("parent", err))
+	}
+	if req.GetTask() == nil {
+		return CreateTaskArgs{}, fmt.Errorf("cannot convert request to create task args: %w", apierror.Fieldf("task", "task is required"))
+	}
+	model, err := taskmodels.TaskFromGRPC(req.GetTask())
+	if err != nil {
+		return CreateTaskArgs{}, fmt.Errorf("cannot convert request to create task args: %w", apierror.Field("task", err))
+	}
+	if strings.TrimSpace(model.Title) == "" {
+		return CreateTaskArgs{}, fmt.Errorf("cannot convert request to create task args: %w", apierror.Fieldf("task.title", "title must not be empty"))
+	}
+	if model.ParentTask != "" {
+		parentProjectID, parentTaskID, err := resourcename.ParseTaskName(model.ParentTask)
+		if err != nil {
+			return CreateTaskArgs{}, fmt.Errorf("cannot convert request to create task args: %w", apierror.Field("task.parent_task", err))
+		}
+		if parentProjectID != projectID {
+			return CreateTaskArgs{}, fmt.Errorf("cannot convert request to create task args: %w", apierror.Fieldf("task.parent_task", "parent task %q belongs to another project", model.ParentTask))
+		}
+		model.ParentTask = resourcename.TaskName(parentProjectID, parentTaskID)
+	}
+	return CreateTaskArgs{
+		ProjectID: projectID,
+		TaskID:    strings.ToLower(req.GetTaskId()),
+		Task:      model,
+	}, nil
+}
+
+type GetTaskArgs struct {
+	ProjectID string
+	TaskID    string
+}
+
+func newGetTaskArgs(req *tasksv1.GetTaskRequest) (GetTaskArgs, error) {
+	projectID, taskID, err := resourcename.ParseTaskName(req.GetName())
+	if err != nil {
+		return GetTaskArgs{}, fmt.Errorf("cannot convert request to get task args: %w", apierror.Field("name", err))
+	}
+	return GetTaskArgs{
+		ProjectID: projectID,
+		TaskID:    taskID,
+	}, nil
+}
+
+type ListTasksArgs struct {
+	ProjectID string
+	PageSize  int32
+	PageToken string
+	Filter    string
+	OrderBy   taskmodels.TaskOrder
+	// Tree lists tasks in depth-first tree order.
+	Tree bool
+}
+
+func newListTasksArgs(req *tasksv1.ListTasksRequest) (ListTasksArgs, error) {
+	projectID, err := resourcename.ParseProjectName(req.GetParent())
+	if err != nil {
+		return ListTasksArgs{}, fmt.Errorf("cannot convert request to list tasks args: %w", apierror.Field("parent", err))
+	}
+	if req.GetTree() && req.GetOrderBy() != "" {
+		return ListTasksArgs{}, fmt.Errorf("cannot convert request to list tasks args: %w", apierror.Fieldf("order_by", "order_by cannot be combined with tree"))
+	}
+	order, err := taskmodels.ParseTaskOrder(req.GetOrderBy())
+	if err != nil {
+		return ListTasksArgs{}, fmt.Errorf("cannot convert request to list tasks args: %w", apierror.Field("order_by", err))
+	}
+	return ListTasksArgs{
+		ProjectID: projectID,
+		PageSize:  req.GetPageSize(),
+		PageToken: req.GetPageToken(),
+		Filter:    req.GetFilter(),
+		OrderBy:   order,
+		Tree:      req.GetTree(),
+	}, nil
+}
+
+type UpdateTaskArgs struct {
+	ProjectID string
+	TaskID    string
+	// Task holds the new values of the fields listed in Fields.
+	Task *taskmodels.Task
+	// Fields lists the task fields to update, with "*" already expanded.
+	Fields []string
+}
+
+func newUpdateTaskArgs(req *tasksv1.UpdateTaskRequest) (UpdateTaskArgs, error) {
+	projectID, taskID, err := resourcename.ParseTaskName(req.GetTask().GetName())
+	if err != nil {
+		return UpdateTaskArgs{}, fmt.Errorf("cannot convert request to update task args: %w", apierror.Field("task.name", err))
+	}
+
+	fields, err := parseUpdateMask(req.GetUpdateMask().GetPaths())
+	if err != nil {
+		return UpdateTaskArgs{}, fmt.Errorf("cannot convert request to update task args: %w", apierror.Field("update_mask", err))
+	}
+
+	model, err := taskmodels.TaskFromGRPC(req.GetTask())
+	if err != nil {
+		return UpdateTaskArgs{}, fmt.Errorf("cannot convert request to update task args: %w", apierror.Field("task", err))
+	}
+	if slices.Contains(fields, taskmodels.TitleField) && strings.TrimSpace(model.Title) == "" {
+		return UpdateTaskArgs{}, fmt.Errorf("cannot convert request to update task args: %w", apierror.Fieldf("task.title", "title must not be empty"))
+	}
+
+	return UpdateTaskArgs{
+		ProjectID: projectID,
+		TaskID:    taskID,
+		Task:      model,
+		Fields:    fields,
+	}, nil
+}
+
+// parseUpdateMask checks update mask paths against the updatable task fields
+// and expands "*" to all of them.
+func parseUpdateMask(paths []string) ([]string, error) {
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("update mask must not be empty")
+	}
+
+	fields := make([]string, 0, len(paths))
+	for _, path := range paths {
+		switch path {
+		case "*":
+			if len(paths) > 1 {
+				return nil, fmt.Errorf("update mask path \"*\" cannot be combined with other paths")
+			}
+			return slices.Clone(taskmodels.UpdatableTaskFields), nil
+		case "name", "state", "completed_at", "created_at", "updated_at", "depth":
+			return nil, fmt.Errorf("update mask path %q is output only", path)
+		case "parent_task":
+			return nil, fmt.Errorf("update mask path %q cannot be updated, use MoveTask", path)
+		}
+		if !slices.Contains(taskmodels.UpdatableTaskFields, path) {
+			return nil, fmt.Errorf("update mask path %q is unknown", path)
+		}
+		if !slices.Contains(fields, path) {
+			fields = append(fields, path)
+		}
+	}
+
+	return fields, nil
+}
+
+type DeleteTaskArgs struct {
+	ProjectID string
+	TaskID    string
+}
+
+func newDeleteTaskArgs(req *tasksv1.DeleteTaskRequest) (DeleteTaskArgs, error) {
+	projectID, taskID, err := resourcename.ParseTaskName(req.GetName())
+	if err != nil {
+		return DeleteTaskArgs{}, fmt.Errorf("cannot convert request to delete task args: %w", apierror.Field("name", err))
+	}
+	return DeleteTaskArgs{
+		ProjectID: projectID,
+		TaskID:    taskID,
+	}, nil
+}
+
+type CompleteTaskArgs struct {
+	ProjectID string
+	TaskID    string
+	// Cascade also completes the open subtasks of the task.
+	Cascade bool
+}
+
+func newCompleteTaskArgs(req *tasksv1.CompleteTaskRequest) (CompleteTaskArgs, error) {
+	projectID, taskID, err := resourcename.ParseTaskName(req.GetName())
+	if err != nil {
+		return CompleteTaskArgs{}, fmt.Errorf("cannot convert request to complete task args: %w", apierror.Field("name", err))
+	}
+	return CompleteTaskArgs{
+		ProjectID: projectID,
+		TaskID:    taskID,
+		Cascade:   req.GetCascade(),
+	}, nil
+}
+
+type ReopenTaskArgs struct {
+	ProjectID string
+	TaskID    string
+}
+
+func newReopenTaskArgs(req *tasksv1.ReopenTaskRequest) (ReopenTaskArgs, error) {
+	projectID, taskID, err := resourcename.ParseTaskName(req.GetName())
+	if err != nil {
+		return ReopenTaskArgs{}, fmt.Errorf("cannot convert request to reopen task args: %w", apierror.Field("name", err))
+	}
+	return ReopenTaskArgs{
+		ProjectID: projectID,
+		TaskID:    taskID,
+	}, nil
+}
+
+type MoveTaskArgs struct {
+	ProjectID string
+	TaskID    string
+	// DestinationProjectID is the project the task ends up in. It equals
+	// ProjectID for moves within a project.
+	DestinationProjectID string
+	// DestinationParentTaskID is the new parent task, empty for the top level.
+	DestinationParentTaskID string
+}
+
+func newMoveTaskArgs(req *tasksv1.MoveTaskRequest) (MoveTaskArgs, error) {
+	projectID, taskID, err := resourcename.ParseTaskName(req.GetName())
+	if err != nil {
+		return MoveTaskArgs{}, fmt.Errorf("cannot convert request to move task args: %w", apierror.Field("name", err))
+	}
+
+	args := MoveTaskArgs{
+		ProjectID:            projectID,
+		TaskID:               taskID,
+		DestinationProjectID: projectID,
+	}
+	if parent := req.GetDestinationParentTask(); parent != "" {
+		args.DestinationProjectID, args.DestinationParentTaskID, err = resourcename.ParseTaskName(parent)
+		if err != nil {
+			return MoveTaskArgs{}, fmt.Errorf("cannot convert request to move task args: %w", apierror.Field("destination_parent_task", err))
+		}
+	}
+	if project := req.GetDestinationProject(); project != "" {
+		destinationProjectID, err := resourcename.ParseProjectName(project)
+		if err != nil {
+			return MoveTaskArgs{}, fmt.Errorf("cannot convert request to move task args: %w", apierror.Field("destination_project", err))
+		}
+		if args.DestinationParentTaskID != "" && destinationProjectID != args.DestinationProjectID {
+			return MoveTaskArgs{}, fmt.Errorf("cannot convert request to move task args: %w", apierror.Fieldf("destination_project", "destination parent task %q is not in project %q", req.GetDestinationParentTask(), project))
+		}
+		args.DestinationProjectID = destinationProjectID
+	}
+
+	return args, nil
+}
+
+type ServerAPI struct {
+	tasksv1.UnimplementedTaskServiceServer
+	service TaskService
+}
+
+func New(service TaskService) *ServerAPI {
+	return &ServerAPI{
+		service: service,
+	}
+}
+
+func Register(server *grpc.Server, service TaskService) {
+	tasksv1.RegisterTaskServiceServer(server, &ServerAPI{service: service})
+}
+
+func (s *ServerAPI) CreateTask(ctx context.Context, req *tasksv1.CreateTaskRequest) (*tasksv1.Task, error) {
+	args, err := newCreateTaskArgs(req)
+	if err != nil {
+		return nil, apierror.InvalidRequest(err).Err()
+	}
+
+	task, stat := s.service.Create(ctx, args)
+	if stat != nil {
+		return nil, stat.Err()
+	}
+
+	return taskmodels.TaskToGRPC(task), nil
+}
+
+func (s *ServerAPI) GetTask(ctx context.Context, req *tasksv1.GetTaskRequest) (*tasksv1.Task, error) {
+	args, err := newGetTaskArgs(req)
+	if err != nil {
+		return nil, apierror.InvalidRequest(err).Err()
+	}
+
+	task, stat := s.service.Get(ctx, args)
+	if stat != nil {
+		return nil, stat.Err()
+	}
+
+	return taskmodels.TaskToGRPC(task), nil
+}
+
+func (s *ServerAPI) ListTasks(ctx context.Context, req *tasksv1.ListTasksRequest) (*tasksv1.ListTasksResponse, error) {
+	args, err := newListTasksArgs(req)
+	if err != nil {
+		return nil, apierror.InvalidRequest(err).Err()
+	}
+
+	tasks, nextPageToken, stat := s.service.List(ctx, args)
+	if stat != nil {
+		return nil, stat.Err()
+	}
+
+	resp := &tasksv1.ListTasksResponse{
+		Tasks:         make([]*tasksv1.Task, 0, len(tasks)),
+		NextPageToken: nextPageToken,
+	}
+	for _, task := range tasks {
+		resp.Tasks = append(resp.Tasks, taskmodels.TaskToGRPC(task))
+	}
+
+	return resp, nil
+}
+
+func (s *ServerAPI) UpdateTask(ctx context.Context, req *tasksv1.UpdateTaskRequest) (*tasksv1.Task, error) {
+	args, err := newUpdateTaskArgs(req)
+	if err != nil {
+		return nil, apierror.InvalidRequest(err).Err()
+	}
+
+	task, stat := s.service.Update(ctx, args)
+	if stat != nil {
+		return nil, stat.Err()
+	}
+
+	return taskmodels.TaskToGRPC(task), nil
+}
+
+func (s *ServerAPI) DeleteTask(ctx context.Context, req *tasksv1.DeleteTaskRequest) (*emptypb.Empty, error) {
+	args, err := newDeleteTaskArgs(req)
+	if err != nil {
+		return nil, apierror.InvalidRequest(err).Err()
+	}
+
+	if stat := s.service.Delete(ctx, args); stat != nil {
+		return nil, stat.Err()
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ServerAPI) CompleteTask(ctx context.Context, req *tasksv1.CompleteTaskRequest) (*tasksv1.Task, error) {
+	args, err := newCompleteTaskArgs(req)
+	if err != nil {
+		return nil, apierror.InvalidRequest(err).Err()
+	}
+
+	task, stat := s.service.Complete(ctx, args)
+	if stat != nil {
+		return nil, stat.Err()
+	}
+
+	return taskmodels.TaskToGRPC(task), nil
+}
+
+func (s *ServerAPI) ReopenTask(ctx context.Context, req *tasksv1.ReopenTaskRequest) (*tasksv1.Task, error) {
+	args, err := newReopenTaskArgs(req)
+	if err != nil {
+		return nil, apierror.InvalidRequest(err).Err()
+	}
+
+	task, stat := s.service.Reopen(ctx, args)
+	if stat != nil {
+		return nil, stat.Err()
+	}
+
+	return taskmodels.TaskToGRPC(task), nil
+}
+
+func (s *ServerAPI) MoveTask(ctx context.Context, req *tasksv1.MoveTaskRequest) (*tasksv1.Task, error) {
+	args, err := newMoveTaskArgs(req)
+	if err != nil {
+		return nil, apierror.InvalidRequest(err).Err()
+	}
+
+	task, stat := s.service.Move(ctx, args)
+	if stat != nil {
+		return nil, stat.Err()
+	}
+
+	return taskmodels.TaskToGRPC(task), nil
+}
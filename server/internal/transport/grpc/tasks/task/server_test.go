@@ -0,0 +1,494 @@
+package taskapi_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	tasksv1 "github.com/10Narratives/ready-to-do/contracts/gen/go/proto/tasks/v1"
+	taskmodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/task"
+	taskapi "github.com/10Narratives/ready-to-do/server/internal/transport/grpc/tasks/task"
+	"github.com/10Narratives/ready-to-do/server/internal/transport/grpc/tasks/task/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	projectID = "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"
+	taskID    = "b1eebc99-9c0b-4ef8-bb6d-6bb9bd380a22"
+	taskName  = "projects/" + projectID + "/tasks/" + taskID
+)
+
+func TestServerAPI_CreateTask(t *testing.T) {
+	t.Parallel()
+
+	const parentID = "c2eebc99-9c0b-4ef8-bb6d-6bb9bd380a33"
+
+	due := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	created := &taskmodels.Task{
+		Name:        taskName,
+		Title:       "write tests",
+		DueTime:     due,
+		DueTimeZone: "Europe/Berlin",
+		Priority:    taskmodels.HighTaskPriority,
+		State:       taskmodels.OpenTaskState,
+		CreatedAt:   time.Now().UTC(),
+		UpdatedAt:   time.Now().UTC(),
+	}
+
+	tests := []struct {
+		name                 string
+		setupTaskServiceMock func(m *mocks.TaskService)
+		req                  *tasksv1.CreateTaskRequest
+		wantCode             codes.Code
+	}{
+		{
+			name: "successful execution",
+			setupTaskServiceMock: func(m *mocks.TaskService) {
+				m.On("Create", mock.Anything, taskapi.CreateTaskArgs{
+					ProjectID: projectID,
+					TaskID:    taskID,
+					Task: &taskmodels.Task{
+						Title:       "write tests",
+						DueTime:     due,
+						DueTimeZone: "Europe/Berlin",
+						Priority:    taskmodels.HighTaskPriority,
+					},
+				}).Return(created, nil)
+			},
+			req: &tasksv1.CreateTaskRequest{
+				Parent: "projects/" + projectID,
+				TaskId: taskID,
+				Task: &tasksv1.Task{
+					Title:       "write tests",
+					DueTime:     timestamppb.New(due),
+					DueTimeZone: "Europe/Berlin",
+					Priority:    tasksv1.Task_HIGH,
+				},
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "upper-case ids",
+			setupTaskServiceMock: func(m *mocks.TaskService) {
+				m.On("Create", mock.Anything, taskapi.CreateTaskArgs{
+					ProjectID: projectID,
+					TaskID:    taskID,
+					Task: &taskmodels.Task{
+						Title:      "write tests",
+						ParentTask: "projects/" + projectID + "/tasks/" + parentID,
+					},
+				}).Return(created, nil)
+			},
+			req: &tasksv1.CreateTaskRequest{
+				Parent: "projects/" + strings.ToUpper(projectID),
+				TaskId: strings.ToUpper(taskID),
+				Task: &tasksv1.Task{
+					Title:      "write tests",
+					ParentTask: "projects/" + strings.ToUpper(projectID) + "/tasks/" + strings.ToUpper(parentID),
+				},
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:                 "empty title",
+			setupTaskServiceMock: func(m *mocks.TaskService) {},
+			req: &tasksv1.CreateTaskRequest{
+				Parent: "projects/" + projectID,
+				TaskId: taskID,
+				Task:   &tasksv1.Task{Title: "  "},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:                 "missing task",
+			setupTaskServiceMock: func(m *mocks.TaskService) {},
+			req: &tasksv1.CreateTaskRequest{
+				Parent: "projects/" + projectID,
+				TaskId: taskID,
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:                 "unknown time zone",
+			setupTaskServiceMock: func(m *mocks.TaskService) {},
+			req: &tasksv1.CreateTaskRequest{
+				Parent: "projects/" + projectID,
+				TaskId: taskID,
+				Task:   &tasksv1.Task{Title: "write tests", DueTimeZone: "Mars/Olympus"},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:                 "parent task in another project",
+			setupTaskServiceMock: func(m *mocks.TaskService) {},
+			req: &tasksv1.CreateTaskRequest{
+				Parent: "projects/" + projectID,
+				TaskId: taskID,
+				Task: &tasksv1.Task{
+					Title:      "write tests",
+					ParentTask: "projects/d3eebc99-9c0b-4ef8-bb6d-6bb9bd380a44/tasks/" + taskID,
+				},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:                 "invalid parent",
+			setupTaskServiceMock: func(m *mocks.TaskService) {},
+			req: &tasksv1.CreateTaskRequest{
+				Parent: "projects/1",
+				TaskId: taskID,
+				Task:   &tasksv1.Task{Title: "write tests"},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "archived project",
+			setupTaskServiceMock: func(m *mocks.TaskService) {
+				m.On("Create", mock.Anything, mock.Anything).Return(nil, status.New(codes.FailedPrecondition, "project is archived"))
+			},
+			req: &tasksv1.CreateTaskRequest{
+				Parent: "projects/" + projectID,
+				TaskId: taskID,
+				Task:   &tasksv1.Task{Title: "write tests"},
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			taskServiceMock := mocks.NewTaskService(t)
+			tt.setupTaskServiceMock(taskServiceMock)
+
+			resp, err := taskapi.New(taskServiceMock).CreateTask(context.Background(), tt.req)
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, taskName, resp.GetName())
+				assert.Equal(t, tasksv1.Task_OPEN, resp.GetState())
+				assert.Nil(t, resp.GetCompletedAt())
+			}
+		})
+	}
+}
+
+func TestServerAPI_GetTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                 string
+		setupTaskServiceMock func(m *mocks.TaskService)
+		req                  *tasksv1.GetTaskRequest
+		wantCode             codes.Code
+	}{
+		{
+			name: "successful execution",
+			setupTaskServiceMock: func(m *mocks.TaskService) {
+				m.On("Get", mock.Anything, taskapi.GetTaskArgs{ProjectID: projectID, TaskID: taskID}).
+					Return(&taskmodels.Task{Name: taskName}, nil)
+			},
+			req:      &tasksv1.GetTaskRequest{Name: taskName},
+			wantCode: codes.OK,
+		},
+		{
+			name:                 "project name instead of task name",
+			setupTaskServiceMock: func(m *mocks.TaskService) {},
+			req:                  &tasksv1.GetTaskRequest{Name: "projects/" + projectID},
+			wantCode:             codes.InvalidArgument,
+		},
+		{
+			name: "task not found",
+			setupTaskServiceMock: func(m *mocks.TaskService) {
+				m.On("Get", mock.Anything, mock.Anything).Return(nil, status.New(codes.NotFound, "task not found"))
+			},
+			req:      &tasksv1.GetTaskRequest{Name: taskName},
+			wantCode: codes.NotFound,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			taskServiceMock := mocks.NewTaskService(t)
+			tt.setupTaskServiceMock(taskServiceMock)
+
+			resp, err := taskapi.New(taskServiceMock).GetTask(context.Background(), tt.req)
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, taskName, resp.GetName())
+			}
+		})
+	}
+}
+
+func TestServerAPI_ListTasks(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                 string
+		setupTaskServiceMock func(m *mocks.TaskService)
+		req                  *tasksv1.ListTasksRequest
+		wantCode             codes.Code
+	}{
+		{
+			name: "successful execution",
+			setupTaskServiceMock: func(m *mocks.TaskService) {
+				m.On("List", mock.Anything, taskapi.ListTasksArgs{
+					ProjectID: projectID,
+					PageSize:  10,
+					Filter:    "state = OPEN",
+					OrderBy:   taskmodels.TaskOrder{Field: taskmodels.OrderByDueTime, Desc: true},
+				}).Return([]*taskmodels.Task{{Name: taskName}}, "next", nil)
+			},
+			req: &tasksv1.ListTasksRequest{
+				Parent:   "projects/" + projectID,
+				PageSize: 10,
+				Filter:   "state = OPEN",
+				OrderBy:  "due_time desc",
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:                 "tree with order",
+			setupTaskServiceMock: func(m *mocks.TaskService) {},
+			req: &tasksv1.ListTasksRequest{
+				Parent:  "projects/" + projectID,
+				OrderBy: "title",
+				Tree:    true,
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:                 "unsupported order",
+			setupTaskServiceMock: func(m *mocks.TaskService) {},
+			req: &tasksv1.ListTasksRequest{
+				Parent:  "projects/" + projectID,
+				OrderBy: "state",
+			},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			taskServiceMock := mocks.NewTaskService(t)
+			tt.setupTaskServiceMock(taskServiceMock)
+
+			resp, err := taskapi.New(taskServiceMock).ListTasks(context.Background(), tt.req)
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				require.Len(t, resp.GetTasks(), 1)
+				assert.Equal(t, "next", resp.GetNextPageToken())
+			}
+		})
+	}
+}
+
+func TestServerAPI_UpdateTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                 string
+		setupTaskServiceMock func(m *mocks.TaskService)
+		paths                []string
+		task                 *tasksv1.Task
+		wantCode             codes.Code
+	}{
+		{
+			name: "successful execution",
+			setupTaskServiceMock: func(m *mocks.TaskService) {
+				m.On("Update", mock.Anything, taskapi.UpdateTaskArgs{
+					ProjectID: projectID,
+					TaskID:    taskID,
+					Task:      &taskmodels.Task{Name: taskName, Title: "renamed"},
+					Fields:    []string{taskmodels.TitleField},
+				}).Return(&taskmodels.Task{Name: taskName, Title: "renamed"}, nil)
+			},
+			paths:    []string{"title"},
+			task:     &tasksv1.Task{Name: taskName, Title: "renamed"},
+			wantCode: codes.OK,
+		},
+		{
+			name: "wildcard expands to all updatable fields",
+			setupTaskServiceMock: func(m *mocks.TaskService) {
+				m.On("Update", mock.Anything, mock.MatchedBy(func(args taskapi.UpdateTaskArgs) bool {
+					return assert.ObjectsAreEqual(taskmodels.UpdatableTaskFields, args.Fields)
+				})).Return(&taskmodels.Task{Name: taskName}, nil)
+			},
+			paths:    []string{"*"},
+			task:     &tasksv1.Task{Name: taskName, Title: "renamed"},
+			wantCode: codes.OK,
+		},
+		{
+			name:                 "output only path",
+			setupTaskServiceMock: func(m *mocks.TaskService) {},
+			paths:                []string{"state"},
+			task:                 &tasksv1.Task{Name: taskName},
+			wantCode:             codes.InvalidArgument,
+		},
+		{
+			name:                 "clearing the title",
+			setupTaskServiceMock: func(m *mocks.TaskService) {},
+			paths:                []string{"title"},
+			task:                 &tasksv1.Task{Name: taskName},
+			wantCode:             codes.InvalidArgument,
+		},
+		{
+			name:                 "unknown path",
+			setupTaskServiceMock: func(m *mocks.TaskService) {},
+			paths:                []string{"owner"},
+			task:                 &tasksv1.Task{Name: taskName},
+			wantCode:             codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			taskServiceMock := mocks.NewTaskService(t)
+			tt.setupTaskServiceMock(taskServiceMock)
+
+			_, err := taskapi.New(taskServiceMock).UpdateTask(context.Background(), &tasksv1.UpdateTaskRequest{
+				Task:       tt.task,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: tt.paths},
+			})
+			require.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestServerAPI_CompleteTask(t *testing.T) {
+	t.Parallel()
+
+	completedAt := time.Now().UTC()
+
+	tests := []struct {
+		name                 string
+		setupTaskServiceMock func(m *mocks.TaskService)
+		wantCode             codes.Code
+	}{
+		{
+			name: "successful execution",
+			setupTaskServiceMock: func(m *mocks.TaskService) {
+				m.On("Complete", mock.Anything, taskapi.CompleteTaskArgs{ProjectID: projectID, TaskID: taskID}).
+					Return(&taskmodels.Task{Name: taskName, State: taskmodels.CompletedTaskState, CompletedAt: completedAt}, nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "task already completed",
+			setupTaskServiceMock: func(m *mocks.TaskService) {
+				m.On("Complete", mock.Anything, mock.Anything).
+					Return(nil, status.New(codes.FailedPrecondition, "task is COMPLETED, expected OPEN"))
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			taskServiceMock := mocks.NewTaskService(t)
+			tt.setupTaskServiceMock(taskServiceMock)
+
+			resp, err := taskapi.New(taskServiceMock).CompleteTask(context.Background(), &tasksv1.CompleteTaskRequest{Name: taskName})
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, tasksv1.Task_COMPLETED, resp.GetState())
+				assert.Equal(t, completedAt, resp.GetCompletedAt().AsTime())
+			}
+		})
+	}
+}
+
+func TestServerAPI_MoveTask(t *testing.T) {
+	t.Parallel()
+
+	const (
+		parentID             = "c2eebc99-9c0b-4ef8-bb6d-6bb9bd380a33"
+		destinationProjectID = "d3eebc99-9c0b-4ef8-bb6d-6bb9bd380a44"
+	)
+
+	tests := []struct {
+		name                 string
+		setupTaskServiceMock func(m *mocks.TaskService)
+		req                  *tasksv1.MoveTaskRequest
+		wantCode             codes.Code
+	}{
+		{
+			name: "to the top level",
+			setupTaskServiceMock: func(m *mocks.TaskService) {
+				m.On("Move", mock.Anything, taskapi.MoveTaskArgs{
+					ProjectID:            projectID,
+					TaskID:               taskID,
+					DestinationProjectID: projectID,
+				}).Return(&taskmodels.Task{Name: taskName}, nil)
+			},
+			req:      &tasksv1.MoveTaskRequest{Name: taskName},
+			wantCode: codes.OK,
+		},
+		{
+			name: "under a task of another project",
+			setupTaskServiceMock: func(m *mocks.TaskService) {
+				m.On("Move", mock.Anything, taskapi.MoveTaskArgs{
+					ProjectID:               projectID,
+					TaskID:                  taskID,
+					DestinationProjectID:    destinationProjectID,
+					DestinationParentTaskID: parentID,
+				}).Return(&taskmodels.Task{Name: "projects/" + destinationProjectID + "/tasks/" + taskID}, nil)
+			},
+			req: &tasksv1.MoveTaskRequest{
+				Name:                  taskName,
+				DestinationParentTask: "projects/" + destinationProjectID + "/tasks/" + parentID,
+				DestinationProject:    "projects/" + destinationProjectID,
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:                 "parent outside the destination project",
+			setupTaskServiceMock: func(m *mocks.TaskService) {},
+			req: &tasksv1.MoveTaskRequest{
+				Name:                  taskName,
+				DestinationParentTask: "projects/" + projectID + "/tasks/" + parentID,
+				DestinationProject:    "projects/" + destinationProjectID,
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "cycle",
+			setupTaskServiceMock: func(m *mocks.TaskService) {
+				m.On("Move", mock.Anything, mock.Anything).Return(nil, status.New(codes.FailedPrecondition, "task cannot be moved under its subtask"))
+			},
+			req: &tasksv1.MoveTaskRequest{
+				Name:                  taskName,
+				DestinationParentTask: "projects/" + projectID + "/tasks/" + parentID,
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			taskServiceMock := mocks.NewTaskService(t)
+			tt.setupTaskServiceMock(taskServiceMock)
+
+			_, err := taskapi.New(taskServiceMock).MoveTask(context.Background(), tt.req)
+			require.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	status "google.golang.org/grpc/status"
+
+	userapi "github.com/10Narratives/ready-to-do/server/internal/transport/grpc/tasks/user"
+
+	usermodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/user"
+)
+
+// UserService is an autogenerated mock type for the UserService type
+type UserService struct {
+	mock.Mock
+}
+
+// Get provides a mock function with given fields: ctx, args
+func (_m *UserService) Get(ctx context.Context, args userapi.GetUserArgs) (*usermodels.User, *status.Status) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *usermodels.User
+	var r1 *status.Status
+	if rf, ok := ret.Get(0).(func(context.Context, userapi.GetUserArgs) (*usermodels.User, *status.Status)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, userapi.GetUserArgs) *usermodels.User); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usermodels.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, userapi.GetUserArgs) *status.Status); ok {
+		r1 = rf(ctx, args)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*status.Status)
+		}
+	}
+
+	return r0, r1
+}
+
+// NewUserService creates a new instance of UserService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUserService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UserService {
+	mock := &UserService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
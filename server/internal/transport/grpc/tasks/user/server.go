@@ -0,0 +1,71 @@
+package userapi
+
+import (
+	"context"
+	"fmt"
+
+	tasksv1 "github.com/10Narratives/ready-to-do/contracts/gen/go/proto/tasks/v1"
+	"github.com/10Narratives/ready-to-do/server/internal/apierror"
+	usermodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/user"
+	"github.com/10Narratives/ready-to-do/server/internal/resourcename"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// MeUserID is the user ID of "users/me", which names the caller.
+const MeUserID = "me"
+
+//go:generate mockery --name UserService --output ./mocks/
+type UserService interface {
+	// Get returns the user identified by the provided arguments.
+	// Returns PermissionDenied for users other than the caller.
+	Get(ctx context.Context, args GetUserArgs) (*usermodels.User, *status.Status)
+}
+
+type GetUserArgs struct {
+	// UserID is the ID of the user, or MeUserID for the caller.
+	UserID string
+}
+
+func newGetUserArgs(req *tasksv1.GetUserRequest) (GetUserArgs, error) {
+	if req.GetName() == resourcename.User.Format(MeUserID) {
+		return GetUserArgs{UserID: MeUserID}, nil
+	}
+
+	userID, err := resourcename.ParseUserName(req.GetName())
+	if err != nil {
+		return GetUserArgs{}, fmt.Errorf("cannot convert request to get user args: %w", apierror.Field("name", err))
+	}
+	return GetUserArgs{
+		UserID: userID,
+	}, nil
+}
+
+type ServerAPI struct {
+	tasksv1.UnimplementedUserServiceServer
+	service UserService
+}
+
+func New(service UserService) *ServerAPI {
+	return &ServerAPI{
+		service: service,
+	}
+}
+
+func Register(server *grpc.Server, service UserService) {
+	tasksv1.RegisterUserServiceServer(server, &ServerAPI{service: service})
+}
+
+func (s *ServerAPI) GetUser(ctx context.Context, req *tasksv1.GetUserRequest) (*tasksv1.User, error) {
+	args, err := newGetUserArgs(req)
+	if err != nil {
+		return nil, apierror.InvalidRequest(err).Err()
+	}
+
+	user, stat := s.service.Get(ctx, args)
+	if stat != nil {
+		return nil, stat.Err()
+	}
+
+	return usermodels.UserToGRPC(user), nil
+}
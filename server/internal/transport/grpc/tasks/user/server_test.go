@@ -0,0 +1,77 @@
+package userapi_test
+
+import (
+	"context"
+	"testing"
+
+	tasksv1 "github.com/10Narratives/ready-to-do/contracts/gen/go/proto/tasks/v1"
+	usermodels "github.com/10Narratives/ready-to-do/server/internal/models/tasks/user"
+	userapi "github.com/10Narratives/ready-to-do/server/internal/transport/grpc/tasks/user"
+	"github.com/10Narratives/ready-to-do/server/internal/transport/grpc/tasks/user/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServerAPI_GetUser(t *testing.T) {
+	t.Parallel()
+
+	const userID = "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"
+
+	tests := []struct {
+		name                 string
+		setupUserServiceMock func(m *mocks.UserService)
+		req                  *tasksv1.GetUserRequest
+		wantCode             codes.Code
+	}{
+		{
+			name: "me",
+			setupUserServiceMock: func(m *mocks.UserService) {
+				m.On("Get", context.Background(), userapi.GetUserArgs{UserID: userapi.MeUserID}).
+					Return(&usermodels.User{Name: "users/" + userID}, nil)
+			},
+			req:      &tasksv1.GetUserRequest{Name: "users/me"},
+			wantCode: codes.OK,
+		},
+		{
+			name: "user id",
+			setupUserServiceMock: func(m *mocks.UserService) {
+				m.On("Get", context.Background(), userapi.GetUserArgs{UserID: userID}).
+					Return(&usermodels.User{Name: "users/" + userID}, nil)
+			},
+			req:      &tasksv1.GetUserRequest{Name: "users/" + userID},
+			wantCode: codes.OK,
+		},
+		{
+			name:                 "invalid name",
+			setupUserServiceMock: func(m *mocks.UserService) {},
+			req:                  &tasksv1.GetUserRequest{Name: "users/alice"},
+			wantCode:             codes.InvalidArgument,
+		},
+		{
+			name: "service status is passed up",
+			setupUserServiceMock: func(m *mocks.UserService) {
+				m.On("Get", context.Background(), userapi.GetUserArgs{UserID: userID}).
+					Return(nil, status.New(codes.PermissionDenied, "permission denied"))
+			},
+			req:      &tasksv1.GetUserRequest{Name: "users/" + userID},
+			wantCode: codes.PermissionDenied,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			userServiceMock := mocks.NewUserService(t)
+			tt.setupUserServiceMock(userServiceMock)
+
+			resp, err := userapi.New(userServiceMock).GetUser(context.Background(), tt.req)
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, "users/"+userID, resp.GetName())
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+-- Databases initialized before migrations existed already have the table,
+-- so every statement tolerates existing objects.
+CREATE TABLE IF NOT EXISTS projects (
+    name TEXT PRIMARY KEY,
+    display_name TEXT,
+    description TEXT,
+    color_tag TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    state INT
+);
+
+ALTER TABLE projects ADD COLUMN IF NOT EXISTS delete_time TIMESTAMP;
+ALTER TABLE projects ADD COLUMN IF NOT EXISTS purge_time TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS projects_purge_time_idx ON projects (purge_time)
+    WHERE purge_time IS NOT NULL;
@@ -0,0 +1,15 @@
+CREATE TABLE tasks (
+    name TEXT PRIMARY KEY,
+    project TEXT NOT NULL REFERENCES projects (name) ON DELETE CASCADE,
+    title TEXT NOT NULL,
+    notes TEXT NOT NULL DEFAULT '',
+    due_time TIMESTAMP,
+    due_time_zone TEXT NOT NULL DEFAULT '',
+    priority INT NOT NULL DEFAULT 0,
+    state INT NOT NULL,
+    completed_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX tasks_project_created_at_idx ON tasks (project, created_at, name);
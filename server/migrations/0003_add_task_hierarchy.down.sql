@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS tasks_parent_idx;
+DROP INDEX IF EXISTS tasks_project_path_idx;
+
+ALTER TABLE tasks
+    DROP COLUMN IF EXISTS path,
+    DROP COLUMN IF EXISTS parent;
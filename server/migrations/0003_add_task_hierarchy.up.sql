@@ -0,0 +1,12 @@
+-- parent links subtasks to their parent task. path holds the keys of the
+-- ancestors of a task followed by its own key, so sorting by path lists every
+-- task right before its subtasks. Keys must match pathKey in taskstore.
+ALTER TABLE tasks
+    ADD COLUMN parent TEXT CONSTRAINT tasks_parent_fkey REFERENCES tasks (name) ON DELETE CASCADE,
+    ADD COLUMN path TEXT[] NOT NULL DEFAULT '{}';
+
+UPDATE tasks
+SET path = ARRAY[to_char(created_at, 'YYYYMMDDHH24MISS.US') || '/' || split_part(name, '/', 4)];
+
+CREATE INDEX tasks_project_path_idx ON tasks (project, path);
+CREATE INDEX tasks_parent_idx ON tasks (parent);
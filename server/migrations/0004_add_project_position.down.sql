@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS projects_position_idx;
+
+ALTER TABLE projects DROP COLUMN IF EXISTS position;
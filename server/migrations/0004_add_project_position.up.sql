@@ -0,0 +1,16 @@
+-- position holds the rank of a project in the manual order (see package
+-- rank). Ranks must compare byte-wise, hence the "C" collation. Existing
+-- projects are ranked in creation order.
+ALTER TABLE projects ADD COLUMN position TEXT COLLATE "C" NOT NULL DEFAULT '';
+
+UPDATE projects
+SET position = ranked.position
+FROM (
+    SELECT name, lpad(row_number() OVER (ORDER BY created_at, name)::text, 10, '0') || 'V' AS position
+    FROM projects
+) AS ranked
+WHERE projects.name = ranked.name;
+
+ALTER TABLE projects ALTER COLUMN position DROP DEFAULT;
+
+CREATE INDEX projects_position_idx ON projects (position, name);
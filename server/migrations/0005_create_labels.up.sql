@@ -0,0 +1,26 @@
+-- Assignments reference labels, projects and tasks by name. Deleting any of
+-- them removes its assignments, and renaming a task when it moves to another
+-- project renames its assignments.
+CREATE TABLE labels (
+    name TEXT PRIMARY KEY,
+    display_name TEXT NOT NULL DEFAULT '',
+    color TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE project_labels (
+    project TEXT NOT NULL REFERENCES projects (name) ON DELETE CASCADE ON UPDATE CASCADE,
+    label TEXT NOT NULL REFERENCES labels (name) ON DELETE CASCADE ON UPDATE CASCADE,
+    PRIMARY KEY (project, label)
+);
+
+CREATE INDEX project_labels_label_idx ON project_labels (label);
+
+CREATE TABLE task_labels (
+    task TEXT NOT NULL REFERENCES tasks (name) ON DELETE CASCADE ON UPDATE CASCADE,
+    label TEXT NOT NULL REFERENCES labels (name) ON DELETE CASCADE ON UPDATE CASCADE,
+    PRIMARY KEY (task, label)
+);
+
+CREATE INDEX task_labels_label_idx ON task_labels (label);
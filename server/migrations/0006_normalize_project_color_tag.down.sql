@@ -0,0 +1,3 @@
+-- Normalization discards the original spelling of color tags, so reverting
+-- keeps the normalized values.
+SELECT 1;
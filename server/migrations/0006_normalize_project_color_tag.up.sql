@@ -0,0 +1,30 @@
+-- Brings stored color tags to the canonical #rrggbb form of
+-- projectmodels.NormalizeColor. Tags that are not colors are cleared. The
+-- palette must match projectmodels.ColorPalette.
+WITH palette (name, hex) AS (
+    VALUES
+        ('red', '#ef4444'),
+        ('orange', '#f97316'),
+        ('yellow', '#eab308'),
+        ('green', '#22c55e'),
+        ('teal', '#14b8a6'),
+        ('blue', '#3b82f6'),
+        ('purple', '#a855f7'),
+        ('pink', '#ec4899'),
+        ('brown', '#92400e'),
+        ('gray', '#6b7280')
+),
+tags AS (
+    SELECT name, lower(btrim(color_tag)) AS tag FROM projects WHERE color_tag IS NOT NULL
+)
+UPDATE projects
+SET color_tag = CASE
+    WHEN tags.tag ~ '^#[0-9a-f]{6}([0-9a-f]{2})?$' THEN substr(tags.tag, 1, 7)
+    WHEN tags.tag ~ '^#[0-9a-f]{3}$' THEN '#'
+        || repeat(substr(tags.tag, 2, 1), 2)
+        || repeat(substr(tags.tag, 3, 1), 2)
+        || repeat(substr(tags.tag, 4, 1), 2)
+    ELSE COALESCE((SELECT hex FROM palette WHERE palette.name = tags.tag), '')
+END
+FROM tags
+WHERE projects.name = tags.name;
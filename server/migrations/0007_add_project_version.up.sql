@@ -0,0 +1,3 @@
+-- version is incremented by every change to a project; the etag of the
+-- project is derived from it.
+ALTER TABLE projects ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
@@ -0,0 +1,12 @@
+-- idempotency_keys remembers requests that carried a request ID. A row
+-- without a response is reserved by a request that is still running and its
+-- expire_time ends the reservation; completed rows keep the response until
+-- expire_time.
+CREATE TABLE idempotency_keys (
+    key TEXT PRIMARY KEY,
+    request_hash TEXT NOT NULL,
+    response JSONB,
+    expire_time TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idempotency_keys_expire_time_idx ON idempotency_keys (expire_time);
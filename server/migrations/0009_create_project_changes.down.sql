@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS projects_record_change_update ON projects;
+DROP TRIGGER IF EXISTS projects_record_change_insert_delete ON projects;
+DROP FUNCTION IF EXISTS record_project_change();
+DROP TABLE IF EXISTS project_changes;
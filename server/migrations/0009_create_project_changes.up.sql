@@ -0,0 +1,41 @@
+-- project_changes is the change log WatchProjects streams from. Rows are
+-- written by a trigger in the transaction that changes the project, so no
+-- change can be lost. Transactions commit out of order, therefore seq is
+-- assigned after commit by readers, under an advisory lock and in id order;
+-- rows with a seq are never renumbered and seqs have no gaps.
+CREATE TABLE project_changes (
+    id BIGSERIAL PRIMARY KEY,
+    seq BIGINT UNIQUE,
+    project TEXT NOT NULL,
+    kind SMALLINT NOT NULL,
+    change_time TIMESTAMP NOT NULL DEFAULT (now() AT TIME ZONE 'UTC'),
+    sequence_time TIMESTAMP
+);
+
+CREATE INDEX project_changes_unsequenced_idx ON project_changes (id) WHERE seq IS NULL;
+
+-- kind: 1 created, 2 updated, 3 deleted. Soft deletion counts as deletion.
+CREATE FUNCTION record_project_change() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        INSERT INTO project_changes (project, kind) VALUES (NEW.name, 1);
+    ELSIF TG_OP = 'DELETE' THEN
+        INSERT INTO project_changes (project, kind) VALUES (OLD.name, 3);
+    ELSIF NEW.state = 3 AND OLD.state IS DISTINCT FROM 3 THEN
+        INSERT INTO project_changes (project, kind) VALUES (NEW.name, 3);
+    ELSE
+        INSERT INTO project_changes (project, kind) VALUES (NEW.name, 2);
+    END IF;
+    PERFORM pg_notify('project_changes', '');
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER projects_record_change_insert_delete
+    AFTER INSERT OR DELETE ON projects
+    FOR EACH ROW EXECUTE FUNCTION record_project_change();
+
+CREATE TRIGGER projects_record_change_update
+    AFTER UPDATE ON projects
+    FOR EACH ROW WHEN (OLD.* IS DISTINCT FROM NEW.*)
+    EXECUTE FUNCTION record_project_change();
@@ -0,0 +1,27 @@
+DROP POLICY IF EXISTS project_changes_tenant_isolation ON project_changes;
+ALTER TABLE project_changes NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE project_changes DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS projects_tenant_isolation ON projects;
+ALTER TABLE projects NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE projects DISABLE ROW LEVEL SECURITY;
+
+CREATE OR REPLACE FUNCTION record_project_change() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        INSERT INTO project_changes (project, kind) VALUES (NEW.name, 1);
+    ELSIF TG_OP = 'DELETE' THEN
+        INSERT INTO project_changes (project, kind) VALUES (OLD.name, 3);
+    ELSIF NEW.state = 3 AND OLD.state IS DISTINCT FROM 3 THEN
+        INSERT INTO project_changes (project, kind) VALUES (NEW.name, 3);
+    ELSE
+        INSERT INTO project_changes (project, kind) VALUES (NEW.name, 2);
+    END IF;
+    PERFORM pg_notify('project_changes', '');
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+ALTER TABLE project_changes DROP COLUMN IF EXISTS owner;
+DROP INDEX IF EXISTS projects_owner_position_idx;
+ALTER TABLE projects DROP COLUMN IF EXISTS owner;
@@ -0,0 +1,45 @@
+-- owner is the user a project belongs to. Projects without an owner, those
+-- created before projects had owners or while authentication is disabled, are
+-- shared with every user.
+ALTER TABLE projects ADD COLUMN owner TEXT REFERENCES users (name);
+
+CREATE INDEX projects_owner_position_idx ON projects (owner, position, name);
+
+-- Changes carry the owner of their project, so that watchers only see the
+-- changes of projects visible to them, deletions included.
+ALTER TABLE project_changes ADD COLUMN owner TEXT;
+
+CREATE OR REPLACE FUNCTION record_project_change() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        INSERT INTO project_changes (project, owner, kind) VALUES (NEW.name, NEW.owner, 1);
+    ELSIF TG_OP = 'DELETE' THEN
+        INSERT INTO project_changes (project, owner, kind) VALUES (OLD.name, OLD.owner, 3);
+    ELSIF NEW.state = 3 AND OLD.state IS DISTINCT FROM 3 THEN
+        INSERT INTO project_changes (project, owner, kind) VALUES (NEW.name, NEW.owner, 3);
+    ELSE
+        INSERT INTO project_changes (project, owner, kind) VALUES (NEW.name, NEW.owner, 2);
+    END IF;
+    PERFORM pg_notify('project_changes', '');
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Row level security backs up the tenant filters of the storages: a
+-- transaction only sees and writes the shared rows and those of the tenant in
+-- its app.tenant setting, or all rows if the setting is '*'. The policies are
+-- forced, so they apply to the owner of the tables as well; only superusers
+-- and roles with BYPASSRLS are exempt.
+ALTER TABLE projects ENABLE ROW LEVEL SECURITY;
+ALTER TABLE projects FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY projects_tenant_isolation ON projects
+    USING (owner IS NULL OR owner = current_setting('app.tenant', true) OR current_setting('app.tenant', true) = '*')
+    WITH CHECK (owner IS NULL OR owner = current_setting('app.tenant', true) OR current_setting('app.tenant', true) = '*');
+
+ALTER TABLE project_changes ENABLE ROW LEVEL SECURITY;
+ALTER TABLE project_changes FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY project_changes_tenant_isolation ON project_changes
+    USING (owner IS NULL OR owner = current_setting('app.tenant', true) OR current_setting('app.tenant', true) = '*')
+    WITH CHECK (owner IS NULL OR owner = current_setting('app.tenant', true) OR current_setting('app.tenant', true) = '*');
@@ -0,0 +1,2 @@
+ALTER TABLE tasks
+    DROP CONSTRAINT IF EXISTS tasks_parent_project_check;
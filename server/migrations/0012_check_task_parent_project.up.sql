@@ -0,0 +1,6 @@
+-- tasks_parent_fkey only references tasks (name), so nothing kept a subtask
+-- and its parent in the same project. Rows written before this check are
+-- not validated, but every new or updated row must satisfy it.
+ALTER TABLE tasks
+    ADD CONSTRAINT tasks_parent_project_check
+        CHECK (parent IS NULL OR starts_with(parent, project || '/tasks/')) NOT VALID;
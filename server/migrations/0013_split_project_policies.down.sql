@@ -0,0 +1,17 @@
+DROP POLICY IF EXISTS project_changes_delete ON project_changes;
+DROP POLICY IF EXISTS project_changes_update ON project_changes;
+DROP POLICY IF EXISTS project_changes_insert ON project_changes;
+DROP POLICY IF EXISTS project_changes_select ON project_changes;
+
+CREATE POLICY project_changes_tenant_isolation ON project_changes
+    USING (owner IS NULL OR owner = current_setting('app.tenant', true) OR current_setting('app.tenant', true) = '*')
+    WITH CHECK (owner IS NULL OR owner = current_setting('app.tenant', true) OR current_setting('app.tenant', true) = '*');
+
+DROP POLICY IF EXISTS projects_delete ON projects;
+DROP POLICY IF EXISTS projects_update ON projects;
+DROP POLICY IF EXISTS projects_insert ON projects;
+DROP POLICY IF EXISTS projects_select ON projects;
+
+CREATE POLICY projects_tenant_isolation ON projects
+    USING (owner IS NULL OR owner = current_setting('app.tenant', true) OR current_setting('app.tenant', true) = '*')
+    WITH CHECK (owner IS NULL OR owner = current_setting('app.tenant', true) OR current_setting('app.tenant', true) = '*');
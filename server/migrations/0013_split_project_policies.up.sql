@@ -0,0 +1,35 @@
+-- Shared projects, those without an owner, are visible to every tenant but
+-- only writable by the empty tenant of unauthenticated calls, which only
+-- happen while authentication is disabled. Users change their own projects
+-- only. Transactions without an app.tenant setting count as the empty tenant.
+DROP POLICY IF EXISTS projects_tenant_isolation ON projects;
+
+CREATE POLICY projects_select ON projects FOR SELECT
+    USING (owner IS NULL OR owner = current_setting('app.tenant', true) OR current_setting('app.tenant', true) = '*');
+
+CREATE POLICY projects_insert ON projects FOR INSERT
+    WITH CHECK (COALESCE(owner, '') = COALESCE(current_setting('app.tenant', true), '') OR current_setting('app.tenant', true) = '*');
+
+CREATE POLICY projects_update ON projects FOR UPDATE
+    USING (COALESCE(owner, '') = COALESCE(current_setting('app.tenant', true), '') OR current_setting('app.tenant', true) = '*')
+    WITH CHECK (COALESCE(owner, '') = COALESCE(current_setting('app.tenant', true), '') OR current_setting('app.tenant', true) = '*');
+
+CREATE POLICY projects_delete ON projects FOR DELETE
+    USING (COALESCE(owner, '') = COALESCE(current_setting('app.tenant', true), '') OR current_setting('app.tenant', true) = '*');
+
+-- Changes are recorded by the trigger of the transaction that changed the
+-- project, so they follow the same rules.
+DROP POLICY IF EXISTS project_changes_tenant_isolation ON project_changes;
+
+CREATE POLICY project_changes_select ON project_changes FOR SELECT
+    USING (owner IS NULL OR owner = current_setting('app.tenant', true) OR current_setting('app.tenant', true) = '*');
+
+CREATE POLICY project_changes_insert ON project_changes FOR INSERT
+    WITH CHECK (COALESCE(owner, '') = COALESCE(current_setting('app.tenant', true), '') OR current_setting('app.tenant', true) = '*');
+
+CREATE POLICY project_changes_update ON project_changes FOR UPDATE
+    USING (current_setting('app.tenant', true) = '*')
+    WITH CHECK (current_setting('app.tenant', true) = '*');
+
+CREATE POLICY project_changes_delete ON project_changes FOR DELETE
+    USING (current_setting('app.tenant', true) = '*');
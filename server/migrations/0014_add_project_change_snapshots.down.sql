@@ -0,0 +1,31 @@
+DROP TRIGGER IF EXISTS projects_record_change_update ON projects;
+DROP TRIGGER IF EXISTS projects_record_change_insert_delete ON projects;
+
+CREATE OR REPLACE FUNCTION record_project_change() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        INSERT INTO project_changes (project, owner, kind) VALUES (NEW.name, NEW.owner, 1);
+    ELSIF TG_OP = 'DELETE' THEN
+        INSERT INTO project_changes (project, owner, kind) VALUES (OLD.name, OLD.owner, 3);
+    ELSIF NEW.state = 3 AND OLD.state IS DISTINCT FROM 3 THEN
+        INSERT INTO project_changes (project, owner, kind) VALUES (NEW.name, NEW.owner, 3);
+    ELSE
+        INSERT INTO project_changes (project, owner, kind) VALUES (NEW.name, NEW.owner, 2);
+    END IF;
+    PERFORM pg_notify('project_changes', '');
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER projects_record_change_insert_delete
+    AFTER INSERT OR DELETE ON projects
+    FOR EACH ROW EXECUTE FUNCTION record_project_change();
+
+CREATE TRIGGER projects_record_change_update
+    AFTER UPDATE ON projects
+    FOR EACH ROW WHEN (OLD.* IS DISTINCT FROM NEW.*)
+    EXECUTE FUNCTION record_project_change();
+
+DROP FUNCTION IF EXISTS project_snapshot(projects);
+
+ALTER TABLE project_changes DROP COLUMN IF EXISTS snapshot;
@@ -0,0 +1,63 @@
+-- Changes carry a snapshot of their project, so that watchers receive the
+-- project as it was when the change was committed rather than as it is when
+-- the change is delivered. Deletions have no snapshot.
+ALTER TABLE project_changes ADD COLUMN snapshot JSONB;
+
+-- project_snapshot returns a project row as JSON together with the comma
+-- separated names of its labels, like the storages read them.
+CREATE FUNCTION project_snapshot(p projects) RETURNS JSONB AS $$
+    SELECT to_jsonb(p) || jsonb_build_object('labels', COALESCE(
+        (SELECT string_agg(label, ',' ORDER BY label) FROM project_labels WHERE project_labels.project = p.name), ''));
+$$ LANGUAGE sql STABLE;
+
+-- Labels are written after the project row, so changes are recorded when the
+-- transaction commits. The snapshot is the project at that point; a project
+-- deleted later in the same transaction falls back to the changed row.
+CREATE OR REPLACE FUNCTION record_project_change() RETURNS trigger AS $$
+DECLARE
+    changed JSONB;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        INSERT INTO project_changes (project, owner, kind) VALUES (OLD.name, OLD.owner, 3);
+        PERFORM pg_notify('project_changes', '');
+        RETURN NULL;
+    END IF;
+
+    SELECT project_snapshot(p) INTO changed FROM projects p WHERE p.name = NEW.name;
+    IF changed IS NULL THEN
+        changed := project_snapshot(NEW);
+    END IF;
+
+    IF TG_OP = 'INSERT' THEN
+        INSERT INTO project_changes (project, owner, kind, snapshot) VALUES (NEW.name, NEW.owner, 1, changed);
+    ELSIF NEW.state = 3 AND OLD.state IS DISTINCT FROM 3 THEN
+        INSERT INTO project_changes (project, owner, kind, snapshot) VALUES (NEW.name, NEW.owner, 3, changed);
+    ELSE
+        INSERT INTO project_changes (project, owner, kind, snapshot) VALUES (NEW.name, NEW.owner, 2, changed);
+    END IF;
+    PERFORM pg_notify('project_changes', '');
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER projects_record_change_insert_delete ON projects;
+DROP TRIGGER projects_record_change_update ON projects;
+
+CREATE CONSTRAINT TRIGGER projects_record_change_insert_delete
+    AFTER INSERT OR DELETE ON projects
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW EXECUTE FUNCTION record_project_change();
+
+CREATE CONSTRAINT TRIGGER projects_record_change_update
+    AFTER UPDATE ON projects
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW WHEN (OLD.* IS DISTINCT FROM NEW.*)
+    EXECUTE FUNCTION record_project_change();
+
+-- Changes recorded before snapshots existed get the current state of their
+-- project, which is what watchers received for them so far.
+SELECT set_config('app.tenant', '*', true);
+
+UPDATE project_changes SET snapshot = project_snapshot(p)
+FROM projects p
+WHERE p.name = project_changes.project AND project_changes.snapshot IS NULL;
@@ -0,0 +1,9 @@
+DROP POLICY IF EXISTS labels_delete ON labels;
+DROP POLICY IF EXISTS labels_update ON labels;
+DROP POLICY IF EXISTS labels_insert ON labels;
+DROP POLICY IF EXISTS labels_select ON labels;
+ALTER TABLE labels NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE labels DISABLE ROW LEVEL SECURITY;
+
+DROP INDEX IF EXISTS labels_owner_name_idx;
+ALTER TABLE labels DROP COLUMN IF EXISTS owner;
@@ -0,0 +1,23 @@
+-- owner is the user a label belongs to. Like projects, labels without an
+-- owner, those created before labels had owners or while authentication is
+-- disabled, are shared: visible to every user but only writable by the empty
+-- tenant of unauthenticated calls.
+ALTER TABLE labels ADD COLUMN owner TEXT REFERENCES users (name);
+
+CREATE INDEX labels_owner_name_idx ON labels (owner, name);
+
+ALTER TABLE labels ENABLE ROW LEVEL SECURITY;
+ALTER TABLE labels FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY labels_select ON labels FOR SELECT
+    USING (owner IS NULL OR owner = current_setting('app.tenant', true) OR current_setting('app.tenant', true) = '*');
+
+CREATE POLICY labels_insert ON labels FOR INSERT
+    WITH CHECK (COALESCE(owner, '') = COALESCE(current_setting('app.tenant', true), '') OR current_setting('app.tenant', true) = '*');
+
+CREATE POLICY labels_update ON labels FOR UPDATE
+    USING (COALESCE(owner, '') = COALESCE(current_setting('app.tenant', true), '') OR current_setting('app.tenant', true) = '*')
+    WITH CHECK (COALESCE(owner, '') = COALESCE(current_setting('app.tenant', true), '') OR current_setting('app.tenant', true) = '*');
+
+CREATE POLICY labels_delete ON labels FOR DELETE
+    USING (COALESCE(owner, '') = COALESCE(current_setting('app.tenant', true), '') OR current_setting('app.tenant', true) = '*');
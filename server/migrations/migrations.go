@@ -0,0 +1,10 @@
+// Package migrations embeds the versioned database schema migrations.
+//
+// Every migration is a pair of files named <version>_<name>.up.sql and
+// <version>_<name>.down.sql. Versions are applied in ascending order.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS